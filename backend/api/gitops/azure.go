@@ -3,6 +3,7 @@ package gitops
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -13,6 +14,39 @@ import (
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
+func getAzurePushInfo(body []byte, branch string) (*pushInfo, error) {
+	var pushEvent azure.PushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+	if pushEvent.Resource == nil || len(pushEvent.Resource.RefUpdates) == 0 {
+		return nil, errors.Errorf("no ref update in the push event")
+	}
+	// A push may update multiple refs, we prefer the update of the branch over the tags.
+	refUpdate := pushEvent.Resource.RefUpdates[0]
+	for _, update := range pushEvent.Resource.RefUpdates {
+		if update.Name == branchRefPrefix+branch {
+			refUpdate = update
+		}
+	}
+	info := &pushInfo{
+		ref:    refUpdate.Name,
+		before: refUpdate.OldObjectID,
+		after:  refUpdate.NewObjectID,
+	}
+	if pushEvent.Resource.PushedBy != nil {
+		info.email = pushEvent.Resource.PushedBy.UniqueName
+	}
+	if pushEvent.Resource.Repository != nil {
+		info.url = fmt.Sprintf("%s/commit/%s", pushEvent.Resource.Repository.WebURL, refUpdate.NewObjectID)
+	}
+	// The commits are in reverse chronological order.
+	if len(pushEvent.Resource.Commits) > 0 {
+		info.message = pushEvent.Resource.Commits[0].Comment
+	}
+	return info, nil
+}
+
 func getAzurePullRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, body []byte) (*pullRequestInfo, error) {
 	var pushEvent azure.PullRequestEvent
 	if err := json.Unmarshal(body, &pushEvent); err != nil {
@@ -52,7 +86,9 @@ func getAzurePullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvider
 		url:         pushEvent.Resource.Links.Web.Href,
 		title:       pushEvent.Resource.Title,
 		description: pushEvent.Resource.Description,
-		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
+		commitID:    pushEvent.Resource.LastMergeCommit.CommitID,
+		merged:      true,
+		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload),
 	}
 
 	for _, file := range prInfo.changes {
@@ -13,7 +13,50 @@ import (
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
-func getBitBucketPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, body []byte) (*pullRequestInfo, error) {
+func getBitBucketPushInfo(body []byte, branch string) (*pushInfo, error) {
+	var pushEvent bitbucket.PushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+	// A push may contain the changes of multiple refs, we prefer the change of the branch over the tags.
+	var change *bitbucket.EventPushChange
+	for i, c := range pushEvent.Push.Changes {
+		if c.New == nil {
+			continue
+		}
+		if change == nil || (c.New.Type == "branch" && c.New.Name == branch) {
+			change = &pushEvent.Push.Changes[i]
+		}
+	}
+	if change == nil {
+		return nil, errors.Errorf("no created or updated ref in the push event")
+	}
+	info := &pushInfo{
+		ref:     branchRefPrefix + change.New.Name,
+		after:   change.New.Target.Hash,
+		message: change.New.Target.Message,
+		url:     change.Links.HTML.Href,
+	}
+	if change.New.Type == "tag" {
+		info.ref = tagRefPrefix + change.New.Name
+	}
+	if change.Old != nil {
+		info.before = change.Old.Target.Hash
+	}
+	return info, nil
+}
+
+// getBitBucketPullRequestStateChange gets the decline of the pull request.
+// Bitbucket Cloud does not support reopening the declined pull requests.
+func getBitBucketPullRequestStateChange(body []byte) (*pullRequestStateChange, error) {
+	var pushEvent bitbucket.PullRequestPushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+	return &pullRequestStateChange{url: pushEvent.PullRequest.Links.HTML.Href, closed: true}, nil
+}
+
+func getBitBucketPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, body []byte, merged bool) (*pullRequestInfo, error) {
 	var pushEvent bitbucket.PullRequestPushEvent
 	if err := json.Unmarshal(body, &pushEvent); err != nil {
 		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
@@ -33,7 +76,9 @@ func getBitBucketPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProv
 		url:         pushEvent.PullRequest.Links.HTML.Href,
 		title:       pushEvent.PullRequest.Title,
 		description: pushEvent.PullRequest.Description,
-		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
+		commitID:    pushEvent.PullRequest.Source.Commit.Hash,
+		merged:      merged,
+		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload),
 	}
 
 	for _, file := range prInfo.changes {
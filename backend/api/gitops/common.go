@@ -10,6 +10,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -22,7 +23,16 @@ type pullRequestInfo struct {
 	title       string
 	description string
 	url         string
-	changes     []*fileChange
+	commitID    string
+	// merged is false if the pull request is opened or updated.
+	merged bool
+	// push is true if the changes are pushed to the branch or tagged without pull requests.
+	push bool
+	// tag is the tag that triggered the changes.
+	tag     string
+	changes []*fileChange
+	// warnings are the problems of the changes reported on the pull request, e.g. the Django operations skipped.
+	warnings []string
 }
 
 type fileChange struct {
@@ -31,9 +41,30 @@ type fileChange struct {
 	changeType  v1pb.Plan_ChangeDatabaseConfig_Type
 	description string
 	content     string
+	// skipApplied is true if the change is skipped for the databases with its version applied instead of failing,
+	// e.g. the Liquibase changeSets in the changelog which contains the applied changeSets as well.
+	skipApplied bool
+	// dbms is the Liquibase dbms of the databases that the change applies to, or empty for all the databases.
+	dbms string
+
+	// The target routed by the file path template of the VCS connector.
+	// If all empty, the change applies to the database group or all databases in the project.
+	environmentID string
+	databaseName  string
+	databaseGroup string
 }
 
-func getChangesByFileList(files []*vcs.PullRequestFile, rootDir string) []*fileChange {
+func getChangesByFileList(files []*vcs.PullRequestFile, vcsConnector *storepb.VCSConnector) []*fileChange {
+	if vcsConnector.Declarative {
+		return getDeclarativeChangesByFileList(files, vcsConnector)
+	}
+	if vcsConnector.MigrationFormat != storepb.VCSConnector_MIGRATION_FORMAT_UNSPECIFIED {
+		return getMigrationFormatChangesByFileList(files, vcsConnector)
+	}
+	if vcsConnector.FilePathTemplate != "" {
+		return getChangesByFilePathTemplate(files, vcsConnector.FilePathTemplate, vcsConnector.BaseDirectory)
+	}
+
 	changes := []*fileChange{}
 	for _, v := range files {
 		if v.IsDeleted {
@@ -43,7 +74,7 @@ func getChangesByFileList(files []*vcs.PullRequestFile, rootDir string) []*fileC
 		if !strings.HasPrefix(prFilePath, "/") {
 			prFilePath = fmt.Sprintf("/%s", prFilePath)
 		}
-		if filepath.Dir(prFilePath) != rootDir {
+		if filepath.Dir(prFilePath) != vcsConnector.BaseDirectory {
 			continue
 		}
 		change, err := getFileChange(v.Path)
@@ -58,6 +89,46 @@ func getChangesByFileList(files []*vcs.PullRequestFile, rootDir string) []*fileC
 	return changes
 }
 
+// getChangesByFilePathTemplate gets the changes from the files matching the file path template,
+// which may be in any subdirectory of the base directory.
+func getChangesByFilePathTemplate(files []*vcs.PullRequestFile, template, baseDirectory string) []*fileChange {
+	filePathTemplate, err := vcs.ParseFilePathTemplate(template, baseDirectory)
+	if err != nil {
+		slog.Error("failed to parse file path template", slog.String("template", template), log.BBError(err))
+		return nil
+	}
+
+	changes := []*fileChange{}
+	for _, v := range files {
+		if v.IsDeleted || filepath.Ext(v.Path) != ".sql" {
+			continue
+		}
+		info := filePathTemplate.Match(v.Path)
+		if info == nil {
+			continue
+		}
+		changeType, description := getChangeType(info.Description)
+		switch info.Type {
+		case "ddl":
+			changeType = v1pb.Plan_ChangeDatabaseConfig_MIGRATE
+		case "dml":
+			changeType = v1pb.Plan_ChangeDatabaseConfig_DATA
+		case "ghost":
+			changeType = v1pb.Plan_ChangeDatabaseConfig_MIGRATE_GHOST
+		}
+		changes = append(changes, &fileChange{
+			path:          v.Path,
+			version:       info.Version,
+			changeType:    changeType,
+			description:   description,
+			environmentID: info.EnvironmentID,
+			databaseName:  info.DatabaseName,
+			databaseGroup: info.DatabaseGroup,
+		})
+	}
+	return changes
+}
+
 func getFileChange(path string) (*fileChange, error) {
 	filename := filepath.Base(path)
 	if filepath.Ext(filename) != ".sql" {
@@ -71,6 +142,17 @@ func getFileChange(path string) (*fileChange, error) {
 	version := matches[0]
 	description := strings.TrimPrefix(filename, version)
 	description = strings.TrimLeft(description, "_")
+	changeType, description := getChangeType(description)
+	return &fileChange{
+		path:        path,
+		version:     version,
+		changeType:  changeType,
+		description: description,
+	}, nil
+}
+
+// getChangeType gets the change type from the prefix of the description, and returns the description without the prefix.
+func getChangeType(description string) (v1pb.Plan_ChangeDatabaseConfig_Type, string) {
 	changeType := v1pb.Plan_ChangeDatabaseConfig_MIGRATE
 	switch {
 	case strings.HasPrefix(description, "ddl"):
@@ -83,13 +165,7 @@ func getFileChange(path string) (*fileChange, error) {
 		description = strings.TrimPrefix(description, "ghost")
 		changeType = v1pb.Plan_ChangeDatabaseConfig_MIGRATE_GHOST
 	}
-	description = strings.TrimLeft(description, "_")
-	return &fileChange{
-		path:        path,
-		version:     version,
-		changeType:  changeType,
-		description: description,
-	}, nil
+	return changeType, strings.TrimLeft(description, "_")
 }
 
 func getPullRequestID(url string) string {
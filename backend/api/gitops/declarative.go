@@ -0,0 +1,288 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	runnerutils "github.com/bytebase/bytebase/backend/runner/utils"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// defaultDeclarativeFilePathTemplate is the file path template of the declarative VCS connectors without one,
+// where the SQL files directly under the base directory are the schema of all the databases.
+const defaultDeclarativeFilePathTemplate = "{{ROOT}}/*.sql"
+
+// declarativeTarget is the target of a declarative schema, which consists of all the matched files with the same target.
+type declarativeTarget struct {
+	environmentID string
+	databaseName  string
+	databaseGroup string
+}
+
+func getDeclarativeFilePathTemplate(vcsConnector *storepb.VCSConnector) (*vcs.FilePathTemplate, error) {
+	template := vcsConnector.FilePathTemplate
+	if template == "" {
+		template = defaultDeclarativeFilePathTemplate
+	}
+	return vcs.ParseFilePathTemplate(template, vcsConnector.BaseDirectory)
+}
+
+// getDeclarativeChangesByFileList gets the schema files changed by the pull request.
+// The changes are only used to find the targets touched by the pull request, see getDeclarativeChanges.
+func getDeclarativeChangesByFileList(files []*vcs.PullRequestFile, vcsConnector *storepb.VCSConnector) []*fileChange {
+	filePathTemplate, err := getDeclarativeFilePathTemplate(vcsConnector)
+	if err != nil {
+		slog.Error("failed to parse file path template", slog.String("template", vcsConnector.FilePathTemplate), log.BBError(err))
+		return nil
+	}
+
+	changes := []*fileChange{}
+	for _, v := range files {
+		if v.IsDeleted || filepath.Ext(v.Path) != ".sql" {
+			continue
+		}
+		info := filePathTemplate.Match(v.Path)
+		if info == nil {
+			continue
+		}
+		changes = append(changes, &fileChange{
+			path:          v.Path,
+			changeType:    v1pb.Plan_ChangeDatabaseConfig_MIGRATE_SDL,
+			description:   info.Description,
+			environmentID: info.EnvironmentID,
+			databaseName:  info.DatabaseName,
+			databaseGroup: info.DatabaseGroup,
+		})
+	}
+	return changes
+}
+
+// getDeclarativeChanges replaces the changed files of the pull request with the full schemas of the touched targets.
+// The schema of a target is the concatenation of all the files matching the target, so that a target can be
+// either described in one SDL file or in a directory of object files.
+// For merged pull requests, the schemas are read from the branch of the VCS connector, otherwise from the head commit,
+// or the pushed commit.
+func (s *Service) getDeclarativeChanges(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo) error {
+	targets := map[declarativeTarget]bool{}
+	for _, change := range prInfo.changes {
+		targets[getDeclarativeTarget(change)] = true
+	}
+	refInfo := vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: prInfo.commitID}
+	if prInfo.merged && !prInfo.push {
+		refInfo = vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: vcsConnector.Payload.Branch}
+	}
+	changes, err := getDeclarativeSchemas(ctx, vcsProvider, vcsConnector, refInfo, targets)
+	if err != nil {
+		return err
+	}
+	prInfo.changes = changes
+	return nil
+}
+
+func getDeclarativeSchemas(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, refInfo vcs.RefInfo, targets map[declarativeTarget]bool) ([]*fileChange, error) {
+	filePathTemplate, err := getDeclarativeFilePathTemplate(vcsConnector.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse file path template")
+	}
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	paths, err := provider.ListFiles(ctx, vcsConnector.Payload.ExternalId, vcsConnector.Payload.BaseDirectory, refInfo)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list files at %s %q", refInfo.RefType, refInfo.RefName)
+	}
+	sort.Strings(paths)
+
+	var changes []*fileChange
+	schemas := map[declarativeTarget]*fileChange{}
+	for _, path := range paths {
+		if filepath.Ext(path) != ".sql" {
+			continue
+		}
+		info := filePathTemplate.Match(path)
+		if info == nil {
+			continue
+		}
+		target := declarativeTarget{
+			environmentID: info.EnvironmentID,
+			databaseName:  info.DatabaseName,
+			databaseGroup: info.DatabaseGroup,
+		}
+		if !targets[target] {
+			continue
+		}
+		content, err := provider.ReadFileContent(ctx, vcsConnector.Payload.ExternalId, path, refInfo)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read file %q", path)
+		}
+		content = convertFileContentToUTF8String(content)
+
+		change, ok := schemas[target]
+		if !ok {
+			change = &fileChange{
+				path:          path,
+				changeType:    v1pb.Plan_ChangeDatabaseConfig_MIGRATE_SDL,
+				description:   info.Description,
+				content:       content,
+				environmentID: target.environmentID,
+				databaseName:  target.databaseName,
+				databaseGroup: target.databaseGroup,
+			}
+			schemas[target] = change
+			changes = append(changes, change)
+			continue
+		}
+		// The schema consists of multiple files, so the sheet is named after the directory of the first file.
+		change.path = filepath.Dir(change.path)
+		change.content = strings.TrimRight(change.content, "\n") + "\n\n" + content
+	}
+	return changes, nil
+}
+
+func getDeclarativeTarget(change *fileChange) declarativeTarget {
+	return declarativeTarget{
+		environmentID: change.environmentID,
+		databaseName:  change.databaseName,
+		databaseGroup: change.databaseGroup,
+	}
+}
+
+// commentDeclarativeChanges posts the migrations generated from the declarative schemas of the pull request for review.
+// It also reports the drift if the database has diverged from the schema on the branch of the VCS connector.
+func (s *Service) commentDeclarativeChanges(ctx context.Context, project *store.ProjectMessage, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo) error {
+	targets := map[declarativeTarget]bool{}
+	for _, change := range prInfo.changes {
+		targets[getDeclarativeTarget(change)] = true
+	}
+	baseChanges, err := getDeclarativeSchemas(ctx, vcsProvider, vcsConnector, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: vcsConnector.Payload.Branch}, targets)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the schemas on branch %q", vcsConnector.Payload.Branch)
+	}
+	baseSchemas := map[declarativeTarget]string{}
+	for _, change := range baseChanges {
+		baseSchemas[getDeclarativeTarget(change)] = change.content
+	}
+
+	var buf strings.Builder
+	_, _ = buf.WriteString("Bytebase Bot: the following migrations will be rolled out after this pull request is merged.\n\n")
+	for _, change := range prInfo.changes {
+		databases, err := s.getChangeDatabases(ctx, project, vcsConnector, change)
+		if err != nil {
+			return err
+		}
+		for _, database := range databases {
+			instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+			if err != nil {
+				return errors.Wrapf(err, "failed to get instance %q", database.InstanceID)
+			}
+			if instance == nil {
+				continue
+			}
+			_, _ = fmt.Fprintf(&buf, "#### %s (%s)\n\n", common.FormatDatabase(database.InstanceID, database.DatabaseName), change.path)
+			diff, err := runnerutils.ComputeDatabaseSchemaDiff(ctx, instance, database, s.dbFactory, change.content)
+			if err != nil {
+				_, _ = fmt.Fprintf(&buf, "❌ Failed to compute the migration: %s\n\n", err.Error())
+				continue
+			}
+			if diff == "" {
+				_, _ = buf.WriteString("No schema change.\n\n")
+			} else {
+				_, _ = fmt.Fprintf(&buf, "```sql\n%s\n```\n\n", strings.TrimSpace(diff))
+			}
+
+			baseSchema, ok := baseSchemas[getDeclarativeTarget(change)]
+			if !ok {
+				continue
+			}
+			drift, err := runnerutils.ComputeDatabaseSchemaDiff(ctx, instance, database, s.dbFactory, baseSchema)
+			if err != nil {
+				slog.Warn("failed to compute schema drift", slog.String("database", database.DatabaseName), log.BBError(err))
+				continue
+			}
+			if drift != "" {
+				_, _ = fmt.Fprintf(&buf, "⚠️ The database has drifted from the schema on branch %q, the following migration is required to bring it back:\n\n```sql\n%s\n```\n\n", vcsConnector.Payload.Branch, strings.TrimSpace(drift))
+			}
+		}
+	}
+
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	return provider.CreatePullRequestComment(ctx, vcsConnector.Payload.ExternalId, getPullRequestID(prInfo.url), buf.String())
+}
+
+// getChangeDatabases gets the databases that the change applies to, in the same way as getChangeSteps.
+func (s *Service) getChangeDatabases(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage, change *fileChange) ([]*store.DatabaseMessage, error) {
+	databases, err := s.getChangeRoutedDatabases(ctx, project, vcsConnector, change)
+	if err != nil {
+		return nil, err
+	}
+	if change.dbms == "" {
+		return databases, nil
+	}
+	var dbmsDatabases []*store.DatabaseMessage
+	for _, database := range databases {
+		dbms, err := s.getDatabaseDBMS(ctx, database)
+		if err != nil {
+			return nil, err
+		}
+		if dbms == change.dbms {
+			dbmsDatabases = append(dbmsDatabases, database)
+		}
+	}
+	return dbmsDatabases, nil
+}
+
+// getChangeRoutedDatabases gets the databases that the change is routed to by the database group or the file path template.
+func (s *Service) getChangeRoutedDatabases(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage, change *fileChange) ([]*store.DatabaseMessage, error) {
+	databaseGroupName := ""
+	switch {
+	case change.databaseGroup != "":
+		databaseGroupName = fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, project.ResourceID, common.DatabaseGroupNamePrefix, change.databaseGroup)
+	case change.databaseName == "" && vcsConnector.Payload.DatabaseGroup != "":
+		databaseGroupName = vcsConnector.Payload.DatabaseGroup
+	}
+
+	allDatabases, err := s.listDatabases(ctx, project)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list databases for project %q", project.ResourceID)
+	}
+	if databaseGroupName != "" {
+		_, databaseGroupID, err := common.GetProjectIDDatabaseGroupID(databaseGroupName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get database group id from %q", databaseGroupName)
+		}
+		databaseGroup, err := s.store.GetDatabaseGroup(ctx, &store.FindDatabaseGroupMessage{ProjectUID: &project.UID, ResourceID: &databaseGroupID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get database group %q", databaseGroupID)
+		}
+		if databaseGroup == nil {
+			return nil, errors.Errorf("database group %q not found", databaseGroupID)
+		}
+		matchedDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, databaseGroup, allDatabases)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get matched databases in database group %q", databaseGroupID)
+		}
+		return matchedDatabases, nil
+	}
+
+	var databases []*store.DatabaseMessage
+	for _, database := range allDatabases {
+		if change.databaseName != "" && change.databaseName != database.DatabaseName {
+			continue
+		}
+		if change.environmentID != "" && change.environmentID != database.EffectiveEnvironmentID {
+			continue
+		}
+		databases = append(databases, database)
+	}
+	return databases, nil
+}
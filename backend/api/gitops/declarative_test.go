@@ -0,0 +1,118 @@
+package gitops
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestGetDeclarativeChangesByFileList(t *testing.T) {
+	files := []*vcs.PullRequestFile{
+		{Path: "schema/employee.sql"},
+		{Path: "schema/prod/employee/tables/salary.sql"},
+		{Path: "schema/prod/employee/tables/dept.sql", IsDeleted: true},
+		{Path: "schema/prod/employee/README.md"},
+		{Path: "other/prod/employee/tables/salary.sql"},
+	}
+
+	tests := []struct {
+		vcsConnector *storepb.VCSConnector
+		want         []*fileChange
+	}{
+		{
+			// The SQL files directly under the base directory are the schema of all the databases without the template.
+			vcsConnector: &storepb.VCSConnector{Declarative: true, BaseDirectory: "/schema"},
+			want: []*fileChange{
+				{path: "schema/employee.sql", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE_SDL},
+			},
+		},
+		{
+			vcsConnector: &storepb.VCSConnector{Declarative: true, BaseDirectory: "/schema", FilePathTemplate: "{{ROOT}}/{{ENV_ID}}/{{DB_NAME}}/**/{{DESC}}.sql"},
+			want: []*fileChange{
+				{path: "schema/prod/employee/tables/salary.sql", changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE_SDL, description: "salary", environmentID: "prod", databaseName: "employee"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got := getChangesByFileList(files, test.vcsConnector)
+		require.Equal(t, test.want, got, test.vcsConnector.FilePathTemplate)
+	}
+}
+
+func TestGetDeclarativeSchemas(t *testing.T) {
+	files := map[string]string{
+		"schema/prod/employee/tables/salary.sql": "CREATE TABLE salary(id INT);\n",
+		"schema/prod/employee/tables/dept.sql":   "CREATE TABLE dept(id INT);",
+		"schema/prod/employee/README.md":         "# employee",
+		"schema/prod/hr/tables/title.sql":        "CREATE TABLE title(id INT);",
+		"schema/test/employee/tables/salary.sql": "CREATE TABLE salary(id BIGINT);",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const rawPrefix = "/api/v1/repos/octocat/hello/raw/"
+		switch {
+		case r.URL.Path == "/api/v1/repos/octocat/hello/git/trees/main":
+			var entries []map[string]string
+			for path := range files {
+				entries = append(entries, map[string]string{"path": path, "type": "blob"})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"tree": entries, "truncated": false})
+		case strings.HasPrefix(r.URL.Path, rawPrefix):
+			require.Equal(t, "main", r.URL.Query().Get("ref"))
+			content, ok := files[strings.TrimPrefix(r.URL.Path, rawPrefix)]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(content))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	vcsProvider := &store.VCSProviderMessage{Type: storepb.VCSType_GITEA, InstanceURL: server.URL, AccessToken: "token"}
+	vcsConnector := &store.VCSConnectorMessage{Payload: &storepb.VCSConnector{
+		ExternalId:       "octocat/hello",
+		Branch:           "main",
+		BaseDirectory:    "/schema",
+		Declarative:      true,
+		FilePathTemplate: "{{ROOT}}/{{ENV_ID}}/{{DB_NAME}}/**/{{DESC}}.sql",
+	}}
+	targets := map[declarativeTarget]bool{
+		{environmentID: "prod", databaseName: "employee"}: true,
+		{environmentID: "prod", databaseName: "hr"}:       true,
+	}
+
+	got, err := getDeclarativeSchemas(context.Background(), vcsProvider, vcsConnector, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: "main"}, targets)
+	require.NoError(t, err)
+	require.Equal(t, []*fileChange{
+		{
+			// The schema of multiple files is concatenated in the order of the paths, and named after the directory of the first file.
+			path:          "schema/prod/employee/tables",
+			changeType:    v1pb.Plan_ChangeDatabaseConfig_MIGRATE_SDL,
+			description:   "dept",
+			content:       "CREATE TABLE dept(id INT);\n\nCREATE TABLE salary(id INT);\n",
+			environmentID: "prod",
+			databaseName:  "employee",
+		},
+		{
+			path:          "schema/prod/hr/tables/title.sql",
+			changeType:    v1pb.Plan_ChangeDatabaseConfig_MIGRATE_SDL,
+			description:   "title",
+			content:       "CREATE TABLE title(id INT);",
+			environmentID: "prod",
+			databaseName:  "hr",
+		},
+	}, got)
+}
@@ -0,0 +1,149 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/git"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const gitPollerInterval = time.Minute
+
+// RunGitPoller polls the branches of the VCS connectors of the generic Git VCS providers,
+// which have no webhook, and handles the new commits as the pushes to the branches.
+func (s *Service) RunGitPoller(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(gitPollerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Git poller started and will run every %v", gitPollerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			s.pollGitRepositories(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) pollGitRepositories(ctx context.Context) {
+	vcsProviders, err := s.store.ListVCSProviders(ctx)
+	if err != nil {
+		slog.Error("failed to list VCS providers", log.BBError(err))
+		return
+	}
+	for _, vcsProvider := range vcsProviders {
+		if vcsProvider.Type != storepb.VCSType_GIT {
+			continue
+		}
+		vcsConnectors, err := s.store.ListVCSConnectors(ctx, &store.FindVCSConnectorMessage{VCSUID: &vcsProvider.ID})
+		if err != nil {
+			slog.Error("failed to list VCS connectors", slog.String("vcs_provider", vcsProvider.ResourceID), log.BBError(err))
+			continue
+		}
+		for _, vcsConnector := range vcsConnectors {
+			if err := s.pollGitBranch(ctx, vcsProvider, vcsConnector); err != nil {
+				slog.Error("failed to poll the branch of the VCS connector",
+					slog.String("project", vcsConnector.ProjectID),
+					slog.String("vcs_connector", vcsConnector.ResourceID),
+					log.BBError(err),
+				)
+			}
+		}
+	}
+}
+
+// pollGitBranch creates the rollout for the files changed since the last polled commit of the branch.
+// The first poll records the head of the branch as the baseline.
+func (s *Service) pollGitBranch(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage) error {
+	provider, ok := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken}).(*git.Provider)
+	if !ok {
+		return errors.Errorf("unexpected provider for VCS type %q", vcsProvider.Type.String())
+	}
+	branch, err := provider.GetBranch(ctx, vcsConnector.Payload.ExternalId, vcsConnector.Payload.Branch)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get branch %q", vcsConnector.Payload.Branch)
+	}
+	lastCommitID := vcsConnector.Payload.LastPolledCommitId
+	if lastCommitID == branch.LastCommitID {
+		return nil
+	}
+	if lastCommitID == "" {
+		return s.updateLastPolledCommitID(ctx, vcsConnector, branch.LastCommitID)
+	}
+
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &vcsConnector.ProjectID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project %q", vcsConnector.ProjectID)
+	}
+	if project == nil || project.Deleted {
+		return nil
+	}
+	commit, err := provider.GetCommit(ctx, branch.LastCommitID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get commit %q", branch.LastCommitID)
+	}
+	prInfo, err := s.getPushRequestInfo(ctx, vcsProvider, vcsConnector, &pushInfo{
+		email:   commit.AuthorEmail,
+		ref:     branchRefPrefix + branch.Name,
+		before:  lastCommitID,
+		after:   branch.LastCommitID,
+		message: commit.Message,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the changes from %q to %q", lastCommitID, branch.LastCommitID)
+	}
+	if len(prInfo.changes) > 0 {
+		if err := s.getMigrationFormatChanges(ctx, project, vcsProvider, vcsConnector, prInfo); err != nil {
+			return errors.Wrapf(err, "failed to convert the migration files of commit %q", branch.LastCommitID)
+		}
+		s.reportMigrationFormatWarnings(ctx, vcsProvider, vcsConnector, prInfo)
+	}
+	if len(prInfo.changes) > 0 && vcsConnector.Payload.Declarative {
+		if err := s.getDeclarativeChanges(ctx, vcsProvider, vcsConnector, prInfo); err != nil {
+			return errors.Wrapf(err, "failed to get declarative changes from commit %q", branch.LastCommitID)
+		}
+	}
+	return handlePolledCommit(ctx, branch.LastCommitID, prInfo,
+		func(ctx context.Context) error {
+			_, err := s.createIssueFromPRInfo(ctx, project, vcsProvider, vcsConnector, prInfo)
+			return err
+		},
+		func(ctx context.Context, commitID string) error {
+			return s.updateLastPolledCommitID(ctx, vcsConnector, commitID)
+		},
+	)
+}
+
+// handlePolledCommit creates the issue for the changes of the polled commit, and records the commit as the last polled commit
+// only after the issue is created, so that the failures are retried in the next poll from the same last polled commit.
+func handlePolledCommit(ctx context.Context, commitID string, prInfo *pullRequestInfo, createIssue func(ctx context.Context) error, recordCommit func(ctx context.Context, commitID string) error) error {
+	if len(prInfo.changes) > 0 {
+		if err := createIssue(ctx); err != nil {
+			return errors.Wrapf(err, "failed to create issue from commit %q", commitID)
+		}
+	}
+	return recordCommit(ctx, commitID)
+}
+
+func (s *Service) updateLastPolledCommitID(ctx context.Context, vcsConnector *store.VCSConnectorMessage, commitID string) error {
+	if err := s.store.UpdateVCSConnector(ctx, &store.UpdateVCSConnectorMessage{
+		ProjectID:          vcsConnector.ProjectID,
+		UpdaterID:          api.SystemBotID,
+		UID:                vcsConnector.UID,
+		LastPolledCommitID: &commitID,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update the last polled commit of VCS connector %q", vcsConnector.ResourceID)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package gitops
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlePolledCommit(t *testing.T) {
+	const commitID = "9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c"
+	tests := []struct {
+		description    string
+		changes        []*fileChange
+		createIssueErr error
+		wantErr        bool
+		wantIssue      bool
+		wantRecorded   bool
+	}{
+		{
+			description:  "the commit is recorded after the issue is created",
+			changes:      []*fileChange{{path: "bytebase/202401010000_create_t.sql"}},
+			wantIssue:    true,
+			wantRecorded: true,
+		},
+		{
+			description:    "the commit is not recorded if the issue fails to be created, so that the next poll retries it",
+			changes:        []*fileChange{{path: "bytebase/202401010000_create_t.sql"}},
+			createIssueErr: errors.New("failed to create plan"),
+			wantErr:        true,
+			wantIssue:      true,
+			wantRecorded:   false,
+		},
+		{
+			description:  "the commit without changes is recorded without issue",
+			wantIssue:    false,
+			wantRecorded: true,
+		},
+	}
+
+	for _, test := range tests {
+		var events []string
+		err := handlePolledCommit(context.Background(), commitID, &pullRequestInfo{changes: test.changes},
+			func(context.Context) error {
+				events = append(events, "issue")
+				return test.createIssueErr
+			},
+			func(_ context.Context, got string) error {
+				require.Equal(t, commitID, got)
+				events = append(events, "record")
+				return nil
+			},
+		)
+		if test.wantErr {
+			require.Error(t, err, test.description)
+		} else {
+			require.NoError(t, err, test.description)
+		}
+		var want []string
+		if test.wantIssue {
+			want = append(want, "issue")
+		}
+		if test.wantRecorded {
+			want = append(want, "record")
+		}
+		require.Equal(t, want, events, test.description)
+	}
+}
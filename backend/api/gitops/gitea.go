@@ -0,0 +1,94 @@
+package gitops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	giteaSynchronizedAction = "synchronized"
+)
+
+func getGiteaPushInfo(body []byte) (*pushInfo, error) {
+	var pushEvent gitea.PushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+	url := pushEvent.CompareURL
+	if url == "" {
+		url = pushEvent.HeadCommit.URL
+	}
+	return &pushInfo{
+		email:   pushEvent.Pusher.Email,
+		ref:     pushEvent.Ref,
+		before:  pushEvent.Before,
+		after:   pushEvent.After,
+		message: pushEvent.HeadCommit.Message,
+		url:     url,
+	}, nil
+}
+
+// getGiteaPullRequestStateChange gets the close without merge or the reopen of the pull request, and returns nil for other actions.
+func getGiteaPullRequestStateChange(body []byte) *pullRequestStateChange {
+	var pushEvent gitea.PullRequestEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil
+	}
+	switch {
+	case pushEvent.Action == closeAction && !pushEvent.PullRequest.Merged:
+		return &pullRequestStateChange{email: pushEvent.Sender.Email, url: pushEvent.PullRequest.HTMLURL, closed: true}
+	case pushEvent.Action == reopenAction:
+		return &pullRequestStateChange{email: pushEvent.Sender.Email, url: pushEvent.PullRequest.HTMLURL}
+	}
+	return nil
+}
+
+func getGiteaPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, body []byte) (*pullRequestInfo, error) {
+	var pushEvent gitea.PullRequestEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+	switch {
+	case pushEvent.Action == closeAction && pushEvent.PullRequest.Merged:
+	case pushEvent.Action == openAction || pushEvent.Action == giteaSynchronizedAction:
+	default:
+		return nil, errors.Errorf("skip webhook event action, got %s, want closed with merged", pushEvent.Action)
+	}
+
+	if pushEvent.PullRequest.Base.Ref != vcsConnector.Payload.Branch {
+		return nil, errors.Errorf("skip branch, got %q, want %q", pushEvent.PullRequest.Base.Ref, vcsConnector.Payload.Branch)
+	}
+
+	provider := vcs.Get(storepb.VCSType_GITEA, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	mrFiles, err := provider.ListPullRequestFile(ctx, vcsConnector.Payload.ExternalId, fmt.Sprintf("%d", pushEvent.Number))
+	if err != nil {
+		return nil, errors.Errorf("failed to list merge %q request files, error %v", pushEvent.PullRequest.HTMLURL, err)
+	}
+
+	prInfo := &pullRequestInfo{
+		email:       pushEvent.Sender.Email,
+		url:         pushEvent.PullRequest.HTMLURL,
+		title:       pushEvent.PullRequest.Title,
+		description: pushEvent.PullRequest.Body,
+		commitID:    pushEvent.PullRequest.Head.SHA,
+		merged:      pushEvent.PullRequest.Merged,
+		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload),
+	}
+
+	for _, file := range prInfo.changes {
+		content, err := provider.ReadFileContent(ctx, vcsConnector.Payload.ExternalId, file.path, vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: pushEvent.PullRequest.Head.SHA})
+		if err != nil {
+			return nil, errors.Errorf("failed read file content, merge request %q, file %q, error %v", pushEvent.PullRequest.HTMLURL, file.path, err)
+		}
+		file.content = convertFileContentToUTF8String(content)
+	}
+	return prInfo, nil
+}
@@ -0,0 +1,173 @@
+package gitops
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const giteaPushPayload = `{"ref":"refs/heads/main","before":"4b1f6a0e2c1d3f5a7b9c0d2e4f6a8b0c1d3e5f7a","after":"9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c","compare_url":"https://gitea.example.com/octocat/hello/compare/4b1f6a0e2c1d...9c2e4a6b8d0f","commits":[{"id":"9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c","message":"Add migration\n","url":"https://gitea.example.com/octocat/hello/commit/9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c","added":["bytebase/202401010000_create_t.sql"],"removed":[],"modified":[]}],"head_commit":{"id":"9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c","message":"Add migration\n","url":"https://gitea.example.com/octocat/hello/commit/9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c"},"repository":{"id":1,"full_name":"octocat/hello","default_branch":"main"},"pusher":{"id":1,"login":"octocat","email":"octocat@example.com"},"sender":{"id":1,"login":"octocat","email":"octocat@example.com"}}`
+
+func TestValidateGiteaWebhookSignature(t *testing.T) {
+	// Gitea sends the HMAC hex digest in the X-Gitea-Signature header without the "sha256=" prefix.
+	const signature = "8581c246c270f390022314bbe6771bfd265e17502cbcf65125be2e562deb82af"
+
+	tests := []struct {
+		description string
+		signature   string
+		key         string
+		body        string
+		want        bool
+	}{
+		{description: "success", signature: signature, key: "gitea-secret", body: giteaPushPayload, want: true},
+		{description: "wrong key", signature: signature, key: "abadkey", body: giteaPushPayload, want: false},
+		{description: "tampered body", signature: signature, key: "gitea-secret", body: giteaPushPayload + " ", want: false},
+		{description: "missing signature", signature: "", key: "gitea-secret", body: giteaPushPayload, want: false},
+	}
+	for _, test := range tests {
+		got, err := validateGitHubWebhookSignature256(test.signature, test.key, []byte(test.body))
+		require.NoError(t, err, test.description)
+		require.Equal(t, test.want, got, test.description)
+	}
+}
+
+func TestGetGiteaPushInfo(t *testing.T) {
+	got, err := getGiteaPushInfo([]byte(giteaPushPayload))
+	require.NoError(t, err)
+	require.Equal(t, &pushInfo{
+		email:   "octocat@example.com",
+		ref:     "refs/heads/main",
+		before:  "4b1f6a0e2c1d3f5a7b9c0d2e4f6a8b0c1d3e5f7a",
+		after:   "9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c",
+		message: "Add migration\n",
+		url:     "https://gitea.example.com/octocat/hello/compare/4b1f6a0e2c1d...9c2e4a6b8d0f",
+	}, got)
+
+	// A new tag has no compare URL, so the head commit URL is used.
+	const tagPayload = `{"ref":"refs/tags/v1.0.0","before":"0000000000000000000000000000000000000000","after":"9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c","compare_url":"","commits":[],"head_commit":{"id":"9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c","message":"Add migration\n","url":"https://gitea.example.com/octocat/hello/commit/9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c"},"pusher":{"email":"octocat@example.com"}}`
+	got, err = getGiteaPushInfo([]byte(tagPayload))
+	require.NoError(t, err)
+	require.Equal(t, "refs/tags/v1.0.0", got.ref)
+	require.Equal(t, "0000000000000000000000000000000000000000", got.before)
+	require.Equal(t, "https://gitea.example.com/octocat/hello/commit/9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c", got.url)
+
+	_, err = getGiteaPushInfo([]byte("not json"))
+	require.Error(t, err)
+}
+
+func TestGetGiteaPullRequestInfo(t *testing.T) {
+	const headSHA = "9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/repos/octocat/hello/pulls/3":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"html_url": "https://gitea.example.com/octocat/hello/pulls/3",
+				"head":     map[string]any{"ref": "feature", "sha": headSHA},
+			})
+		case "/api/v1/repos/octocat/hello/pulls/3/files":
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"filename": "bytebase/202401010000_create_t.sql", "status": "added"},
+				{"filename": "bytebase/202401010001_drop_t.sql", "status": "deleted"},
+				{"filename": "README.md", "status": "changed"},
+			})
+		case "/api/v1/repos/octocat/hello/raw/bytebase/202401010000_create_t.sql":
+			require.Equal(t, headSHA, r.URL.Query().Get("ref"))
+			_, _ = w.Write([]byte("CREATE TABLE t(id INT);"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	vcsProvider := &store.VCSProviderMessage{Type: storepb.VCSType_GITEA, InstanceURL: server.URL, AccessToken: "token"}
+	vcsConnector := &store.VCSConnectorMessage{Payload: &storepb.VCSConnector{
+		ExternalId:    "octocat/hello",
+		Branch:        "main",
+		BaseDirectory: "/bytebase",
+	}}
+	payload := func(action string, merged bool, base string) []byte {
+		body, err := json.Marshal(map[string]any{
+			"action": action,
+			"number": 3,
+			"pull_request": map[string]any{
+				"html_url": "https://gitea.example.com/octocat/hello/pulls/3",
+				"title":    "Create table t",
+				"body":     "Create the table t.",
+				"base":     map[string]any{"ref": base},
+				"head":     map[string]any{"ref": "feature", "sha": headSHA},
+				"merged":   merged,
+			},
+			"sender": map[string]any{"email": "octocat@example.com"},
+		})
+		require.NoError(t, err)
+		return body
+	}
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		action string
+		merged bool
+		base   string
+	}{
+		// The closed pull requests without merge and the edits are skipped.
+		{action: "closed", merged: false, base: "main"},
+		{action: "edited", merged: false, base: "main"},
+		// The pull requests to other branches are skipped.
+		{action: "opened", merged: false, base: "dev"},
+	} {
+		_, err := getGiteaPullRequestInfo(ctx, vcsProvider, vcsConnector, payload(test.action, test.merged, test.base))
+		require.Error(t, err, test.action)
+	}
+
+	for _, test := range []struct {
+		action string
+		merged bool
+	}{
+		{action: "opened", merged: false},
+		{action: giteaSynchronizedAction, merged: false},
+		{action: "closed", merged: true},
+	} {
+		got, err := getGiteaPullRequestInfo(ctx, vcsProvider, vcsConnector, payload(test.action, test.merged, "main"))
+		require.NoError(t, err, test.action)
+		require.Equal(t, "octocat@example.com", got.email)
+		require.Equal(t, "https://gitea.example.com/octocat/hello/pulls/3", got.url)
+		require.Equal(t, "Create table t", got.title)
+		require.Equal(t, "Create the table t.", got.description)
+		require.Equal(t, headSHA, got.commitID)
+		require.Equal(t, test.merged, got.merged)
+		// The deleted files and the files out of the base directory are skipped.
+		require.Len(t, got.changes, 1)
+		require.Equal(t, "bytebase/202401010000_create_t.sql", got.changes[0].path)
+		require.Equal(t, "202401010000", got.changes[0].version)
+		require.Equal(t, v1pb.Plan_ChangeDatabaseConfig_MIGRATE, got.changes[0].changeType)
+		require.Equal(t, "CREATE TABLE t(id INT);", got.changes[0].content)
+	}
+}
+
+func TestGetGiteaPullRequestStateChange(t *testing.T) {
+	payload := func(action string, merged bool) []byte {
+		body, err := json.Marshal(map[string]any{
+			"action":       action,
+			"number":       3,
+			"pull_request": map[string]any{"html_url": "https://gitea.example.com/octocat/hello/pulls/3", "merged": merged},
+			"sender":       map[string]any{"email": "octocat@example.com"},
+		})
+		require.NoError(t, err)
+		return body
+	}
+
+	require.Equal(t, &pullRequestStateChange{email: "octocat@example.com", url: "https://gitea.example.com/octocat/hello/pulls/3", closed: true}, getGiteaPullRequestStateChange(payload("closed", false)))
+	require.Equal(t, &pullRequestStateChange{email: "octocat@example.com", url: "https://gitea.example.com/octocat/hello/pulls/3"}, getGiteaPullRequestStateChange(payload("reopened", false)))
+	// The merge and the other actions are handled as the pull request info.
+	require.Nil(t, getGiteaPullRequestStateChange(payload("closed", true)))
+	require.Nil(t, getGiteaPullRequestStateChange(payload("opened", false)))
+	require.Nil(t, getGiteaPullRequestStateChange(payload(giteaSynchronizedAction, false)))
+}
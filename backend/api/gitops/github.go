@@ -14,15 +14,54 @@ import (
 )
 
 const (
-	closeAction = "closed"
+	closeAction       = "closed"
+	openAction        = "opened"
+	synchronizeAction = "synchronize"
+	reopenAction      = "reopened"
 )
 
+func getGitHubPushInfo(body []byte) (*pushInfo, error) {
+	var pushEvent github.PushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+	if pushEvent.Deleted {
+		return nil, errors.Errorf("skip the deletion of %q", pushEvent.Ref)
+	}
+	return &pushInfo{
+		email:   pushEvent.Pusher.Email,
+		ref:     pushEvent.Ref,
+		before:  pushEvent.Before,
+		after:   pushEvent.After,
+		message: pushEvent.HeadCommit.Message,
+		url:     pushEvent.Compare,
+	}, nil
+}
+
+// getGitHubPullRequestStateChange gets the close without merge or the reopen of the pull request, and returns nil for other actions.
+func getGitHubPullRequestStateChange(body []byte) *pullRequestStateChange {
+	var pushEvent github.PullRequestPushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil
+	}
+	switch {
+	case pushEvent.Action == closeAction && !pushEvent.PullRequest.Merged:
+		return &pullRequestStateChange{url: pushEvent.PullRequest.HTMLURL, closed: true}
+	case pushEvent.Action == reopenAction:
+		return &pullRequestStateChange{url: pushEvent.PullRequest.HTMLURL}
+	}
+	return nil
+}
+
 func getGitHubPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, body []byte) (*pullRequestInfo, error) {
 	var pushEvent github.PullRequestPushEvent
 	if err := json.Unmarshal(body, &pushEvent); err != nil {
 		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
 	}
-	if pushEvent.Action != closeAction || !pushEvent.PullRequest.Merged {
+	switch {
+	case pushEvent.Action == closeAction && pushEvent.PullRequest.Merged:
+	case pushEvent.Action == openAction || pushEvent.Action == synchronizeAction:
+	default:
 		return nil, errors.Errorf("skip webhook event action, got %s, want closed with merged", pushEvent.Action)
 	}
 
@@ -40,7 +79,9 @@ func getGitHubPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvide
 		url:         pushEvent.PullRequest.HTMLURL,
 		title:       pushEvent.PullRequest.Title,
 		description: pushEvent.PullRequest.Body,
-		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
+		commitID:    pushEvent.PullRequest.Head.SHA,
+		merged:      pushEvent.PullRequest.Merged,
+		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload),
 	}
 
 	for _, file := range prInfo.changes {
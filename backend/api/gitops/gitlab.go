@@ -16,8 +16,53 @@ import (
 const (
 	mergeRequestObjectKind = "merge_request"
 	mergeAction            = "merge"
+	gitlabOpenAction       = "open"
+	gitlabUpdateAction     = "update"
+	gitlabCloseAction      = "close"
+	gitlabReopenAction     = "reopen"
 )
 
+func getGitLabPushInfo(body []byte) (*pushInfo, error) {
+	var pushEvent gitlab.WebhookPushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+	if pushEvent.ObjectKind != gitlab.WebhookPush && pushEvent.ObjectKind != gitlab.WebhookTagPush {
+		return nil, errors.Errorf("skip webhook event type, got %s, want push or tag_push", pushEvent.ObjectKind)
+	}
+	info := &pushInfo{
+		email:  pushEvent.UserEmail,
+		ref:    pushEvent.Ref,
+		before: pushEvent.Before,
+		after:  pushEvent.After,
+		url:    fmt.Sprintf("%s/-/commit/%s", pushEvent.Project.WebURL, pushEvent.After),
+	}
+	// The commits are in chronological order.
+	if n := len(pushEvent.CommitList); n > 0 {
+		info.message = pushEvent.CommitList[n-1].Message
+		info.url = pushEvent.CommitList[n-1].URL
+	}
+	return info, nil
+}
+
+// getGitLabPullRequestStateChange gets the close or the reopen of the merge request, and returns nil for other actions.
+func getGitLabPullRequestStateChange(body []byte) *pullRequestStateChange {
+	var pushEvent gitlab.MergeRequestPushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil
+	}
+	if pushEvent.ObjectKind != mergeRequestObjectKind {
+		return nil
+	}
+	switch pushEvent.ObjectAttributes.Action {
+	case gitlabCloseAction:
+		return &pullRequestStateChange{email: pushEvent.User.Email, url: pushEvent.ObjectAttributes.URL, closed: true}
+	case gitlabReopenAction:
+		return &pullRequestStateChange{email: pushEvent.User.Email, url: pushEvent.ObjectAttributes.URL}
+	}
+	return nil
+}
+
 func getGitLabPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, body []byte) (*pullRequestInfo, error) {
 	var pushEvent gitlab.MergeRequestPushEvent
 	if err := json.Unmarshal(body, &pushEvent); err != nil {
@@ -26,8 +71,11 @@ func getGitLabPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvide
 	if pushEvent.ObjectKind != mergeRequestObjectKind {
 		return nil, errors.Errorf("skip webhook event type, got %s, want push", pushEvent.ObjectKind)
 	}
-	if pushEvent.ObjectAttributes.Action != mergeAction {
-		return nil, errors.Errorf("skip webhook event action, got %s, want merge", pushEvent.ObjectAttributes.Action)
+	switch action := pushEvent.ObjectAttributes.Action; {
+	case action == mergeAction:
+	case action == gitlabOpenAction || action == gitlabUpdateAction:
+	default:
+		return nil, errors.Errorf("skip webhook event action, got %s, want merge", action)
 	}
 
 	if pushEvent.ObjectAttributes.TargetBranch != vcsConnector.Payload.Branch {
@@ -44,7 +92,9 @@ func getGitLabPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvide
 		url:         pushEvent.ObjectAttributes.URL,
 		title:       pushEvent.ObjectAttributes.Title,
 		description: pushEvent.ObjectAttributes.Description,
-		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
+		commitID:    pushEvent.ObjectAttributes.LastCommit.ID,
+		merged:      pushEvent.ObjectAttributes.Action == mergeAction,
+		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload),
 	}
 
 	for _, file := range prInfo.changes {
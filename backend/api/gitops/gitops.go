@@ -6,6 +6,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	"github.com/bytebase/bytebase/backend/store"
 )
@@ -20,6 +21,7 @@ type Service struct {
 	rolloutService *v1pb.RolloutService
 	issueService   *v1pb.IssueService
 	sheetManager   *sheet.Manager
+	webhookManager *webhook.Manager
 }
 
 // NewService creates a GitOps service.
@@ -32,6 +34,7 @@ func NewService(
 	rolloutService *v1pb.RolloutService,
 	issueService *v1pb.IssueService,
 	sheetManager *sheet.Manager,
+	webhookManager *webhook.Manager,
 ) *Service {
 	return &Service{
 		store:          store,
@@ -42,5 +45,6 @@ func NewService(
 		rolloutService: rolloutService,
 		issueService:   issueService,
 		sheetManager:   sheetManager,
+		webhookManager: webhookManager,
 	}
 }
@@ -0,0 +1,303 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/django"
+	"github.com/bytebase/bytebase/backend/plugin/parser/liquibase"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	// flywayRE matches the Flyway versioned migrations V<VERSION>__<DESC>.sql and the repeatable migrations R__<DESC>.sql.
+	// The undo migrations U<VERSION>__<DESC>.sql are not rolled out.
+	flywayRE = regexp.MustCompile(`^(?:V([0-9][0-9._]*)|R)__(.+)\.sql$`)
+	// djangoRE matches the Django migrations <APP>/migrations/<NUMBER>_<NAME>.py.
+	djangoRE = regexp.MustCompile(`(?:^|/)(\w+)/migrations/([0-9]+)_(\w+)\.py$`)
+	// prismaRE matches the Prisma migrations <TIMESTAMP>_<NAME>/migration.sql.
+	prismaRE = regexp.MustCompile(`^([0-9]+)_(.+)$`)
+)
+
+// migrationFormatCommentMarker marks the pull request comment of the warnings of converting the migration files.
+const migrationFormatCommentMarker = "<!-- bytebase-migration-format-warning -->"
+
+// getMigrationFormatChangesByFileList gets the changes from the migration files of the ORM or migration tool,
+// which may be in any subdirectory of the base directory.
+// The contents of the changes are converted by getMigrationFormatChanges after they are read.
+func getMigrationFormatChangesByFileList(files []*vcs.PullRequestFile, vcsConnector *storepb.VCSConnector) []*fileChange {
+	changes := []*fileChange{}
+	for _, v := range files {
+		if v.IsDeleted || !vcs.IsUnderDirectory(v.Path, vcsConnector.BaseDirectory) {
+			continue
+		}
+		filename := filepath.Base(v.Path)
+		change := &fileChange{path: v.Path, changeType: v1pb.Plan_ChangeDatabaseConfig_MIGRATE}
+		switch vcsConnector.MigrationFormat {
+		case storepb.VCSConnector_FLYWAY:
+			matches := flywayRE.FindStringSubmatch(filename)
+			if matches == nil {
+				continue
+			}
+			// The version of the repeatable migrations is set from the checksum of the content.
+			change.version = strings.ReplaceAll(matches[1], "_", ".")
+			change.description = strings.ReplaceAll(matches[2], "_", " ")
+			change.skipApplied = change.version == ""
+		case storepb.VCSConnector_LIQUIBASE:
+			switch strings.ToLower(filepath.Ext(filename)) {
+			case ".xml", ".yaml", ".yml", ".json", ".sql":
+			default:
+				continue
+			}
+			// The changelog is expanded to the changeSets.
+		case storepb.VCSConnector_DJANGO:
+			matches := djangoRE.FindStringSubmatch(v.Path)
+			if matches == nil {
+				continue
+			}
+			change.version = fmt.Sprintf("%s_%s", matches[1], matches[2])
+			change.description = matches[3]
+			// The migrations of different apps are not ordered by their numbers.
+			change.skipApplied = true
+		case storepb.VCSConnector_PRISMA:
+			if filename != "migration.sql" || filepath.Dir(filepath.Dir("/"+strings.TrimPrefix(v.Path, "/"))) != vcsConnector.BaseDirectory {
+				continue
+			}
+			matches := prismaRE.FindStringSubmatch(filepath.Base(filepath.Dir(v.Path)))
+			if matches == nil {
+				continue
+			}
+			change.version = matches[1]
+			change.description = matches[2]
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	// The versioned migrations are applied in the order of their versions, followed by the repeatable migrations.
+	sort.SliceStable(changes, func(i, j int) bool {
+		if vcsConnector.MigrationFormat == storepb.VCSConnector_LIQUIBASE {
+			return false
+		}
+		if changes[i].skipApplied != changes[j].skipApplied {
+			return !changes[i].skipApplied
+		}
+		if changes[i].skipApplied {
+			return changes[i].path < changes[j].path
+		}
+		return compareMigrationVersions(changes[i].version, changes[j].version) < 0
+	})
+	return changes
+}
+
+// getMigrationFormatChanges converts the contents of the migration files of the ORM or migration tool to the statements.
+// The Liquibase changelogs are expanded to their changeSets for the dbms of the target databases, and each changeSet is versioned
+// by its id and author.
+func (s *Service) getMigrationFormatChanges(ctx context.Context, project *store.ProjectMessage, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo) error {
+	switch vcsConnector.Payload.MigrationFormat {
+	case storepb.VCSConnector_FLYWAY:
+		for _, change := range prInfo.changes {
+			if change.version == "" {
+				// The repeatable migration is rolled out again whenever its content changes.
+				change.version = fmt.Sprintf("R__%s_%s", strings.ReplaceAll(change.description, " ", "_"), getChecksum(change.content)[:8])
+			}
+		}
+	case storepb.VCSConnector_DJANGO:
+		var changes []*fileChange
+		for _, change := range prInfo.changes {
+			migration, err := django.Parse(change.content)
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse Django migration %q", change.path)
+			}
+			if len(migration.SkippedOperations) > 0 {
+				prInfo.warnings = append(prInfo.warnings, fmt.Sprintf("The operations of Django migration %q are skipped, only RunSQL is rolled out: %s.", change.path, strings.Join(migration.SkippedOperations, ", ")))
+			}
+			if len(migration.Statements) == 0 {
+				continue
+			}
+			change.content = strings.Join(migration.Statements, ";\n") + ";"
+			changes = append(changes, change)
+		}
+		prInfo.changes = changes
+	case storepb.VCSConnector_LIQUIBASE:
+		provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+		refInfo := vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: prInfo.commitID}
+		if prInfo.commitID == "" {
+			refInfo = vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: vcsConnector.Payload.Branch}
+		}
+		readFile := func(filePath string, relativeToChangelogFile bool) (string, error) {
+			if !relativeToChangelogFile {
+				// The base directory is the search path of the changelogs.
+				filePath = strings.TrimPrefix(path.Join(vcsConnector.Payload.BaseDirectory, filePath), "/")
+			}
+			content, err := provider.ReadFileContent(ctx, vcsConnector.Payload.ExternalId, filePath, refInfo)
+			if err != nil {
+				return "", err
+			}
+			return convertFileContentToUTF8String(content), nil
+		}
+
+		var changes []*fileChange
+		for _, change := range prInfo.changes {
+			// The changeSets are generated for the dbms of each target database.
+			dbmsList, err := s.getChangeDBMSList(ctx, project, vcsConnector, change)
+			if err != nil {
+				return err
+			}
+			changeSets, err := liquibase.ParseChangeLogForDBMSList(change.path, change.content, dbmsList, readFile)
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse Liquibase changelog %q", change.path)
+			}
+			for _, changeSet := range changeSets {
+				description := changeSet.Comment
+				if description == "" {
+					description = changeSet.ID
+				}
+				changes = append(changes, &fileChange{
+					path:        fmt.Sprintf("%s::%s::%s", change.path, changeSet.ID, changeSet.Author),
+					version:     fmt.Sprintf("%s::%s", changeSet.ID, changeSet.Author),
+					changeType:  v1pb.Plan_ChangeDatabaseConfig_MIGRATE,
+					description: description,
+					content:     changeSet.Statement,
+					// The changelog contains the changeSets applied already.
+					skipApplied: true,
+					dbms:        changeSet.DBMS,
+				})
+			}
+		}
+		prInfo.changes = changes
+	}
+	return nil
+}
+
+// isVersionApplied returns true if the version is applied to the database successfully.
+func (s *Service) isVersionApplied(ctx context.Context, database *store.DatabaseMessage, version string) (bool, error) {
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get instance %q", database.InstanceID)
+	}
+	if instance == nil {
+		return false, nil
+	}
+	status := db.Done
+	limit := 1
+	histories, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		InstanceID: &instance.UID,
+		DatabaseID: &database.UID,
+		Status:     &status,
+		Version:    &model.Version{Version: version},
+		Limit:      &limit,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list change history of database %q", database.DatabaseName)
+	}
+	return len(histories) > 0, nil
+}
+
+// isVersionAppliedToAll returns true if the version of the change is applied to all its target databases.
+func (s *Service) isVersionAppliedToAll(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage, change *fileChange) (bool, error) {
+	databases, err := s.getChangeDatabases(ctx, project, vcsConnector, change)
+	if err != nil {
+		return false, err
+	}
+	for _, database := range databases {
+		applied, err := s.isVersionApplied(ctx, database, change.version)
+		if err != nil {
+			return false, err
+		}
+		if !applied {
+			return false, nil
+		}
+	}
+	return len(databases) > 0, nil
+}
+
+// reportMigrationFormatWarnings reports the warnings of converting the migration files on the pull request,
+// or logs them for the pushes without pull requests.
+func (s *Service) reportMigrationFormatWarnings(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo) {
+	if len(prInfo.warnings) == 0 {
+		return
+	}
+	if prInfo.push {
+		for _, warning := range prInfo.warnings {
+			slog.Warn(warning, slog.String("vcs_connector", vcsConnector.ResourceID), slog.String("commit", prInfo.commitID))
+		}
+		return
+	}
+	var buf strings.Builder
+	_, _ = buf.WriteString(migrationFormatCommentMarker)
+	_, _ = buf.WriteString("\nBytebase Bot: ⚠️ the following migrations are not rolled out as they are.\n\n")
+	for _, warning := range prInfo.warnings {
+		_, _ = fmt.Fprintf(&buf, "- %s\n", warning)
+	}
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	if err := vcs.UpsertPullRequestComment(ctx, provider, vcsConnector.Payload.ExternalId, getPullRequestID(prInfo.url), migrationFormatCommentMarker, buf.String()); err != nil {
+		slog.Error("failed to comment the migration format warnings", slog.String("pull_request", prInfo.url), log.BBError(err))
+	}
+}
+
+// getChangeDBMSList gets the distinct Liquibase dbms of the target databases of the changelog in order.
+func (s *Service) getChangeDBMSList(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage, change *fileChange) ([]string, error) {
+	databases, err := s.getChangeDatabases(ctx, project, vcsConnector, change)
+	if err != nil {
+		return nil, err
+	}
+	var dbmsList []string
+	seen := map[string]bool{}
+	for _, database := range databases {
+		dbms, err := s.getDatabaseDBMS(ctx, database)
+		if err != nil {
+			return nil, err
+		}
+		if dbms == "" || seen[dbms] {
+			continue
+		}
+		seen[dbms] = true
+		dbmsList = append(dbmsList, dbms)
+	}
+	sort.Strings(dbmsList)
+	return dbmsList, nil
+}
+
+// getDatabaseDBMS gets the Liquibase dbms of the database, or empty if its instance is not found.
+func (s *Service) getDatabaseDBMS(ctx context.Context, database *store.DatabaseMessage) (string, error) {
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get instance %q", database.InstanceID)
+	}
+	if instance == nil {
+		return "", nil
+	}
+	return getLiquibaseDBMS(instance.Engine), nil
+}
+
+// getLiquibaseDBMS gets the database type name used by the dbms attributes of Liquibase.
+func getLiquibaseDBMS(engine storepb.Engine) string {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		return "mysql"
+	case storepb.Engine_MARIADB:
+		return "mariadb"
+	case storepb.Engine_POSTGRES, storepb.Engine_RISINGWAVE:
+		return "postgresql"
+	case storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE:
+		return "oracle"
+	default:
+		return strings.ToLower(engine.String())
+	}
+}
@@ -0,0 +1,62 @@
+package gitops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetMigrationFormatChangesByFileList(t *testing.T) {
+	tests := []struct {
+		format   storepb.VCSConnector_MigrationFormat
+		files    []string
+		versions []string
+	}{
+		{
+			format: storepb.VCSConnector_FLYWAY,
+			files: []string{
+				"db/migration/R__views.sql",
+				"db/migration/V1.10__add_index.sql",
+				"db/migration/sub/V1_2__add_column.sql",
+				"db/migration/U1.2__undo.sql",
+				"db/migration/V3__Migration.java",
+				"other/V4__outside.sql",
+			},
+			versions: []string{"1.2", "1.10", ""},
+		},
+		{
+			format: storepb.VCSConnector_PRISMA,
+			files: []string{
+				"db/migration/20240201000000_add_email/migration.sql",
+				"db/migration/20240101000000_init/migration.sql",
+				"db/migration/migration_lock.toml",
+				"db/migration/20240101000000_init/other.sql",
+			},
+			versions: []string{"20240101000000", "20240201000000"},
+		},
+		{
+			format: storepb.VCSConnector_DJANGO,
+			files: []string{
+				"db/migration/accounts/migrations/0002_add_email.py",
+				"db/migration/accounts/migrations/__init__.py",
+				"db/migration/accounts/models.py",
+			},
+			versions: []string{"accounts_0002"},
+		},
+	}
+	for _, test := range tests {
+		var files []*vcs.PullRequestFile
+		for _, path := range test.files {
+			files = append(files, &vcs.PullRequestFile{Path: path})
+		}
+		changes := getMigrationFormatChangesByFileList(files, &storepb.VCSConnector{BaseDirectory: "/db/migration", MigrationFormat: test.format})
+		var versions []string
+		for _, change := range changes {
+			versions = append(versions, change.version)
+		}
+		assert.Equal(t, test.versions, versions, test.format.String())
+	}
+}
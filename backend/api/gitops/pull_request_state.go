@@ -0,0 +1,75 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// pullRequestStateChange is the close without merge or the reopen of a pull request.
+type pullRequestStateChange struct {
+	email string
+	url   string
+	// closed is true if the pull request is closed without merge, and false if it is reopened.
+	closed bool
+}
+
+// syncIssueStatus cancels the open issues created from the pull request closed without merge,
+// and reopens the canceled issues when the pull request is reopened.
+// It returns the number of the updated issues.
+func (s *Service) syncIssueStatus(ctx context.Context, project *store.ProjectMessage, change *pullRequestStateChange) (int, error) {
+	if change.url == "" {
+		return 0, nil
+	}
+	plans, err := s.store.ListPlans(ctx, &store.FindPlanMessage{ProjectID: &project.ResourceID, PullRequestURL: &change.url})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to list plans for pull request %q", change.url)
+	}
+
+	fromStatus, toStatus := api.IssueCanceled, api.IssueOpen
+	comment := fmt.Sprintf("The pull request %s is reopened.", change.url)
+	if change.closed {
+		fromStatus, toStatus = api.IssueOpen, api.IssueCanceled
+		comment = fmt.Sprintf("The pull request %s is closed without merge.", change.url)
+	}
+	updater := s.getUserByEmail(ctx, change.email)
+
+	count := 0
+	for _, plan := range plans {
+		issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PlanUID: &plan.UID})
+		if err != nil {
+			return count, errors.Wrapf(err, "failed to get issue for plan %d", plan.UID)
+		}
+		if issue == nil || issue.Status != fromStatus {
+			continue
+		}
+		if err := webhook.ChangeIssueStatus(ctx, s.store, s.webhookManager, issue, toStatus, updater, comment); err != nil {
+			return count, errors.Wrapf(err, "failed to change the status of issue %d", issue.UID)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// getUserByEmail gets the user by the email, and falls back to the system bot if the user is not found.
+func (s *Service) getUserByEmail(ctx context.Context, email string) *store.UserMessage {
+	if email == "" {
+		return s.store.GetSystemBotUser(ctx)
+	}
+	user, err := s.store.GetUserByEmail(ctx, email)
+	if err != nil {
+		slog.Error("failed to find user by email", slog.String("email", email), log.BBError(err))
+		return s.store.GetSystemBotUser(ctx)
+	}
+	if user == nil {
+		return s.store.GetSystemBotUser(ctx)
+	}
+	return user
+}
@@ -0,0 +1,139 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	branchRefPrefix = "refs/heads/"
+	tagRefPrefix    = "refs/tags/"
+	// zeroCommitID is the commit of the side of a push that creates or deletes a ref.
+	zeroCommitID = "0000000000000000000000000000000000000000"
+)
+
+// pushInfo is the information of a push event of a branch or a tag.
+type pushInfo struct {
+	email string
+	// ref is the full git ref, e.g. refs/heads/main, refs/tags/v1.0.0.
+	ref    string
+	before string
+	after  string
+	// message is the message of the head commit.
+	message string
+	// url is the URL to view the pushed commits.
+	url string
+}
+
+// getPushRequestInfo gets the changes of the push event.
+// A push to the branch of the VCS connector creates a rollout for the files changed by the push if the push trigger is enabled.
+// A tag matching the tag pattern creates a rollout for the files changed since the last released tag,
+// and the first matching tag is recorded as the baseline.
+func (s *Service) getPushRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, push *pushInfo) (*pullRequestInfo, error) {
+	baseCommitID, tag, baseline, err := getPushBase(vcsConnector.Payload, push)
+	if err != nil {
+		return nil, err
+	}
+	if baseline {
+		if err := s.updateLastReleaseCommitID(ctx, vcsConnector, push.after); err != nil {
+			return nil, err
+		}
+		return nil, errors.Errorf("recorded tag %q as the baseline release, the following tags matching %q will create rollouts", tag, vcsConnector.Payload.TagPattern)
+	}
+
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	files, err := provider.CompareCommits(ctx, vcsConnector.Payload.ExternalId, baseCommitID, push.after)
+	if err != nil {
+		return nil, errors.Errorf("failed to compare commits %q and %q, error %v", baseCommitID, push.after, err)
+	}
+
+	title := strings.SplitN(strings.TrimSpace(push.message), "\n", 2)[0]
+	if tag != "" {
+		title = fmt.Sprintf("Release %s", tag)
+	}
+	prInfo := &pullRequestInfo{
+		email:       push.email,
+		title:       title,
+		description: fmt.Sprintf("%s\n\n%s", strings.TrimSpace(push.message), push.url),
+		url:         push.url,
+		commitID:    push.after,
+		merged:      true,
+		push:        true,
+		tag:         tag,
+		changes:     getChangesByFileList(files, vcsConnector.Payload),
+	}
+	for _, file := range prInfo.changes {
+		content, err := provider.ReadFileContent(ctx, vcsConnector.Payload.ExternalId, file.path, vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: push.after})
+		if err != nil {
+			return nil, errors.Errorf("failed read file content, commit %q, file %q, error %v", push.after, file.path, err)
+		}
+		file.content = convertFileContentToUTF8String(content)
+	}
+	return prInfo, nil
+}
+
+// getPushBase gets the base commit to compare the pushed commit with, and the tag of the push.
+// The baseline is true if the push is the first tag matching the tag pattern, which is recorded as the baseline release
+// instead of creating a rollout.
+func getPushBase(vcsConnector *storepb.VCSConnector, push *pushInfo) (baseCommitID string, tag string, baseline bool, err error) {
+	if push.after == "" || push.after == zeroCommitID {
+		return "", "", false, errors.Errorf("skip the deletion of %q", push.ref)
+	}
+
+	switch {
+	case strings.HasPrefix(push.ref, branchRefPrefix):
+		branch := strings.TrimPrefix(push.ref, branchRefPrefix)
+		if !vcsConnector.PushTrigger {
+			return "", "", false, errors.Errorf("skip the push to branch %q, the push trigger is disabled", branch)
+		}
+		if branch != vcsConnector.Branch {
+			return "", "", false, errors.Errorf("skip branch, got %q, want %q", branch, vcsConnector.Branch)
+		}
+		if push.before == "" || push.before == zeroCommitID {
+			return "", "", false, errors.Errorf("skip the creation of branch %q", branch)
+		}
+		if push.before == push.after {
+			return "", "", false, errors.Errorf("skip the push to branch %q without commits", branch)
+		}
+		return push.before, "", false, nil
+	case strings.HasPrefix(push.ref, tagRefPrefix):
+		tag := strings.TrimPrefix(push.ref, tagRefPrefix)
+		pattern := vcsConnector.TagPattern
+		if pattern == "" {
+			return "", "", false, errors.Errorf("skip tag %q, the tag pattern is not set", tag)
+		}
+		if ok, err := path.Match(pattern, tag); err != nil || !ok {
+			return "", "", false, errors.Errorf("skip tag %q not matching the tag pattern %q", tag, pattern)
+		}
+		if vcsConnector.LastReleaseCommitId == "" {
+			return "", tag, true, nil
+		}
+		if vcsConnector.LastReleaseCommitId == push.after {
+			return "", "", false, errors.Errorf("skip tag %q on the last released commit %q", tag, push.after)
+		}
+		return vcsConnector.LastReleaseCommitId, tag, false, nil
+	default:
+		return "", "", false, errors.Errorf("skip unknown ref %q", push.ref)
+	}
+}
+
+func (s *Service) updateLastReleaseCommitID(ctx context.Context, vcsConnector *store.VCSConnectorMessage, commitID string) error {
+	if err := s.store.UpdateVCSConnector(ctx, &store.UpdateVCSConnectorMessage{
+		ProjectID:           vcsConnector.ProjectID,
+		UpdaterID:           api.SystemBotID,
+		UID:                 vcsConnector.UID,
+		LastReleaseCommitID: &commitID,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update the last release commit of VCS connector %q", vcsConnector.ResourceID)
+	}
+	return nil
+}
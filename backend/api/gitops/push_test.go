@@ -0,0 +1,205 @@
+package gitops
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	testBeforeCommitID = "4b1f6a0e2c1d3f5a7b9c0d2e4f6a8b0c1d3e5f7a"
+	testAfterCommitID  = "9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c"
+)
+
+func TestGetPushBase(t *testing.T) {
+	tests := []struct {
+		description   string
+		vcsConnector  *storepb.VCSConnector
+		push          *pushInfo
+		wantBase      string
+		wantTag       string
+		wantBaseline  bool
+		wantSkipError bool
+	}{
+		{
+			description:  "push to the branch",
+			vcsConnector: &storepb.VCSConnector{Branch: "main", PushTrigger: true},
+			push:         &pushInfo{ref: "refs/heads/main", before: testBeforeCommitID, after: testAfterCommitID},
+			wantBase:     testBeforeCommitID,
+		},
+		{
+			description:   "push trigger disabled",
+			vcsConnector:  &storepb.VCSConnector{Branch: "main"},
+			push:          &pushInfo{ref: "refs/heads/main", before: testBeforeCommitID, after: testAfterCommitID},
+			wantSkipError: true,
+		},
+		{
+			description:   "push to other branches",
+			vcsConnector:  &storepb.VCSConnector{Branch: "main", PushTrigger: true},
+			push:          &pushInfo{ref: "refs/heads/dev", before: testBeforeCommitID, after: testAfterCommitID},
+			wantSkipError: true,
+		},
+		{
+			description:   "branch creation",
+			vcsConnector:  &storepb.VCSConnector{Branch: "main", PushTrigger: true},
+			push:          &pushInfo{ref: "refs/heads/main", before: zeroCommitID, after: testAfterCommitID},
+			wantSkipError: true,
+		},
+		{
+			description:   "branch deletion",
+			vcsConnector:  &storepb.VCSConnector{Branch: "main", PushTrigger: true},
+			push:          &pushInfo{ref: "refs/heads/main", before: testBeforeCommitID, after: zeroCommitID},
+			wantSkipError: true,
+		},
+		{
+			description:   "push without commits",
+			vcsConnector:  &storepb.VCSConnector{Branch: "main", PushTrigger: true},
+			push:          &pushInfo{ref: "refs/heads/main", before: testAfterCommitID, after: testAfterCommitID},
+			wantSkipError: true,
+		},
+		{
+			description:  "first tag as the baseline",
+			vcsConnector: &storepb.VCSConnector{Branch: "main", TagPattern: "v*"},
+			push:         &pushInfo{ref: "refs/tags/v1.0.0", before: zeroCommitID, after: testAfterCommitID},
+			wantTag:      "v1.0.0",
+			wantBaseline: true,
+		},
+		{
+			description:  "tag after the baseline",
+			vcsConnector: &storepb.VCSConnector{Branch: "main", TagPattern: "v*", LastReleaseCommitId: testBeforeCommitID},
+			push:         &pushInfo{ref: "refs/tags/v1.1.0", before: zeroCommitID, after: testAfterCommitID},
+			wantBase:     testBeforeCommitID,
+			wantTag:      "v1.1.0",
+		},
+		{
+			description:   "tag on the last released commit",
+			vcsConnector:  &storepb.VCSConnector{Branch: "main", TagPattern: "v*", LastReleaseCommitId: testAfterCommitID},
+			push:          &pushInfo{ref: "refs/tags/v1.1.0", before: zeroCommitID, after: testAfterCommitID},
+			wantSkipError: true,
+		},
+		{
+			description:   "tag not matching the pattern",
+			vcsConnector:  &storepb.VCSConnector{Branch: "main", TagPattern: "v*"},
+			push:          &pushInfo{ref: "refs/tags/release-1", before: zeroCommitID, after: testAfterCommitID},
+			wantSkipError: true,
+		},
+		{
+			description:   "tag without the pattern",
+			vcsConnector:  &storepb.VCSConnector{Branch: "main", PushTrigger: true},
+			push:          &pushInfo{ref: "refs/tags/v1.0.0", before: zeroCommitID, after: testAfterCommitID},
+			wantSkipError: true,
+		},
+		{
+			description:   "unknown ref",
+			vcsConnector:  &storepb.VCSConnector{Branch: "main", PushTrigger: true, TagPattern: "*"},
+			push:          &pushInfo{ref: "refs/notes/commits", before: testBeforeCommitID, after: testAfterCommitID},
+			wantSkipError: true,
+		},
+	}
+
+	for _, test := range tests {
+		base, tag, baseline, err := getPushBase(test.vcsConnector, test.push)
+		if test.wantSkipError {
+			require.Error(t, err, test.description)
+			continue
+		}
+		require.NoError(t, err, test.description)
+		require.Equal(t, test.wantBase, base, test.description)
+		require.Equal(t, test.wantTag, tag, test.description)
+		require.Equal(t, test.wantBaseline, baseline, test.description)
+	}
+}
+
+func TestGetPushRequestInfo(t *testing.T) {
+	var gotCompare string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/repos/octocat/hello/compare/" + testBeforeCommitID + "..." + testAfterCommitID:
+			gotCompare = r.URL.Path
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"commits": []map[string]any{
+					{"files": []map[string]string{
+						{"filename": "bytebase/202401010000_create_t.sql", "status": "added"},
+						{"filename": "bytebase/202401010001_drop_t.sql", "status": "added"},
+					}},
+					{"files": []map[string]string{
+						{"filename": "bytebase/202401010001_drop_t.sql", "status": "removed"},
+					}},
+				},
+			})
+		case "/api/v1/repos/octocat/hello/raw/bytebase/202401010000_create_t.sql":
+			require.Equal(t, testAfterCommitID, r.URL.Query().Get("ref"))
+			_, _ = w.Write([]byte("CREATE TABLE t(id INT);"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	s := &Service{}
+	vcsProvider := &store.VCSProviderMessage{Type: storepb.VCSType_GITEA, InstanceURL: server.URL, AccessToken: "token"}
+	ctx := context.Background()
+
+	// The push to the branch is compared with the commit before the push.
+	branchConnector := &store.VCSConnectorMessage{Payload: &storepb.VCSConnector{
+		ExternalId:    "octocat/hello",
+		Branch:        "main",
+		BaseDirectory: "/bytebase",
+		PushTrigger:   true,
+	}}
+	got, err := s.getPushRequestInfo(ctx, vcsProvider, branchConnector, &pushInfo{
+		email:   "octocat@example.com",
+		ref:     "refs/heads/main",
+		before:  testBeforeCommitID,
+		after:   testAfterCommitID,
+		message: "Add migration\n\nCreate the table t.",
+		url:     "https://gitea.example.com/octocat/hello/compare/4b1f6a0e2c1d...9c2e4a6b8d0f",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, gotCompare)
+	require.Equal(t, "Add migration", got.title)
+	require.Equal(t, testAfterCommitID, got.commitID)
+	require.True(t, got.push)
+	require.True(t, got.merged)
+	require.Empty(t, got.tag)
+	// The file added and then removed by the pushed commits is skipped.
+	require.Len(t, got.changes, 1)
+	require.Equal(t, "bytebase/202401010000_create_t.sql", got.changes[0].path)
+	require.Equal(t, "CREATE TABLE t(id INT);", got.changes[0].content)
+
+	// The tag is compared with the last released commit.
+	gotCompare = ""
+	tagConnector := &store.VCSConnectorMessage{Payload: &storepb.VCSConnector{
+		ExternalId:          "octocat/hello",
+		Branch:              "main",
+		BaseDirectory:       "/bytebase",
+		TagPattern:          "v*",
+		LastReleaseCommitId: testBeforeCommitID,
+	}}
+	got, err = s.getPushRequestInfo(ctx, vcsProvider, tagConnector, &pushInfo{
+		email:   "octocat@example.com",
+		ref:     "refs/tags/v1.1.0",
+		before:  zeroCommitID,
+		after:   testAfterCommitID,
+		message: "Add migration",
+		url:     "https://gitea.example.com/octocat/hello/commit/9c2e4a6b8d0f",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, gotCompare)
+	require.Equal(t, "Release v1.1.0", got.title)
+	require.Equal(t, "v1.1.0", got.tag)
+	require.Len(t, got.changes, 1)
+
+	// The skipped pushes are not compared.
+	gotCompare = ""
+	_, err = s.getPushRequestInfo(ctx, vcsProvider, branchConnector, &pushInfo{ref: "refs/heads/main", before: testAfterCommitID, after: testAfterCommitID})
+	require.Error(t, err)
+	require.Empty(t, gotCompare)
+}
@@ -0,0 +1,283 @@
+package gitops
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// migrationVersionCommentMarker marks the pull request comment of the migration version problems,
+// which is updated by the following checks of the pull request instead of posting new comments.
+const migrationVersionCommentMarker = "<!-- bytebase-migration-version-check -->"
+
+// appliedMigration is the migration applied to a database by GitOps.
+type appliedMigration struct {
+	version string
+	// checksum is only set for the versions used by the pull request.
+	checksum string
+}
+
+// migrationVersionProblem is the problem of the version of a migration file against a target database.
+type migrationVersionProblem struct {
+	path     string
+	database string
+	message  string
+}
+
+// checkMigrationVersions checks the versions of the migration files in the pull request against the change history
+// of every target database, and reports the problems in the commit status and the comment of the pull request.
+func (s *Service) checkMigrationVersions(ctx context.Context, project *store.ProjectMessage, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo) error {
+	databases := map[string]*store.DatabaseMessage{}
+	databaseChanges := map[string][]*fileChange{}
+	for _, change := range prInfo.changes {
+		if change.version == "" {
+			continue
+		}
+		targets, err := s.getChangeDatabases(ctx, project, vcsConnector, change)
+		if err != nil {
+			return err
+		}
+		for _, database := range targets {
+			name := common.FormatDatabase(database.InstanceID, database.DatabaseName)
+			databases[name] = database
+			databaseChanges[name] = append(databaseChanges[name], change)
+		}
+	}
+	var names []string
+	for name := range databases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []*migrationVersionProblem
+	for _, name := range names {
+		applied, err := s.listAppliedMigrations(ctx, databases[name], databaseChanges[name])
+		if err != nil {
+			return err
+		}
+		problems = append(problems, getMigrationVersionProblems(name, databaseChanges[name], applied)...)
+	}
+
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	commitStatus := &vcs.CommitStatus{
+		State:       vcs.CommitStateSuccess,
+		Context:     vcs.MigrationVersionCommitStatusContext,
+		Description: "The migration versions are valid",
+	}
+	if len(problems) > 0 {
+		commitStatus.State = vcs.CommitStateFailure
+		commitStatus.Description = fmt.Sprintf("Found %d migration version problems", len(problems))
+	}
+	if prInfo.commitID != "" {
+		if err := provider.SetCommitStatus(ctx, vcsConnector.Payload.ExternalId, prInfo.commitID, commitStatus); err != nil {
+			return errors.Wrapf(err, "failed to set commit status")
+		}
+	}
+
+	pullRequestID := getPullRequestID(prInfo.url)
+	var buf strings.Builder
+	_, _ = buf.WriteString(migrationVersionCommentMarker)
+	_, _ = buf.WriteString("\n")
+	if len(problems) == 0 {
+		// The comment of the previous problems is updated once they are fixed, and no comment is posted if there was no problem.
+		existing, err := vcs.FindPullRequestComment(ctx, provider, vcsConnector.Payload.ExternalId, pullRequestID, migrationVersionCommentMarker)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return nil
+		}
+		_, _ = buf.WriteString("Bytebase Bot: ✅ the migration versions in this pull request are valid.\n")
+		return vcs.UpsertPullRequestComment(ctx, provider, vcsConnector.Payload.ExternalId, pullRequestID, migrationVersionCommentMarker, buf.String())
+	}
+	_, _ = buf.WriteString("Bytebase Bot: the migration files in this pull request have the following version problems.\n\n")
+	for _, problem := range problems {
+		_, _ = fmt.Fprintf(&buf, "- ❌ `%s` on `%s`: %s\n", problem.path, problem.database, problem.message)
+	}
+	return vcs.UpsertPullRequestComment(ctx, provider, vcsConnector.Payload.ExternalId, pullRequestID, migrationVersionCommentMarker, buf.String())
+}
+
+// listAppliedMigrations lists the migrations applied to the database by the GitOps issues.
+// The checksums of the applied statements are only computed for the versions used by the changes.
+func (s *Service) listAppliedMigrations(ctx context.Context, database *store.DatabaseMessage, changes []*fileChange) ([]*appliedMigration, error) {
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %q", database.InstanceID)
+	}
+	if instance == nil {
+		return nil, nil
+	}
+	status := db.Done
+	histories, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		InstanceID: &instance.UID,
+		DatabaseID: &database.UID,
+		Status:     &status,
+		TypeList:   []db.MigrationType{db.Migrate, db.Data},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list change history of database %q", database.DatabaseName)
+	}
+
+	versions := map[string]bool{}
+	for _, change := range changes {
+		versions[change.version] = true
+	}
+	gitOpsIssues, err := s.listGitOpsIssues(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	var applied []*appliedMigration
+	for _, history := range histories {
+		if history.IssueUID == nil || history.Version.Version == "" {
+			continue
+		}
+		if !gitOpsIssues[*history.IssueUID] {
+			continue
+		}
+		migration := &appliedMigration{version: history.Version.Version}
+		if versions[migration.version] {
+			fullHistory, err := s.store.GetInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+				ID:         &history.UID,
+				InstanceID: &instance.UID,
+				DatabaseID: &database.UID,
+				ShowFull:   true,
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get change history %q", history.UID)
+			}
+			if fullHistory != nil {
+				migration.checksum = getChecksum(fullHistory.Statement)
+			}
+		}
+		applied = append(applied, migration)
+	}
+	return applied, nil
+}
+
+// listGitOpsIssues returns the UIDs of the issues changing the database which are created from the VCS connectors.
+func (s *Service) listGitOpsIssues(ctx context.Context, database *store.DatabaseMessage) (map[int]bool, error) {
+	issues, err := s.store.ListIssueV2(ctx, &store.FindIssueMessage{DatabaseUID: &database.UID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list issues of database %q", database.DatabaseName)
+	}
+	projectIDMap := map[string]bool{}
+	var projectIDs []string
+	for _, issue := range issues {
+		if issue.PlanUID == nil || projectIDMap[issue.Project.ResourceID] {
+			continue
+		}
+		projectIDMap[issue.Project.ResourceID] = true
+		projectIDs = append(projectIDs, issue.Project.ResourceID)
+	}
+	if len(projectIDs) == 0 {
+		return nil, nil
+	}
+	plans, err := s.store.ListPlans(ctx, &store.FindPlanMessage{ProjectIDs: &projectIDs})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list plans")
+	}
+	gitOpsPlans := map[int64]bool{}
+	for _, plan := range plans {
+		if plan.Config.GetVcsSource().GetVcsConnector() != "" {
+			gitOpsPlans[plan.UID] = true
+		}
+	}
+	gitOpsIssues := map[int]bool{}
+	for _, issue := range issues {
+		if issue.PlanUID != nil && gitOpsPlans[*issue.PlanUID] {
+			gitOpsIssues[issue.UID] = true
+		}
+	}
+	return gitOpsIssues, nil
+}
+
+// getMigrationVersionProblems finds the duplicate versions in the changes, the changes modifying the applied migrations,
+// and the changes with versions lower than the latest applied version.
+// The unmodified applied changes are not problems if they skip the applied versions, e.g. the Liquibase changeSets.
+func getMigrationVersionProblems(database string, changes []*fileChange, applied []*appliedMigration) []*migrationVersionProblem {
+	appliedVersions := map[string]*appliedMigration{}
+	latestVersion := ""
+	for _, migration := range applied {
+		appliedVersions[migration.version] = migration
+		if latestVersion == "" || compareMigrationVersions(migration.version, latestVersion) > 0 {
+			latestVersion = migration.version
+		}
+	}
+
+	var problems []*migrationVersionProblem
+	paths := map[string]string{}
+	for _, change := range changes {
+		newProblem := func(format string, a ...any) {
+			problems = append(problems, &migrationVersionProblem{path: change.path, database: database, message: fmt.Sprintf(format, a...)})
+		}
+		if path, ok := paths[change.version]; ok {
+			newProblem("version %q is also used by `%s`.", change.version, path)
+			continue
+		}
+		paths[change.version] = change.path
+
+		if migration, ok := appliedVersions[change.version]; ok {
+			if checksum := getChecksum(change.content); migration.checksum != "" && migration.checksum != checksum {
+				newProblem("version %q was applied with a different statement (checksum %s, applied %s), the applied migrations must not be modified.", change.version, checksum[:8], migration.checksum[:8])
+			} else if !change.skipApplied {
+				newProblem("version %q is already applied.", change.version)
+			}
+			continue
+		}
+		// The versions of the changes skipping the applied versions identify the changes rather than order them.
+		if change.skipApplied {
+			continue
+		}
+		if latestVersion != "" && compareMigrationVersions(change.version, latestVersion) < 0 {
+			newProblem("version %q is lower than the latest applied version %q.", change.version, latestVersion)
+		}
+	}
+	return problems
+}
+
+// compareMigrationVersions compares the versions segment by segment separated by dots, e.g. the Flyway versions.
+// The segments are compared numerically if both are numbers, otherwise lexicographically.
+func compareMigrationVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if c := compareVersionSegments(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareVersionSegments(a, b string) int {
+	if versionRE.FindString(a) == a && versionRE.FindString(b) == b {
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		if len(a) != len(b) {
+			if len(a) < len(b) {
+				return -1
+			}
+			return 1
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+func getChecksum(content string) string {
+	h := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(h[:])
+}
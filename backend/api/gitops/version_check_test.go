@@ -0,0 +1,73 @@
+package gitops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareMigrationVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1", "1", 0},
+		{"0002", "10", -1},
+		{"20240101", "9", 1},
+		{"001", "1", 0},
+		{"v1", "v2", -1},
+		{"1.2", "1.10", -1},
+		{"1.2.0", "1.2", 1},
+		{"2", "1.10", 1},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, compareMigrationVersions(test.a, test.b), "%s vs %s", test.a, test.b)
+	}
+}
+
+func TestGetMigrationVersionProblems(t *testing.T) {
+	applied := []*appliedMigration{
+		{version: "0001"},
+		{version: "0003", checksum: getChecksum("CREATE TABLE t3(id INT);")},
+		{version: "0004", checksum: getChecksum("CREATE TABLE t4(id INT);")},
+	}
+	changes := []*fileChange{
+		{path: "migrations/0002_t2.sql", version: "0002", content: "CREATE TABLE t2(id INT);"},
+		{path: "migrations/0003_t3.sql", version: "0003", content: "CREATE TABLE t3(id BIGINT);"},
+		{path: "migrations/0004_t4.sql", version: "0004", content: "CREATE TABLE t4(id INT);"},
+		{path: "migrations/0005_t5.sql", version: "0005", content: "CREATE TABLE t5(id INT);"},
+		{path: "migrations/0005_t6.sql", version: "0005", content: "CREATE TABLE t6(id INT);"},
+	}
+	problems := getMigrationVersionProblems("instances/i/databases/d", changes, applied)
+
+	var paths []string
+	for _, problem := range problems {
+		paths = append(paths, problem.path)
+	}
+	assert.Equal(t, []string{
+		"migrations/0002_t2.sql",
+		"migrations/0003_t3.sql",
+		"migrations/0004_t4.sql",
+		"migrations/0005_t6.sql",
+	}, paths)
+	assert.Contains(t, problems[0].message, "lower than the latest applied version")
+	assert.Contains(t, problems[1].message, "different statement")
+	assert.Contains(t, problems[2].message, "already applied")
+	assert.Contains(t, problems[3].message, "also used by")
+}
+
+func TestGetMigrationVersionProblemsSkipApplied(t *testing.T) {
+	applied := []*appliedMigration{
+		{version: "2::alice", checksum: getChecksum("CREATE TABLE t2(id INT);")},
+		{version: "3::alice", checksum: getChecksum("CREATE TABLE t3(id INT);")},
+	}
+	changes := []*fileChange{
+		{path: "changelog.xml::1::alice", version: "1::alice", content: "CREATE TABLE t1(id INT);", skipApplied: true},
+		{path: "changelog.xml::2::alice", version: "2::alice", content: "CREATE TABLE t2(id INT);", skipApplied: true},
+		{path: "changelog.xml::3::alice", version: "3::alice", content: "CREATE TABLE t3(id BIGINT);", skipApplied: true},
+	}
+	problems := getMigrationVersionProblems("instances/i/databases/d", changes, applied)
+	assert.Len(t, problems, 1)
+	assert.Equal(t, "changelog.xml::3::alice", problems[0].path)
+	assert.Contains(t, problems[0].message, "different statement")
+}
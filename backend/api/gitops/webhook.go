@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"crypto/subtle"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
@@ -17,11 +18,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/bytebase/bytebase/backend/utils"
 
 	"github.com/bytebase/bytebase/backend/common"
-	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
 
 	"github.com/bytebase/bytebase/backend/store"
@@ -81,6 +82,8 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 		}
 
 		var prInfo *pullRequestInfo
+		var push *pushInfo
+		var stateChange *pullRequestStateChange
 		switch vcsProvider.Type {
 		case storepb.VCSType_GITHUB:
 			secretToken := c.Request().Header.Get("X-Hub-Signature-256")
@@ -99,37 +102,65 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 			case "ping":
 				return c.String(http.StatusOK, "OK")
 			case "pull_request":
+				if stateChange = getGitHubPullRequestStateChange(body); stateChange != nil {
+					break
+				}
+				prInfo, err = getGitHubPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+				}
+			case "push":
+				push, err = getGitHubPushInfo(body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get push info from push event, error %v", err))
+				}
 			default:
 				return c.String(http.StatusOK, "OK")
 			}
-
-			prInfo, err = getGitHubPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
-			if err != nil {
-				return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
-			}
 		case storepb.VCSType_GITLAB:
 			secretToken := c.Request().Header.Get("X-Gitlab-Token")
 			if secretToken != vcsConnector.Payload.WebhookSecretToken {
 				return c.String(http.StatusOK, fmt.Sprintf("invalid webhook secret token %q", secretToken))
 			}
 
-			prInfo, err = getGitLabPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
-			if err != nil {
-				return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+			switch eventType := c.Request().Header.Get("X-Gitlab-Event"); eventType {
+			case "Push Hook", "Tag Push Hook":
+				push, err = getGitLabPushInfo(body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get push info from push event, error %v", err))
+				}
+			default:
+				if stateChange = getGitLabPullRequestStateChange(body); stateChange != nil {
+					break
+				}
+				prInfo, err = getGitLabPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+				}
 			}
 		case storepb.VCSType_BITBUCKET:
 			eventType := c.Request().Header.Get("X-Event-Key")
 			switch eventType {
-			case "pullrequest:created", "pullrequest:updated":
-				return c.String(http.StatusOK, "OK")
-			case "pullrequest:fulfilled":
+			case "pullrequest:created", "pullrequest:updated", "pullrequest:fulfilled":
+			case "pullrequest:rejected":
+				stateChange, err = getBitBucketPullRequestStateChange(body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+				}
+			case "repo:push":
+				push, err = getBitBucketPushInfo(body, vcsConnector.Payload.Branch)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get push info from push event, error %v", err))
+				}
 			default:
 				return c.String(http.StatusOK, "OK")
 			}
 
-			prInfo, err = getBitBucketPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
-			if err != nil {
-				return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+			if push == nil && stateChange == nil {
+				prInfo, err = getBitBucketPullRequestInfo(ctx, vcsProvider, vcsConnector, body, eventType == "pullrequest:fulfilled")
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+				}
 			}
 		case storepb.VCSType_AZURE_DEVOPS:
 			secretToken := c.Request().Header.Get("X-Azure-Token")
@@ -137,27 +168,140 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 				return c.String(http.StatusOK, fmt.Sprintf("invalid webhook secret token %q", secretToken))
 			}
 
-			prInfo, err = getAzurePullRequestInfo(ctx, vcsProvider, vcsConnector, body)
+			var event struct {
+				EventType string `json:"eventType"`
+			}
+			if err := json.Unmarshal(body, &event); err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to unmarshal event, error %v", err))
+			}
+			switch event.EventType {
+			case "git.push":
+				push, err = getAzurePushInfo(body, vcsConnector.Payload.Branch)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get push info from push event, error %v", err))
+				}
+			default:
+				prInfo, err = getAzurePullRequestInfo(ctx, vcsProvider, vcsConnector, body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+				}
+			}
+		case storepb.VCSType_GITEA:
+			// Forgejo sends both the X-Gitea-* and the X-Forgejo-* headers.
+			// The signature is the HMAC hex digested SHA256 hash of the body without the "sha256=" prefix.
+			signature := c.Request().Header.Get("X-Gitea-Signature")
+			ok, err := validateGitHubWebhookSignature256(signature, vcsConnector.Payload.WebhookSecretToken, body)
 			if err != nil {
-				return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+				return c.String(http.StatusOK, fmt.Sprintf("failed to validate webhook signature %q, error %v", signature, err))
+			}
+			if !ok {
+				return c.String(http.StatusOK, fmt.Sprintf("invalid webhook signature %q", signature))
+			}
+			switch c.Request().Header.Get("X-Gitea-Event") {
+			case "pull_request":
+				if stateChange = getGiteaPullRequestStateChange(body); stateChange != nil {
+					break
+				}
+				prInfo, err = getGiteaPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+				}
+			case "push":
+				push, err = getGiteaPushInfo(body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get push info from push event, error %v", err))
+				}
+			default:
+				return c.String(http.StatusOK, "OK")
 			}
 		default:
 			return nil
 		}
+		if stateChange != nil {
+			count, err := s.syncIssueStatus(ctx, project, stateChange)
+			if err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to sync the issue status for pull request %q, error %v", stateChange.url, err))
+			}
+			return c.String(http.StatusOK, fmt.Sprintf("updated the status of %d issues for pull request %q", count, stateChange.url))
+		}
+		if push != nil {
+			prInfo, err = s.getPushRequestInfo(ctx, vcsProvider, vcsConnector, push)
+			if err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("skip push event, %v", err))
+			}
+		} else if prInfo.merged && vcsConnector.Payload.PushTrigger {
+			return c.String(http.StatusOK, fmt.Sprintf("skip merged pull request %q, the changes are rolled out by the push to branch %q", prInfo.url, vcsConnector.Payload.Branch))
+		} else if prInfo.merged && vcsConnector.Payload.TagPattern != "" {
+			return c.String(http.StatusOK, fmt.Sprintf("skip merged pull request %q, the changes are rolled out by the tags matching %q", prInfo.url, vcsConnector.Payload.TagPattern))
+		}
 		if len(prInfo.changes) == 0 {
+			if format := vcsConnector.Payload.MigrationFormat; format != storepb.VCSConnector_MIGRATION_FORMAT_UNSPECIFIED {
+				return c.String(http.StatusOK, fmt.Sprintf("no relevant %s migration file change under the base directory %q for pull request %q", format.String(), vcsConnector.Payload.BaseDirectory, prInfo.url))
+			}
+			if template := vcsConnector.Payload.FilePathTemplate; template != "" {
+				return c.String(http.StatusOK, fmt.Sprintf("no relevant file change matching the file path template %q for pull request %q", template, prInfo.url))
+			}
 			return c.String(http.StatusOK, fmt.Sprintf("no relevant file change directly under the base directory %q for pull request %q", vcsConnector.Payload.BaseDirectory, prInfo.url))
 		}
+		if err := s.getMigrationFormatChanges(ctx, project, vcsProvider, vcsConnector, prInfo); err != nil {
+			return c.String(http.StatusOK, fmt.Sprintf("failed to convert the migration files of pull request %s, error %v", prInfo.url, err))
+		}
+		s.reportMigrationFormatWarnings(ctx, vcsProvider, vcsConnector, prInfo)
+		if len(prInfo.changes) == 0 {
+			return c.String(http.StatusOK, fmt.Sprintf("no change for the databases in the migration files of pull request %q", prInfo.url))
+		}
+		if vcsConnector.Payload.Declarative {
+			if err := s.getDeclarativeChanges(ctx, vcsProvider, vcsConnector, prInfo); err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to get declarative changes from pull request %s, error %v", prInfo.url, err))
+			}
+			if !prInfo.merged {
+				if err := s.commentDeclarativeChanges(ctx, project, vcsProvider, vcsConnector, prInfo); err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to comment the schema diff on pull request %s, error %v", prInfo.url, err))
+				}
+			}
+		} else if !prInfo.merged {
+			if err := s.checkMigrationVersions(ctx, project, vcsProvider, vcsConnector, prInfo); err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to check the migration versions of pull request %s, error %v", prInfo.url, err))
+			}
+		}
+		if !prInfo.merged {
+			// The plan checks of the open pull request are reported on the pull request by the plan check scheduler.
+			if err := s.createPlanToCheckPullRequest(ctx, project, vcsProvider, vcsConnector, prInfo, setting.ExternalUrl); err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to run plan checks for pull request %s, error %v", prInfo.url, err))
+			}
+			return nil
+		}
 		issue, err := s.createIssueFromPRInfo(ctx, project, vcsProvider, vcsConnector, prInfo)
 		if err != nil {
 			return c.String(http.StatusOK, fmt.Sprintf("failed to create issue from pull request %s, error %v", prInfo.url, err))
 		}
-		comment := getPullRequestComment(setting.ExternalUrl, issue.Name)
-		pullRequestID := getPullRequestID(prInfo.url)
-		if err := vcs.Get(
+		provider := vcs.Get(
 			vcsProvider.Type,
 			vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken},
-		).CreatePullRequestComment(ctx, vcsConnector.Payload.ExternalId, pullRequestID, comment); err != nil {
-			return c.String(http.StatusOK, fmt.Sprintf("failed to create pull request comment, error %v", err))
+		)
+		if prInfo.push {
+			if prInfo.tag != "" {
+				if err := s.updateLastReleaseCommitID(ctx, vcsConnector, prInfo.commitID); err != nil {
+					return c.String(http.StatusOK, err.Error())
+				}
+			}
+		} else {
+			comment := getPullRequestComment(setting.ExternalUrl, issue.Name)
+			pullRequestID := getPullRequestID(prInfo.url)
+			if err := provider.CreatePullRequestComment(ctx, vcsConnector.Payload.ExternalId, pullRequestID, comment); err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to create pull request comment, error %v", err))
+			}
+		}
+		// The plan check scheduler reports the results on the commit after the plan checks finish.
+		if prInfo.commitID != "" {
+			if err := provider.SetCommitStatus(ctx, vcsConnector.Payload.ExternalId, prInfo.commitID, &vcs.CommitStatus{
+				State:       vcs.CommitStatePending,
+				Context:     vcs.PlanCheckCommitStatusContext,
+				Description: "Bytebase plan checks are running",
+				TargetURL:   fmt.Sprintf("%s/%s", setting.ExternalUrl, issue.Name),
+			}); err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to set commit status, error %v", err))
+			}
 		}
 		return nil
 	})
@@ -180,65 +324,15 @@ func validateGitHubWebhookSignature256(signature, key string, body []byte) (bool
 }
 
 func (s *Service) createIssueFromPRInfo(ctx context.Context, project *store.ProjectMessage, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo) (*v1pb.Issue, error) {
-	user := func() *store.UserMessage {
-		user, err := s.store.GetUserByEmail(ctx, prInfo.email)
-		if err != nil {
-			slog.Error("failed to find user by email", slog.String("email", prInfo.email), log.BBError(err))
-			return s.store.GetSystemBotUser(ctx)
-		}
-		if user == nil {
-			return s.store.GetSystemBotUser(ctx)
-		}
-		return user
-	}()
-	creatorID := user.ID
+	user := s.getUserByEmail(ctx, prInfo.email)
 	creatorName := common.FormatUserUID(user.ID)
-
-	engine, err := s.getDatabaseEngineSample(ctx, project, vcsConnector)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get database engine")
-	}
-
-	var sheets []int
-	for _, change := range prInfo.changes {
-		sheet, err := s.sheetManager.CreateSheet(ctx, &store.SheetMessage{
-			CreatorID:  creatorID,
-			ProjectUID: project.UID,
-			Title:      change.path,
-			Statement:  change.content,
-
-			Payload: &storepb.SheetPayload{
-				Engine: engine,
-			},
-		})
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to create sheet for file %s", change.path)
-		}
-		sheets = append(sheets, sheet.UID)
-	}
-
-	steps, err := s.getChangeSteps(ctx, project, vcsConnector, prInfo.changes, sheets)
-	if err != nil {
-		return nil, err
-	}
-
-	childCtx := context.WithValue(ctx, common.PrincipalIDContextKey, creatorID)
+	childCtx := context.WithValue(ctx, common.PrincipalIDContextKey, user.ID)
 	childCtx = context.WithValue(childCtx, common.UserContextKey, user)
 	childCtx = context.WithValue(childCtx, common.LoopbackContextKey, true)
-	plan, err := s.planService.CreatePlan(childCtx, &v1pb.CreatePlanRequest{
-		Parent: common.FormatProject(project.ResourceID),
-		Plan: &v1pb.Plan{
-			Title: prInfo.title,
-			Steps: steps,
-			VcsSource: &v1pb.Plan_VCSSource{
-				VcsConnector:   fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, vcsConnector.ProjectID, common.VCSConnectorPrefix, vcsConnector.ResourceID),
-				PullRequestUrl: prInfo.url,
-				VcsType:        v1pb.VCSType(vcsProvider.Type),
-			},
-		},
-	})
+
+	plan, err := s.createPlanFromPRInfo(childCtx, project, vcsProvider, vcsConnector, prInfo, user)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to create plan")
+		return nil, err
 	}
 	issue, err := s.issueService.CreateIssue(childCtx, &v1pb.CreateIssueRequest{
 		Parent: common.FormatProject(project.ResourceID),
@@ -283,6 +377,156 @@ func (s *Service) createIssueFromPRInfo(ctx context.Context, project *store.Proj
 	return issue, nil
 }
 
+// createPlanToCheckPullRequest creates a plan without issue from the open pull request to run the plan checks on the head commit.
+// A new plan is created for every new head commit, so that the results of the plan checks are reported on the commit.
+func (s *Service) createPlanToCheckPullRequest(ctx context.Context, project *store.ProjectMessage, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo, externalURL string) error {
+	if prInfo.commitID == "" {
+		return nil
+	}
+	user := s.getUserByEmail(ctx, prInfo.email)
+	childCtx := context.WithValue(ctx, common.PrincipalIDContextKey, user.ID)
+	childCtx = context.WithValue(childCtx, common.UserContextKey, user)
+	childCtx = context.WithValue(childCtx, common.LoopbackContextKey, true)
+
+	plans, err := s.store.ListPlans(ctx, &store.FindPlanMessage{ProjectID: &project.ResourceID, PullRequestURL: &prInfo.url, NoIssue: true})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list plans for pull request %q", prInfo.url)
+	}
+	for _, plan := range plans {
+		if plan.Config.GetVcsSource().GetCommitId() == prInfo.commitID {
+			return nil
+		}
+	}
+
+	var plan *v1pb.Plan
+	if len(plans) == 0 {
+		plan, err = s.createPlanFromPRInfo(childCtx, project, vcsProvider, vcsConnector, prInfo, user)
+		if err != nil {
+			return err
+		}
+	} else {
+		// The plans are listed by ID in descending order, and the latest plan of the pull request is updated to the head commit
+		// instead of creating a plan for each commit.
+		plan, err = s.updatePlanFromPRInfo(childCtx, project, vcsConnector, plans[0], prInfo, user)
+		if err != nil {
+			return err
+		}
+	}
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	if err := provider.SetCommitStatus(ctx, vcsConnector.Payload.ExternalId, prInfo.commitID, &vcs.CommitStatus{
+		State:       vcs.CommitStatePending,
+		Context:     vcs.PlanCheckCommitStatusContext,
+		Description: "Bytebase plan checks are running",
+		TargetURL:   fmt.Sprintf("%s/%s", externalURL, plan.Name),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to set commit status")
+	}
+	return nil
+}
+
+// createPlanFromPRInfo creates the sheets of the changes and the plan to apply them.
+// The ctx carries the principal of the user.
+func (s *Service) createPlanFromPRInfo(ctx context.Context, project *store.ProjectMessage, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo, user *store.UserMessage) (*v1pb.Plan, error) {
+	steps, err := s.getPlanStepsFromPRInfo(ctx, project, vcsConnector, prInfo, user)
+	if err != nil {
+		return nil, err
+	}
+
+	// The plans created from pushes are not linked to any pull request.
+	pullRequestURL := prInfo.url
+	if prInfo.push {
+		pullRequestURL = ""
+	}
+	plan, err := s.planService.CreatePlan(ctx, &v1pb.CreatePlanRequest{
+		Parent: common.FormatProject(project.ResourceID),
+		Plan: &v1pb.Plan{
+			Title: prInfo.title,
+			Steps: steps,
+			VcsSource: &v1pb.Plan_VCSSource{
+				VcsConnector:   fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, vcsConnector.ProjectID, common.VCSConnectorPrefix, vcsConnector.ResourceID),
+				PullRequestUrl: pullRequestURL,
+				CommitId:       prInfo.commitID,
+				Tag:            prInfo.tag,
+				VcsType:        v1pb.VCSType(vcsProvider.Type),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create plan")
+	}
+	return plan, nil
+}
+
+// updatePlanFromPRInfo updates the steps of the plan to the changes of the new head commit of the pull request.
+// The ctx carries the principal of the user, and the plan is updated by its creator because the commits may be pushed by other users.
+func (s *Service) updatePlanFromPRInfo(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage, plan *store.PlanMessage, prInfo *pullRequestInfo, user *store.UserMessage) (*v1pb.Plan, error) {
+	steps, err := s.getPlanStepsFromPRInfo(ctx, project, vcsConnector, prInfo, user)
+	if err != nil {
+		return nil, err
+	}
+	creator, err := s.store.GetUserByID(ctx, plan.CreatorUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the creator of plan %d", plan.UID)
+	}
+	if creator == nil {
+		return nil, errors.Errorf("creator %d of plan %d not found", plan.CreatorUID, plan.UID)
+	}
+	creatorCtx := context.WithValue(ctx, common.PrincipalIDContextKey, creator.ID)
+	creatorCtx = context.WithValue(creatorCtx, common.UserContextKey, creator)
+	updated, err := s.planService.UpdatePlan(creatorCtx, &v1pb.UpdatePlanRequest{
+		Plan: &v1pb.Plan{
+			Name:  fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, plan.ProjectID, common.PlanPrefix, plan.UID),
+			Title: prInfo.title,
+			Steps: steps,
+			VcsSource: &v1pb.Plan_VCSSource{
+				VcsConnector:   plan.Config.GetVcsSource().GetVcsConnector(),
+				PullRequestUrl: plan.Config.GetVcsSource().GetPullRequestUrl(),
+				CommitId:       prInfo.commitID,
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "steps", "vcs_source"}},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update plan %d", plan.UID)
+	}
+	return updated, nil
+}
+
+// getPlanStepsFromPRInfo creates the sheets of the changes and returns the plan steps to apply them.
+func (s *Service) getPlanStepsFromPRInfo(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo, user *store.UserMessage) ([]*v1pb.Plan_Step, error) {
+	engine, err := s.getDatabaseEngineSample(ctx, project, vcsConnector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database engine")
+	}
+
+	var sheets []int
+	for _, change := range prInfo.changes {
+		sheet, err := s.sheetManager.CreateSheet(ctx, &store.SheetMessage{
+			CreatorID:  user.ID,
+			ProjectUID: project.UID,
+			Title:      change.path,
+			Statement:  change.content,
+
+			Payload: &storepb.SheetPayload{
+				Engine: engine,
+			},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create sheet for file %s", change.path)
+		}
+		sheets = append(sheets, sheet.UID)
+	}
+
+	steps, err := s.getChangeSteps(ctx, project, vcsConnector, prInfo.changes, sheets)
+	if err != nil {
+		return nil, err
+	}
+	if len(steps) == 0 {
+		return nil, errors.Errorf("all the changes are applied to the databases already")
+	}
+	return steps, nil
+}
+
 func (s *Service) getDatabaseEngineSample(
 	ctx context.Context,
 	project *store.ProjectMessage,
@@ -348,24 +592,63 @@ func (s *Service) getChangeSteps(
 	changes []*fileChange,
 	sheetUIDList []int,
 ) ([]*v1pb.Plan_Step, error) {
-	if vcsConnector.Payload.DatabaseGroup != "" {
-		step := &v1pb.Plan_Step{}
-		for i, change := range changes {
-			step.Specs = append(step.Specs, &v1pb.Plan_Spec{
-				Id: uuid.NewString(),
-				Config: &v1pb.Plan_Spec_ChangeDatabaseConfig{
-					ChangeDatabaseConfig: &v1pb.Plan_ChangeDatabaseConfig{
-						Type:          change.changeType,
-						Target:        vcsConnector.Payload.DatabaseGroup,
-						Sheet:         fmt.Sprintf("projects/%s/sheets/%d", project.ResourceID, sheetUIDList[i]),
-						SchemaVersion: change.version,
-					},
+	newSpec := func(change *fileChange, sheetUID int, target string) *v1pb.Plan_Spec {
+		return &v1pb.Plan_Spec{
+			Id: uuid.NewString(),
+			Config: &v1pb.Plan_Spec_ChangeDatabaseConfig{
+				ChangeDatabaseConfig: &v1pb.Plan_ChangeDatabaseConfig{
+					Type:          change.changeType,
+					Target:        target,
+					Sheet:         fmt.Sprintf("projects/%s/sheets/%d", project.ResourceID, sheetUID),
+					SchemaVersion: change.version,
 				},
-			})
+			},
+		}
+	}
+
+	// The changes routed to the database groups are applied in the first step.
+	// The changes for a Liquibase dbms are applied to the databases of the dbms instead.
+	databaseGroupStep := &v1pb.Plan_Step{}
+	var databaseChanges []int
+	dbmsChangeDatabases := map[int]map[string]bool{}
+	for i, change := range changes {
+		if change.dbms != "" {
+			databases, err := s.getChangeDatabases(ctx, project, vcsConnector, change)
+			if err != nil {
+				return nil, err
+			}
+			dbmsChangeDatabases[i] = map[string]bool{}
+			for _, database := range databases {
+				dbmsChangeDatabases[i][common.FormatDatabase(database.InstanceID, database.DatabaseName)] = true
+			}
+			databaseChanges = append(databaseChanges, i)
+			continue
+		}
+		if change.skipApplied && change.databaseName == "" && (change.databaseGroup != "" || vcsConnector.Payload.DatabaseGroup != "") {
+			// The database group is skipped only if the version is applied to all its databases.
+			applied, err := s.isVersionAppliedToAll(ctx, project, vcsConnector, change)
+			if err != nil {
+				return nil, err
+			}
+			if applied {
+				continue
+			}
 		}
-		return []*v1pb.Plan_Step{
-			step,
-		}, nil
+		switch {
+		case change.databaseGroup != "":
+			databaseGroupStep.Specs = append(databaseGroupStep.Specs, newSpec(change, sheetUIDList[i], fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, project.ResourceID, common.DatabaseGroupNamePrefix, change.databaseGroup)))
+		case change.databaseName == "" && vcsConnector.Payload.DatabaseGroup != "":
+			databaseGroupStep.Specs = append(databaseGroupStep.Specs, newSpec(change, sheetUIDList[i], vcsConnector.Payload.DatabaseGroup))
+		default:
+			databaseChanges = append(databaseChanges, i)
+		}
+	}
+	var steps []*v1pb.Plan_Step
+	if len(databaseGroupStep.Specs) > 0 {
+		steps = append(steps, databaseGroupStep)
+	}
+	if len(databaseChanges) == 0 {
+		return steps, nil
 	}
 
 	databases, err := s.listDatabases(ctx, project)
@@ -373,24 +656,41 @@ func (s *Service) getChangeSteps(
 		return nil, err
 	}
 
-	var steps []*v1pb.Plan_Step
+	var databaseSteps []*v1pb.Plan_Step
 	for i, database := range databases {
 		if i == 0 || databases[i].EffectiveEnvironmentID != databases[i-1].EffectiveEnvironmentID {
-			steps = append(steps, &v1pb.Plan_Step{})
-		}
-		step := steps[len(steps)-1]
-		for i, change := range changes {
-			step.Specs = append(step.Specs, &v1pb.Plan_Spec{
-				Id: uuid.NewString(),
-				Config: &v1pb.Plan_Spec_ChangeDatabaseConfig{
-					ChangeDatabaseConfig: &v1pb.Plan_ChangeDatabaseConfig{
-						Type:          change.changeType,
-						Target:        common.FormatDatabase(database.InstanceID, database.DatabaseName),
-						Sheet:         fmt.Sprintf("projects/%s/sheets/%d", project.ResourceID, sheetUIDList[i]),
-						SchemaVersion: change.version,
-					},
-				},
-			})
+			databaseSteps = append(databaseSteps, &v1pb.Plan_Step{})
+		}
+		step := databaseSteps[len(databaseSteps)-1]
+		for _, j := range databaseChanges {
+			change := changes[j]
+			if targets, ok := dbmsChangeDatabases[j]; ok {
+				if !targets[common.FormatDatabase(database.InstanceID, database.DatabaseName)] {
+					continue
+				}
+			} else {
+				if change.databaseName != "" && change.databaseName != database.DatabaseName {
+					continue
+				}
+				if change.environmentID != "" && change.environmentID != database.EffectiveEnvironmentID {
+					continue
+				}
+			}
+			if change.skipApplied {
+				applied, err := s.isVersionApplied(ctx, database, change.version)
+				if err != nil {
+					return nil, err
+				}
+				if applied {
+					continue
+				}
+			}
+			step.Specs = append(step.Specs, newSpec(change, sheetUIDList[j], common.FormatDatabase(database.InstanceID, database.DatabaseName)))
+		}
+	}
+	for _, step := range databaseSteps {
+		if len(step.Specs) > 0 {
+			steps = append(steps, step)
 		}
 	}
 
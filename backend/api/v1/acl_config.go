@@ -6,20 +6,27 @@ import (
 )
 
 var methodPermissionMap = map[string]iam.Permission{
-	v1pb.SQLService_AdminExecute_FullMethodName:           iam.PermissionInstancesAdminExecute,
-	v1pb.InstanceService_ListInstances_FullMethodName:     iam.PermissionInstancesList,
-	v1pb.InstanceService_SearchInstances_FullMethodName:   "", // handled in the method; if the user can get the database, then the user can get the instance.
-	v1pb.InstanceService_GetInstance_FullMethodName:       iam.PermissionInstancesGet,
-	v1pb.InstanceService_CreateInstance_FullMethodName:    iam.PermissionInstancesCreate,
-	v1pb.InstanceService_UpdateInstance_FullMethodName:    iam.PermissionInstancesUpdate,
-	v1pb.InstanceService_DeleteInstance_FullMethodName:    iam.PermissionInstancesDelete,
-	v1pb.InstanceService_UndeleteInstance_FullMethodName:  iam.PermissionInstancesUndelete,
-	v1pb.InstanceService_SyncInstance_FullMethodName:      iam.PermissionInstancesSync,
-	v1pb.InstanceService_BatchSyncInstance_FullMethodName: iam.PermissionInstancesSync,
-	v1pb.InstanceService_AddDataSource_FullMethodName:     iam.PermissionInstancesUpdate,
-	v1pb.InstanceService_RemoveDataSource_FullMethodName:  iam.PermissionInstancesUpdate,
-	v1pb.InstanceService_UpdateDataSource_FullMethodName:  iam.PermissionInstancesUpdate,
-	v1pb.InstanceService_SyncSlowQueries_FullMethodName:   iam.PermissionInstancesSync,
+	v1pb.SQLService_AdminExecute_FullMethodName:            iam.PermissionInstancesAdminExecute,
+	v1pb.SQLService_Unmask_FullMethodName:                  iam.PermissionDatabasesUnmask,
+	v1pb.SQLService_CreateEditorSession_FullMethodName:     iam.PermissionInstancesAdminExecute,
+	v1pb.SQLService_GetEditorSession_FullMethodName:        iam.PermissionInstancesAdminExecute,
+	v1pb.SQLService_ExecuteEditorSession_FullMethodName:    iam.PermissionInstancesAdminExecute,
+	v1pb.SQLService_HeartbeatEditorSession_FullMethodName:  iam.PermissionInstancesAdminExecute,
+	v1pb.SQLService_DeleteEditorSession_FullMethodName:     iam.PermissionInstancesAdminExecute,
+	v1pb.SQLService_AggregateQueryHistories_FullMethodName: iam.PermissionAuditLogsGet,
+	v1pb.InstanceService_ListInstances_FullMethodName:      iam.PermissionInstancesList,
+	v1pb.InstanceService_SearchInstances_FullMethodName:    "", // handled in the method; if the user can get the database, then the user can get the instance.
+	v1pb.InstanceService_GetInstance_FullMethodName:        iam.PermissionInstancesGet,
+	v1pb.InstanceService_CreateInstance_FullMethodName:     iam.PermissionInstancesCreate,
+	v1pb.InstanceService_UpdateInstance_FullMethodName:     iam.PermissionInstancesUpdate,
+	v1pb.InstanceService_DeleteInstance_FullMethodName:     iam.PermissionInstancesDelete,
+	v1pb.InstanceService_UndeleteInstance_FullMethodName:   iam.PermissionInstancesUndelete,
+	v1pb.InstanceService_SyncInstance_FullMethodName:       iam.PermissionInstancesSync,
+	v1pb.InstanceService_BatchSyncInstance_FullMethodName:  iam.PermissionInstancesSync,
+	v1pb.InstanceService_AddDataSource_FullMethodName:      iam.PermissionInstancesUpdate,
+	v1pb.InstanceService_RemoveDataSource_FullMethodName:   iam.PermissionInstancesUpdate,
+	v1pb.InstanceService_UpdateDataSource_FullMethodName:   iam.PermissionInstancesUpdate,
+	v1pb.InstanceService_SyncSlowQueries_FullMethodName:    iam.PermissionInstancesSync,
 
 	v1pb.DatabaseService_GetDatabase_FullMethodName:            iam.PermissionDatabasesGet,
 	v1pb.DatabaseService_SearchDatabases_FullMethodName:        iam.PermissionDatabasesGet,
@@ -28,6 +35,7 @@ var methodPermissionMap = map[string]iam.Permission{
 	v1pb.DatabaseService_BatchUpdateDatabases_FullMethodName:   iam.PermissionDatabasesUpdate,
 	v1pb.DatabaseService_SyncDatabase_FullMethodName:           iam.PermissionDatabasesSync,
 	v1pb.DatabaseService_GetDatabaseMetadata_FullMethodName:    iam.PermissionDatabasesGetSchema,
+	v1pb.DatabaseService_GetSensitiveDataReport_FullMethodName: iam.PermissionDatabasesGetSchema,
 	v1pb.DatabaseService_UpdateDatabaseMetadata_FullMethodName: iam.PermissionDatabasesUpdate,
 	v1pb.DatabaseService_GetDatabaseSchema_FullMethodName:      iam.PermissionDatabasesGetSchema,
 	v1pb.DatabaseService_DiffSchema_FullMethodName:             iam.PermissionChangeHistoriesGet,
@@ -38,6 +46,7 @@ var methodPermissionMap = map[string]iam.Permission{
 	v1pb.DatabaseService_AdviseIndex_FullMethodName:            iam.PermissionDatabasesAdviseIndex,
 	v1pb.DatabaseService_ListChangeHistories_FullMethodName:    iam.PermissionChangeHistoriesList,
 	v1pb.DatabaseService_GetChangeHistory_FullMethodName:       iam.PermissionChangeHistoriesGet,
+	v1pb.DatabaseService_RollbackChangeHistory_FullMethodName:  iam.PermissionIssuesCreate,
 	v1pb.EnvironmentService_CreateEnvironment_FullMethodName:   iam.PermissionEnvironmentsCreate,
 	v1pb.EnvironmentService_UpdateEnvironment_FullMethodName:   iam.PermissionEnvironmentsUpdate,
 	v1pb.EnvironmentService_DeleteEnvironment_FullMethodName:   iam.PermissionEnvironmentsDelete,
@@ -66,6 +66,10 @@ func isSkippedMethod(fullMethod string) bool {
 		v1pb.CelService_BatchParse_FullMethodName,
 		v1pb.CelService_BatchDeparse_FullMethodName,
 		v1pb.SQLService_Query_FullMethodName,
+		v1pb.SQLService_FetchQueryResult_FullMethodName,
+		v1pb.SQLService_CloseQueryResult_FullMethodName,
+		v1pb.SQLService_CreateQueryResultShare_FullMethodName,
+		v1pb.SQLService_GetQueryResultShare_FullMethodName,
 		// TODO(steven): maybe needs to add a permission to check.
 		v1pb.SQLService_Execute_FullMethodName,
 		v1pb.SQLService_SearchQueryHistories_FullMethodName,
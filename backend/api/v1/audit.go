@@ -106,6 +106,16 @@ func getRequestResource(request any) string {
 		return r.Name
 	case *v1pb.ExportRequest:
 		return r.Name
+	case *v1pb.UnmaskRequest:
+		return r.Name
+	case *v1pb.CreateQueryResultShareRequest:
+		return r.Parent
+	case *v1pb.CreateEditorSessionRequest:
+		return r.Parent
+	case *v1pb.ExecuteEditorSessionRequest:
+		return r.Name
+	case *v1pb.DeleteEditorSessionRequest:
+		return r.Name
 	case *v1pb.UpdateDatabaseRequest:
 		return r.Database.Name
 	case *v1pb.BatchUpdateDatabasesRequest:
@@ -134,6 +144,16 @@ func getRequestString(request any) (string, error) {
 			r = proto.Clone(r).(*v1pb.ExportRequest)
 			r.Password = ""
 			return r
+		case *v1pb.UnmaskRequest:
+			return r
+		case *v1pb.CreateQueryResultShareRequest:
+			return r
+		case *v1pb.CreateEditorSessionRequest:
+			return r
+		case *v1pb.ExecuteEditorSessionRequest:
+			return r
+		case *v1pb.DeleteEditorSessionRequest:
+			return r
 		case *v1pb.UpdateDatabaseRequest:
 			return r
 		case *v1pb.BatchUpdateDatabasesRequest:
@@ -168,6 +188,22 @@ func getResponseString(response any) (string, error) {
 			return redactQueryResponse(r)
 		case *v1pb.ExportResponse:
 			return nil
+		case *v1pb.UnmaskResponse:
+			// The unmasked values are sensitive.
+			return nil
+		case *v1pb.QueryResultShare:
+			// The results are kept in the snapshot.
+			//nolint:revive
+			r = proto.Clone(r).(*v1pb.QueryResultShare)
+			r.Results = nil
+			return r
+		case *v1pb.EditorSession:
+			return r
+		case *v1pb.ExecuteEditorSessionResponse:
+			return &v1pb.ExecuteEditorSessionResponse{
+				Results: redactQueryResponse(&v1pb.QueryResponse{Results: r.Results}).Results,
+				Session: r.Session,
+			}
 		case *v1pb.Database:
 			return r
 		case *v1pb.BatchUpdateDatabasesResponse:
@@ -257,7 +293,12 @@ func isAuditMethod(method string) bool {
 		v1pb.DatabaseService_BatchUpdateDatabases_FullMethodName,
 		v1pb.ProjectService_SetIamPolicy_FullMethodName,
 		v1pb.SQLService_Export_FullMethodName,
-		v1pb.SQLService_Query_FullMethodName:
+		v1pb.SQLService_Query_FullMethodName,
+		v1pb.SQLService_Unmask_FullMethodName,
+		v1pb.SQLService_CreateQueryResultShare_FullMethodName,
+		v1pb.SQLService_CreateEditorSession_FullMethodName,
+		v1pb.SQLService_ExecuteEditorSession_FullMethodName,
+		v1pb.SQLService_DeleteEditorSession_FullMethodName:
 		return true
 	default:
 		return false
@@ -64,6 +64,11 @@ func (p *ContextProvider) do(ctx context.Context, fullMethod string, req any) ([
 		v1pb.ProjectService_DeleteProject_FullMethodName,
 		v1pb.ProjectService_UndeleteProject_FullMethodName,
 		v1pb.SQLService_AdminExecute_FullMethodName,
+		v1pb.SQLService_CreateEditorSession_FullMethodName,
+		v1pb.SQLService_GetEditorSession_FullMethodName,
+		v1pb.SQLService_ExecuteEditorSession_FullMethodName,
+		v1pb.SQLService_HeartbeatEditorSession_FullMethodName,
+		v1pb.SQLService_DeleteEditorSession_FullMethodName,
 		v1pb.InstanceService_ListInstances_FullMethodName,
 		v1pb.InstanceService_CreateInstance_FullMethodName,
 		v1pb.InstanceService_UpdateInstance_FullMethodName,
@@ -129,12 +134,15 @@ func (p *ContextProvider) do(ctx context.Context, fullMethod string, req any) ([
 	case
 		v1pb.SQLService_Query_FullMethodName,
 		v1pb.SQLService_Export_FullMethodName,
+		v1pb.SQLService_Unmask_FullMethodName,
+		v1pb.SQLService_CreateQueryResultShare_FullMethodName,
 		v1pb.DatabaseService_GetDatabase_FullMethodName,
 		v1pb.DatabaseService_UpdateDatabase_FullMethodName,
 		v1pb.DatabaseService_BatchUpdateDatabases_FullMethodName,
 		v1pb.DatabaseService_DiffSchema_FullMethodName,
 		v1pb.DatabaseService_SyncDatabase_FullMethodName,
 		v1pb.DatabaseService_GetDatabaseMetadata_FullMethodName,
+		v1pb.DatabaseService_GetSensitiveDataReport_FullMethodName,
 		v1pb.DatabaseService_UpdateDatabaseMetadata_FullMethodName,
 		v1pb.DatabaseService_GetDatabaseSchema_FullMethodName,
 		v1pb.DatabaseService_ListSecrets_FullMethodName,
@@ -142,7 +150,8 @@ func (p *ContextProvider) do(ctx context.Context, fullMethod string, req any) ([
 		v1pb.DatabaseService_DeleteSecret_FullMethodName,
 		v1pb.DatabaseService_AdviseIndex_FullMethodName,
 		v1pb.DatabaseService_ListChangeHistories_FullMethodName,
-		v1pb.DatabaseService_GetChangeHistory_FullMethodName:
+		v1pb.DatabaseService_GetChangeHistory_FullMethodName,
+		v1pb.DatabaseService_RollbackChangeHistory_FullMethodName:
 		return p.getProjectIDsForDatabase(ctx, req)
 
 	case
@@ -198,7 +207,9 @@ func (p *ContextProvider) do(ctx context.Context, fullMethod string, req any) ([
 		v1pb.ProjectService_DeleteDatabaseGroup_FullMethodName,
 
 		v1pb.ProjectService_GetProjectProtectionRules_FullMethodName,
-		v1pb.ProjectService_UpdateProjectProtectionRules_FullMethodName:
+		v1pb.ProjectService_UpdateProjectProtectionRules_FullMethodName,
+
+		v1pb.SQLService_AggregateQueryHistories_FullMethodName:
 
 		return p.getProjectIDsForProjectService(ctx, req)
 	}
@@ -430,6 +441,8 @@ func (*ContextProvider) getProjectIDsForProjectService(_ context.Context, req an
 		protectionRules = append(protectionRules, r.GetName())
 	case *v1pb.UpdateProjectProtectionRulesRequest:
 		protectionRules = append(protectionRules, r.GetProtectionRules().GetName())
+	case *v1pb.AggregateQueryHistoriesRequest:
+		projects = append(projects, r.GetParent())
 	}
 
 	var projectIDs []string
@@ -495,6 +508,10 @@ func (p *ContextProvider) getProjectIDsForDatabase(ctx context.Context, req any)
 		} else if strings.HasPrefix(r.GetName(), common.InstanceNamePrefix) && r.GetConnectionDatabase() != "" {
 			databaseNames = append(databaseNames, fmt.Sprintf("%s/%s%s", r.GetName(), common.DatabaseIDPrefix, r.GetConnectionDatabase()))
 		}
+	case *v1pb.UnmaskRequest:
+		databaseNames = append(databaseNames, r.GetName())
+	case *v1pb.CreateQueryResultShareRequest:
+		databaseNames = append(databaseNames, r.GetParent())
 	case *v1pb.GetDatabaseRequest:
 		databaseNames = append(databaseNames, r.GetName())
 	case *v1pb.SyncDatabaseRequest:
@@ -507,6 +524,12 @@ func (p *ContextProvider) getProjectIDsForDatabase(ctx context.Context, req any)
 			return nil, errors.Wrapf(err, "failed to get databaseName from %q", r.GetName())
 		}
 		databaseNames = append(databaseNames, databaseName)
+	case *v1pb.GetSensitiveDataReportRequest:
+		databaseName, err := common.TrimSuffix(r.GetName(), common.SensitiveDataReportSuffix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get databaseName from %q", r.GetName())
+		}
+		databaseNames = append(databaseNames, databaseName)
 	case *v1pb.UpdateDatabaseMetadataRequest:
 		databaseName, err := common.TrimSuffix(r.GetDatabaseMetadata().GetName(), "/metadata")
 		if err != nil {
@@ -567,6 +590,12 @@ func (p *ContextProvider) getProjectIDsForDatabase(ctx context.Context, req any)
 			return nil, errors.Wrapf(err, "failed to get databaseName from %q", r.GetName())
 		}
 		databaseNames = append(databaseNames, common.FormatDatabase(instance, database))
+	case *v1pb.RollbackChangeHistoryRequest:
+		instance, database, _, err := common.GetInstanceDatabaseIDChangeHistory(r.GetName())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get databaseName from %q", r.GetName())
+		}
+		databaseNames = append(databaseNames, common.FormatDatabase(instance, database))
 	case *v1pb.DiffSchemaRequest:
 		if instance, database, _, err := common.GetInstanceDatabaseIDChangeHistory(r.GetName()); err == nil {
 			databaseNames = append(databaseNames, common.FormatDatabase(instance, database))
@@ -25,6 +25,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/sheet"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -66,16 +67,24 @@ type DatabaseService struct {
 	licenseService enterprise.LicenseService
 	profile        *config.Profile
 	iamManager     *iam.Manager
+	sheetManager   *sheet.Manager
+	planService    *PlanService
+	issueService   *IssueService
+	rolloutService *RolloutService
 }
 
 // NewDatabaseService creates a new DatabaseService.
-func NewDatabaseService(store *store.Store, schemaSyncer *schemasync.Syncer, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager) *DatabaseService {
+func NewDatabaseService(store *store.Store, schemaSyncer *schemasync.Syncer, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager, sheetManager *sheet.Manager, planService *PlanService, issueService *IssueService, rolloutService *RolloutService) *DatabaseService {
 	return &DatabaseService{
 		store:          store,
 		schemaSyncer:   schemaSyncer,
 		licenseService: licenseService,
 		profile:        profile,
 		iamManager:     iamManager,
+		sheetManager:   sheetManager,
+		planService:    planService,
+		issueService:   issueService,
+		rolloutService: rolloutService,
 	}
 }
 
@@ -711,6 +720,67 @@ func (s *DatabaseService) GetDatabaseMetadata(ctx context.Context, request *v1pb
 	return v1pbMetadata, nil
 }
 
+// GetSensitiveDataReport gets the sensitive data discovered by sampling the database.
+func (s *DatabaseService) GetSensitiveDataReport(ctx context.Context, request *v1pb.GetSensitiveDataReportRequest) (*v1pb.SensitiveDataReport, error) {
+	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(request.Name, common.SensitiveDataReportSuffix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %s", instanceID)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	if err := s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	return convertToSensitiveDataReport(database), nil
+}
+
+func convertToSensitiveDataReport(database *store.DatabaseMessage) *v1pb.SensitiveDataReport {
+	report := database.Metadata.GetSensitiveDataReport()
+	v1Report := &v1pb.SensitiveDataReport{
+		Name:         fmt.Sprintf("%s%s/%s%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName, common.SensitiveDataReportSuffix),
+		DiscoverTime: report.GetDiscoverTime(),
+		SampleSize:   report.GetSampleSize(),
+	}
+	for _, finding := range report.GetFindings() {
+		v1Report.Findings = append(v1Report.Findings, &v1pb.SensitiveDataReport_Finding{
+			Schema:            finding.Schema,
+			Table:             finding.Table,
+			Column:            finding.Column,
+			DataType:          v1pb.SensitiveDataReport_DataType(finding.DataType),
+			MatchRatio:        finding.MatchRatio,
+			SampledCount:      finding.SampledCount,
+			ColumnNameMatched: finding.ColumnNameMatched,
+			SemanticTypeId:    finding.SemanticTypeId,
+			ClassificationId:  finding.ClassificationId,
+			LevelId:           finding.LevelId,
+		})
+	}
+	for _, table := range report.GetSkippedTables() {
+		v1Report.SkippedTables = append(v1Report.SkippedTables, &v1pb.SensitiveDataReport_SkippedTable{
+			Schema: table.Schema,
+			Table:  table.Table,
+			Reason: table.Reason,
+		})
+	}
+	return v1Report
+}
+
 // UpdateDatabaseMetadata updates the metadata config of a database.
 func (s *DatabaseService) UpdateDatabaseMetadata(ctx context.Context, request *v1pb.UpdateDatabaseMetadataRequest) (*v1pb.DatabaseMetadata, error) {
 	if request.DatabaseMetadata == nil {
@@ -1216,6 +1286,7 @@ func (s *DatabaseService) convertToChangeHistory(ctx context.Context, h *store.I
 	if h.Payload != nil && h.Payload.ChangedResources != nil {
 		v1pbHistory.ChangedResources = convertToChangedResources(h.Payload.ChangedResources)
 	}
+	v1pbHistory.RollbackStatement = h.Payload.GetRollbackStatement()
 	return v1pbHistory, nil
 }
 
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// RollbackChangeHistory creates an issue to roll back the change history with its rollback statement.
+func (s *DatabaseService) RollbackChangeHistory(ctx context.Context, request *v1pb.RollbackChangeHistoryRequest) (*v1pb.Issue, error) {
+	instanceID, databaseName, changeHistoryID, err := common.GetInstanceDatabaseIDChangeHistory(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
+		ResourceID: &instanceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project %q, error: %v", database.ProjectID, err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", database.ProjectID)
+	}
+
+	changeHistories, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		InstanceID: &instance.UID,
+		DatabaseID: &database.UID,
+		ID:         &changeHistoryID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list change history, error: %v", err)
+	}
+	if len(changeHistories) == 0 {
+		return nil, status.Errorf(codes.NotFound, "change history %q not found", changeHistoryID)
+	}
+	changeHistory := changeHistories[0]
+	rollbackStatement := changeHistory.Payload.GetRollbackStatement()
+	if rollbackStatement == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "change history %q has no rollback statement", changeHistoryID)
+	}
+
+	title := fmt.Sprintf("Roll back %s", changeHistory.Version.Version)
+	sheetMessage, err := s.sheetManager.CreateSheet(ctx, &store.SheetMessage{
+		CreatorID:  user.ID,
+		ProjectUID: project.UID,
+		Title:      title,
+		Statement:  rollbackStatement,
+
+		Payload: &storepb.SheetPayload{
+			Engine: instance.Engine,
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create sheet, error: %v", err)
+	}
+
+	plan, err := s.planService.CreatePlan(ctx, &v1pb.CreatePlanRequest{
+		Parent: common.FormatProject(project.ResourceID),
+		Plan: &v1pb.Plan{
+			Title: title,
+			Steps: []*v1pb.Plan_Step{
+				{
+					Specs: []*v1pb.Plan_Spec{
+						{
+							Id: uuid.NewString(),
+							Config: &v1pb.Plan_Spec_ChangeDatabaseConfig{
+								ChangeDatabaseConfig: &v1pb.Plan_ChangeDatabaseConfig{
+									Type:   v1pb.Plan_ChangeDatabaseConfig_MIGRATE,
+									Target: common.FormatDatabase(instance.ResourceID, database.DatabaseName),
+									Sheet:  common.FormatSheet(project.ResourceID, sheetMessage.UID),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	issue, err := s.issueService.CreateIssue(ctx, &v1pb.CreateIssueRequest{
+		Parent: common.FormatProject(project.ResourceID),
+		Issue: &v1pb.Issue{
+			Title:       title,
+			Description: fmt.Sprintf("Roll back the change %s of database %q.", request.Name, database.DatabaseName),
+			Type:        v1pb.Issue_DATABASE_CHANGE,
+			Plan:        plan.Name,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.rolloutService.CreateRollout(ctx, &v1pb.CreateRolloutRequest{
+		Parent: common.FormatProject(project.ResourceID),
+		Rollout: &v1pb.Rollout{
+			Plan: plan.Name,
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
@@ -356,6 +356,15 @@ func (s *InstanceService) UpdateInstance(ctx context.Context, request *v1pb.Upda
 				patch.OptionsUpsert = instance.Options
 			}
 			patch.OptionsUpsert.MaximumConnections = request.Instance.Options.GetMaximumConnections()
+		case "options.query_result_cache_ttl":
+			ttl := request.Instance.Options.GetQueryResultCacheTtl()
+			if ttl.AsDuration() < 0 || ttl.AsDuration() > maxQueryResultCacheTTL {
+				return nil, status.Errorf(codes.InvalidArgument, "query result cache ttl must be in [0, %v]", maxQueryResultCacheTTL)
+			}
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = instance.Options
+			}
+			patch.OptionsUpsert.QueryResultCacheTtl = ttl
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, `unsupported update_mask "%s"`, path)
 		}
@@ -1389,8 +1398,9 @@ func convertToInstanceOptions(options *storepb.InstanceOptions) *v1pb.InstanceOp
 	}
 
 	return &v1pb.InstanceOptions{
-		SyncInterval:       options.SyncInterval,
-		MaximumConnections: options.MaximumConnections,
+		SyncInterval:        options.SyncInterval,
+		MaximumConnections:  options.MaximumConnections,
+		QueryResultCacheTtl: options.QueryResultCacheTtl,
 	}
 }
 
@@ -1400,7 +1410,8 @@ func convertInstanceOptions(options *v1pb.InstanceOptions) *storepb.InstanceOpti
 	}
 
 	return &storepb.InstanceOptions{
-		SyncInterval:       options.SyncInterval,
-		MaximumConnections: options.MaximumConnections,
+		SyncInterval:        options.SyncInterval,
+		MaximumConnections:  options.MaximumConnections,
+		QueryResultCacheTtl: options.QueryResultCacheTtl,
 	}
 }
@@ -1073,6 +1073,14 @@ func (s *IssueService) RejectIssue(ctx context.Context, request *v1pb.RejectIssu
 		return nil, status.Errorf(codes.Internal, "failed to update issue, error: %v", err)
 	}
 
+	s.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor:   user,
+		Type:    webhook.EventTypeIssueApprovalReject,
+		Comment: request.Comment,
+		Issue:   webhook.NewIssue(issue),
+		Project: webhook.NewProject(issue.Project),
+	})
+
 	if err := func() error {
 		p := &storepb.IssueCommentPayload{
 			Comment: request.Comment,
@@ -305,10 +305,11 @@ func convertToGrantRequest(ctx context.Context, s *store.Store, v *storepb.Grant
 		return nil, errors.Errorf("user %q not found", v.User)
 	}
 	return &v1pb.GrantRequest{
-		Role:       v.Role,
-		User:       common.FormatUserEmail(user.Email),
-		Condition:  v.Condition,
-		Expiration: v.Expiration,
+		Role:        v.Role,
+		User:        common.FormatUserEmail(user.Email),
+		Condition:   v.Condition,
+		Expiration:  v.Expiration,
+		QueryDigest: v.QueryDigest,
 	}, nil
 }
 
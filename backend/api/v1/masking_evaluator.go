@@ -19,6 +19,8 @@ type maskingLevelEvaluator struct {
 	dataClassificationIDMap map[string]*storepb.DataClassificationSetting_DataClassificationConfig
 	semanticTypesMap        map[string]*storepb.SemanticTypeSetting_SemanticType
 	maskingAlgorithms       map[string]*storepb.MaskingAlgorithmSetting_Algorithm
+	// maskingKey is the workspace key of the format-preserving and tokenization masking algorithms.
+	maskingKey string
 }
 
 func newEmptyMaskingLevelEvaluator() *maskingLevelEvaluator {
@@ -73,6 +75,11 @@ func (m *maskingLevelEvaluator) withMaskingAlgorithmSetting(maskingAlgorithmSett
 	return m
 }
 
+func (m *maskingLevelEvaluator) withMaskingKey(maskingKey string) *maskingLevelEvaluator {
+	m.maskingKey = maskingKey
+	return m
+}
+
 func (m *maskingLevelEvaluator) getDataClassificationConfig(classificationID string) *storepb.DataClassificationSetting_DataClassificationConfig {
 	return m.dataClassificationIDMap[classificationID]
 }
@@ -531,6 +531,60 @@ func validatePolicyPayload(policyType api.PolicyType, policy *v1pb.Policy) error
 				return status.Errorf(codes.InvalidArgument, "invalid masking exception member %s", exception.Member)
 			}
 		}
+	case api.PolicyTypeRowFilter:
+		rowFilterPolicy, ok := policy.Policy.(*v1pb.Policy_RowFilterPolicy)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unmatched policy type %v and policy %v", policyType, policy.Policy)
+		}
+		if rowFilterPolicy.RowFilterPolicy == nil {
+			return status.Errorf(codes.InvalidArgument, "row filter policy must be set")
+		}
+		for _, rowFilter := range rowFilterPolicy.RowFilterPolicy.RowFilters {
+			if strings.TrimSpace(rowFilter.Predicate) == "" {
+				return status.Errorf(codes.InvalidArgument, "row filter must have predicate set")
+			}
+			if rowFilter.Condition == nil {
+				return status.Errorf(codes.InvalidArgument, "row filter must have condition set")
+			}
+			if _, err := common.ValidateRowFilterCELExpr(rowFilter.Condition.Expression); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid row filter expression: %v", err)
+			}
+			if !strings.HasPrefix(rowFilter.Member, "user:") && !strings.HasPrefix(rowFilter.Member, "group:") {
+				return status.Errorf(codes.InvalidArgument, "invalid row filter member %s", rowFilter.Member)
+			}
+		}
+	case api.PolicyTypeQueryCostGuard:
+		queryCostGuardPolicy, ok := policy.Policy.(*v1pb.Policy_QueryCostGuardPolicy)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unmatched policy type %v and policy %v", policyType, policy.Policy)
+		}
+		if queryCostGuardPolicy.QueryCostGuardPolicy == nil {
+			return status.Errorf(codes.InvalidArgument, "query cost guard policy must be set")
+		}
+		for _, rule := range queryCostGuardPolicy.QueryCostGuardPolicy.Rules {
+			if rule.Action == v1pb.QueryCostGuardPolicy_Rule_ACTION_UNSPECIFIED {
+				return status.Errorf(codes.InvalidArgument, "query cost guard rule must have action set")
+			}
+			if rule.Condition == nil {
+				return status.Errorf(codes.InvalidArgument, "query cost guard rule must have condition set")
+			}
+			if _, err := common.ValidateQueryCostGuardCELExpr(rule.Condition.Expression); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid query cost guard rule expression: %v", err)
+			}
+		}
+	case api.PolicyTypeQueryHistoryRetention:
+		queryHistoryRetentionPolicy, ok := policy.Policy.(*v1pb.Policy_QueryHistoryRetentionPolicy)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unmatched policy type %v and policy %v", policyType, policy.Policy)
+		}
+		if queryHistoryRetentionPolicy.QueryHistoryRetentionPolicy == nil {
+			return status.Errorf(codes.InvalidArgument, "query history retention policy must be set")
+		}
+		if retention := queryHistoryRetentionPolicy.QueryHistoryRetentionPolicy.Retention; retention != nil {
+			if err := retention.CheckValid(); err != nil || retention.AsDuration() < 0 {
+				return status.Errorf(codes.InvalidArgument, "query history retention must be a non-negative duration")
+			}
+		}
 	default:
 	}
 	return nil
@@ -611,6 +665,36 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(ctx context.Context, pol
 			return "", errors.Wrap(err, "failed to marshal masking exception policy")
 		}
 		return string(payloadBytes), nil
+	case v1pb.PolicyType_ROW_FILTER:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureAccessControl); err != nil {
+			return "", status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		payload, err := s.convertToStorePBRowFilterPolicyPayload(ctx, policy.GetRowFilterPolicy())
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal row filter policy")
+		}
+		return string(payloadBytes), nil
+	case v1pb.PolicyType_QUERY_COST_GUARD:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureAccessControl); err != nil {
+			return "", status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		payloadBytes, err := protojson.Marshal(convertToStorePBQueryCostGuardPolicy(policy.GetQueryCostGuardPolicy()))
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal query cost guard policy")
+		}
+		return string(payloadBytes), nil
+	case v1pb.PolicyType_QUERY_HISTORY_RETENTION:
+		payloadBytes, err := protojson.Marshal(&storepb.QueryHistoryRetentionPolicy{
+			Retention: policy.GetQueryHistoryRetentionPolicy().GetRetention(),
+		})
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal query history retention policy")
+		}
+		return string(payloadBytes), nil
 	case v1pb.PolicyType_RESTRICT_ISSUE_CREATION_FOR_SQL_REVIEW:
 		if err := s.licenseService.IsFeatureEnabled(api.FeatureAccessControl); err != nil {
 			return "", status.Errorf(codes.PermissionDenied, err.Error())
@@ -712,6 +796,39 @@ func (s *OrgPolicyService) convertToPolicy(ctx context.Context, parentPath strin
 		policy.Policy = &v1pb.Policy_MaskingExceptionPolicy{
 			MaskingExceptionPolicy: payload,
 		}
+	case api.PolicyTypeRowFilter:
+		pType = v1pb.PolicyType_ROW_FILTER
+		rowFilterPolicy := &storepb.RowFilterPolicy{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policyMessage.Payload), rowFilterPolicy); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal row filter policy")
+		}
+		payload, err := s.convertToV1PBRowFilterPolicyPayload(ctx, rowFilterPolicy)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert row filter policy")
+		}
+		policy.Policy = &v1pb.Policy_RowFilterPolicy{
+			RowFilterPolicy: payload,
+		}
+	case api.PolicyTypeQueryCostGuard:
+		pType = v1pb.PolicyType_QUERY_COST_GUARD
+		queryCostGuardPolicy := &storepb.QueryCostGuardPolicy{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policyMessage.Payload), queryCostGuardPolicy); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal query cost guard policy")
+		}
+		policy.Policy = &v1pb.Policy_QueryCostGuardPolicy{
+			QueryCostGuardPolicy: convertToV1PBQueryCostGuardPolicy(queryCostGuardPolicy),
+		}
+	case api.PolicyTypeQueryHistoryRetention:
+		pType = v1pb.PolicyType_QUERY_HISTORY_RETENTION
+		queryHistoryRetentionPolicy := &storepb.QueryHistoryRetentionPolicy{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policyMessage.Payload), queryHistoryRetentionPolicy); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal query history retention policy")
+		}
+		policy.Policy = &v1pb.Policy_QueryHistoryRetentionPolicy{
+			QueryHistoryRetentionPolicy: &v1pb.QueryHistoryRetentionPolicy{
+				Retention: queryHistoryRetentionPolicy.Retention,
+			},
+		}
 	case api.PolicyTypeRestrictIssueCreationForSQLReview:
 		pType = v1pb.PolicyType_RESTRICT_ISSUE_CREATION_FOR_SQL_REVIEW
 		payload, err := convertToV1PBRestrictIssueCreationForSQLReviewPolicy(policyMessage.Payload)
@@ -976,22 +1093,9 @@ func convertToV1PBMaskingRulePolicy(policy *storepb.MaskingRulePolicy) (*v1pb.Ma
 func (s *OrgPolicyService) convertToStorePBMaskingExceptionPolicyPayload(ctx context.Context, policy *v1pb.MaskingExceptionPolicy) (*storepb.MaskingExceptionPolicy, error) {
 	var exceptions []*storepb.MaskingExceptionPolicy_MaskingException
 	for _, exception := range policy.MaskingExceptions {
-		member := exception.Member
-		if strings.HasPrefix(exception.Member, "user:") {
-			memberEmail := strings.TrimPrefix(exception.Member, "user:")
-			user, err := s.store.GetUserByEmail(ctx, memberEmail)
-			if err != nil {
-				return nil, err
-			}
-			if user == nil {
-				return nil, status.Errorf(codes.NotFound, "user %q not found", member)
-			}
-			member = common.FormatUserUID(user.ID)
-		} else if strings.HasPrefix(exception.Member, "group:") {
-			email := strings.TrimPrefix(member, "group:")
-			member = common.FormatGroupEmail(email)
-		} else {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid member %s", exception.Member)
+		member, err := s.convertToStorePBMember(ctx, exception.Member)
+		if err != nil {
+			return nil, err
 		}
 		exceptions = append(exceptions, &storepb.MaskingExceptionPolicy_MaskingException{
 			Action:       convertToStorePBAction(exception.Action),
@@ -1014,23 +1118,9 @@ func (s *OrgPolicyService) convertToStorePBMaskingExceptionPolicyPayload(ctx con
 func (s *OrgPolicyService) convertToV1PBMaskingExceptionPolicyPayload(ctx context.Context, policy *storepb.MaskingExceptionPolicy) (*v1pb.MaskingExceptionPolicy, error) {
 	var exceptions []*v1pb.MaskingExceptionPolicy_MaskingException
 	for _, exception := range policy.MaskingExceptions {
-		member := exception.Member
-		if strings.HasPrefix(exception.Member, common.UserNamePrefix) {
-			uid, err := common.GetUserID(exception.Member)
-			if err != nil {
-				return nil, err
-			}
-			user, err := s.store.GetUserByID(ctx, uid)
-			if err != nil {
-				return nil, err
-			}
-			member = fmt.Sprintf("user:%s", user.Email)
-		} else {
-			email, err := common.GetUserGroupEmail(member)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to parse group email from member %s with error: %v", member, err)
-			}
-			member = fmt.Sprintf("group:%s", email)
+		member, err := s.convertToV1PBMember(ctx, exception.Member)
+		if err != nil {
+			return nil, err
 		}
 		exceptions = append(exceptions, &v1pb.MaskingExceptionPolicy_MaskingException{
 			Action:       convertToV1PBAction(exception.Action),
@@ -1050,6 +1140,93 @@ func (s *OrgPolicyService) convertToV1PBMaskingExceptionPolicyPayload(ctx contex
 	}, nil
 }
 
+func (s *OrgPolicyService) convertToStorePBRowFilterPolicyPayload(ctx context.Context, policy *v1pb.RowFilterPolicy) (*storepb.RowFilterPolicy, error) {
+	var rowFilters []*storepb.RowFilterPolicy_RowFilter
+	for _, rowFilter := range policy.RowFilters {
+		member, err := s.convertToStorePBMember(ctx, rowFilter.Member)
+		if err != nil {
+			return nil, err
+		}
+		rowFilters = append(rowFilters, &storepb.RowFilterPolicy_RowFilter{
+			Member: member,
+			Condition: &expr.Expr{
+				Title:       rowFilter.Condition.Title,
+				Expression:  rowFilter.Condition.Expression,
+				Description: rowFilter.Condition.Description,
+				Location:    rowFilter.Condition.Location,
+			},
+			Predicate: rowFilter.Predicate,
+		})
+	}
+
+	return &storepb.RowFilterPolicy{
+		RowFilters: rowFilters,
+	}, nil
+}
+
+func (s *OrgPolicyService) convertToV1PBRowFilterPolicyPayload(ctx context.Context, policy *storepb.RowFilterPolicy) (*v1pb.RowFilterPolicy, error) {
+	var rowFilters []*v1pb.RowFilterPolicy_RowFilter
+	for _, rowFilter := range policy.RowFilters {
+		member, err := s.convertToV1PBMember(ctx, rowFilter.Member)
+		if err != nil {
+			return nil, err
+		}
+		rowFilters = append(rowFilters, &v1pb.RowFilterPolicy_RowFilter{
+			Member: member,
+			Condition: &expr.Expr{
+				Title:       rowFilter.Condition.Title,
+				Expression:  rowFilter.Condition.Expression,
+				Description: rowFilter.Condition.Description,
+				Location:    rowFilter.Condition.Location,
+			},
+			Predicate: rowFilter.Predicate,
+		})
+	}
+
+	return &v1pb.RowFilterPolicy{
+		RowFilters: rowFilters,
+	}, nil
+}
+
+// convertToStorePBMember converts the member in the format of user:{email} or group:{email} to users/{userUID} or groups/{group email}.
+func (s *OrgPolicyService) convertToStorePBMember(ctx context.Context, member string) (string, error) {
+	if strings.HasPrefix(member, "user:") {
+		memberEmail := strings.TrimPrefix(member, "user:")
+		user, err := s.store.GetUserByEmail(ctx, memberEmail)
+		if err != nil {
+			return "", err
+		}
+		if user == nil {
+			return "", status.Errorf(codes.NotFound, "user %q not found", member)
+		}
+		return common.FormatUserUID(user.ID), nil
+	} else if strings.HasPrefix(member, "group:") {
+		email := strings.TrimPrefix(member, "group:")
+		return common.FormatGroupEmail(email), nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "invalid member %s", member)
+}
+
+// convertToV1PBMember converts the member in the format of users/{userUID} or groups/{group email} to user:{email} or group:{email}.
+func (s *OrgPolicyService) convertToV1PBMember(ctx context.Context, member string) (string, error) {
+	if strings.HasPrefix(member, common.UserNamePrefix) {
+		uid, err := common.GetUserID(member)
+		if err != nil {
+			return "", err
+		}
+		user, err := s.store.GetUserByID(ctx, uid)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("user:%s", user.Email), nil
+	}
+	email, err := common.GetUserGroupEmail(member)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to parse group email from member %s with error: %v", member, err)
+	}
+	return fmt.Sprintf("group:%s", email), nil
+}
+
 func convertToV1PBRestrictIssueCreationForSQLReviewPolicy(payloadStr string) (*v1pb.Policy_RestrictIssueCreationForSqlReviewPolicy, error) {
 	payload, err := api.UnmarshalRestrictIssueCreationForSQLReviewPolicy(payloadStr)
 	if err != nil {
@@ -1062,6 +1239,44 @@ func convertToV1PBRestrictIssueCreationForSQLReviewPolicy(payloadStr string) (*v
 	}, nil
 }
 
+func convertToStorePBQueryCostGuardPolicy(policy *v1pb.QueryCostGuardPolicy) *storepb.QueryCostGuardPolicy {
+	var rules []*storepb.QueryCostGuardPolicy_Rule
+	for _, rule := range policy.Rules {
+		rules = append(rules, &storepb.QueryCostGuardPolicy_Rule{
+			Condition: &expr.Expr{
+				Title:       rule.Condition.Title,
+				Expression:  rule.Condition.Expression,
+				Description: rule.Condition.Description,
+				Location:    rule.Condition.Location,
+			},
+			Action: storepb.QueryCostGuardPolicy_Rule_Action(rule.Action),
+		})
+	}
+
+	return &storepb.QueryCostGuardPolicy{
+		Rules: rules,
+	}
+}
+
+func convertToV1PBQueryCostGuardPolicy(policy *storepb.QueryCostGuardPolicy) *v1pb.QueryCostGuardPolicy {
+	var rules []*v1pb.QueryCostGuardPolicy_Rule
+	for _, rule := range policy.Rules {
+		rules = append(rules, &v1pb.QueryCostGuardPolicy_Rule{
+			Condition: &expr.Expr{
+				Title:       rule.Condition.Title,
+				Expression:  rule.Condition.Expression,
+				Description: rule.Condition.Description,
+				Location:    rule.Condition.Location,
+			},
+			Action: v1pb.QueryCostGuardPolicy_Rule_Action(rule.Action),
+		})
+	}
+
+	return &v1pb.QueryCostGuardPolicy{
+		Rules: rules,
+	}
+}
+
 func convertToRestrictIssueCreationForSQLReviewPayload(policy *v1pb.RestrictIssueCreationForSQLReviewPolicy) (*api.RestrictIssueCreationForSQLReviewPolicy, error) {
 	return &api.RestrictIssueCreationForSQLReviewPolicy{
 		Disallow: policy.Disallow,
@@ -1087,6 +1302,12 @@ func convertPolicyType(pType string) (api.PolicyType, error) {
 		return api.PolicyTypeDisableCopyData, nil
 	case v1pb.PolicyType_RESTRICT_ISSUE_CREATION_FOR_SQL_REVIEW.String():
 		return api.PolicyTypeRestrictIssueCreationForSQLReview, nil
+	case v1pb.PolicyType_ROW_FILTER.String():
+		return api.PolicyTypeRowFilter, nil
+	case v1pb.PolicyType_QUERY_COST_GUARD.String():
+		return api.PolicyTypeQueryCostGuard, nil
+	case v1pb.PolicyType_QUERY_HISTORY_RETENTION.String():
+		return api.PolicyTypeQueryHistoryRetention, nil
 	}
 	return policyType, errors.Errorf("invalid policy type %v", pType)
 }
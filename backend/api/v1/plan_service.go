@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
@@ -199,6 +200,62 @@ func (s *PlanService) SearchPlans(ctx context.Context, request *v1pb.SearchPlans
 	}, nil
 }
 
+// validatePlanDependencies validates that the plans exist and don't depend on the plan directly or indirectly.
+// The plans can be in other projects, in which case the user must be able to get the plans of the projects.
+// The planUID is 0 for a plan to create.
+func validatePlanDependencies(ctx context.Context, s *store.Store, iamManager *iam.Manager, planUID int64, dependsOnPlans []string) error {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return status.Errorf(codes.Internal, "user not found")
+	}
+	for _, name := range dependsOnPlans {
+		projectID, _, err := common.GetProjectIDPlanID(name)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		ok, err := iamManager.CheckPermission(ctx, iam.PermissionPlansGet, user, projectID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+		}
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "permission denied to get plan %q", name)
+		}
+	}
+
+	visited := map[int64]bool{}
+	var visit func(names []string) error
+	visit = func(names []string) error {
+		for _, name := range names {
+			projectID, uid, err := common.GetProjectIDPlanID(name)
+			if err != nil {
+				return err
+			}
+			if uid == planUID {
+				return errors.Errorf("plan %q depends on the plan itself", name)
+			}
+			if visited[uid] {
+				continue
+			}
+			visited[uid] = true
+			plan, err := s.GetPlan(ctx, &store.FindPlanMessage{UID: &uid})
+			if err != nil {
+				return errors.Wrapf(err, "failed to get plan %q", name)
+			}
+			if plan == nil || plan.ProjectID != projectID {
+				return errors.Errorf("plan %q not found", name)
+			}
+			if err := visit(plan.Config.GetDependsOnPlans()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := visit(dependsOnPlans); err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to validate plan dependencies, error: %v", err)
+	}
+	return nil
+}
+
 // CreatePlan creates a new plan.
 func (s *PlanService) CreatePlan(ctx context.Context, request *v1pb.CreatePlanRequest) (*v1pb.Plan, error) {
 	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
@@ -221,6 +278,9 @@ func (s *PlanService) CreatePlan(ctx context.Context, request *v1pb.CreatePlanRe
 	if err := validateSteps(request.Plan.Steps); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to validate plan steps, error: %v", err)
 	}
+	if err := validatePlanDependencies(ctx, s.store, s.iamManager, 0, request.Plan.DependsOnPlans); err != nil {
+		return nil, err
+	}
 
 	planMessage := &store.PlanMessage{
 		ProjectID:   projectID,
@@ -228,13 +288,16 @@ func (s *PlanService) CreatePlan(ctx context.Context, request *v1pb.CreatePlanRe
 		Name:        request.Plan.Title,
 		Description: request.Plan.Description,
 		Config: &storepb.PlanConfig{
-			Steps: convertPlanSteps(request.Plan.Steps),
+			Steps:          convertPlanSteps(request.Plan.Steps),
+			DependsOnPlans: request.Plan.DependsOnPlans,
 		},
 	}
 	if request.GetPlan().VcsSource != nil {
 		planMessage.Config.VcsSource = &storepb.PlanConfig_VCSSource{
 			VcsConnector:   request.GetPlan().GetVcsSource().GetVcsConnector(),
 			PullRequestUrl: request.GetPlan().GetVcsSource().GetPullRequestUrl(),
+			CommitId:       request.GetPlan().GetVcsSource().GetCommitId(),
+			Tag:            request.GetPlan().GetVcsSource().GetTag(),
 			VcsType:        storepb.VCSType(request.GetPlan().GetVcsSource().VcsType),
 		}
 	}
@@ -323,10 +386,19 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePlanRe
 		case "description":
 			description := request.Plan.Description
 			planUpdate.Description = &description
+		case "depends_on_plans":
+			if err := validatePlanDependencies(ctx, s.store, s.iamManager, oldPlan.UID, request.Plan.DependsOnPlans); err != nil {
+				return nil, err
+			}
+			if planUpdate.Config == nil {
+				planUpdate.Config = proto.Clone(oldPlan.Config).(*storepb.PlanConfig)
+			}
+			planUpdate.Config.DependsOnPlans = request.Plan.DependsOnPlans
 		case "steps":
-			planUpdate.Config = &storepb.PlanConfig{
-				Steps: convertPlanSteps(request.Plan.Steps),
+			if planUpdate.Config == nil {
+				planUpdate.Config = proto.Clone(oldPlan.Config).(*storepb.PlanConfig)
 			}
+			planUpdate.Config.Steps = convertPlanSteps(request.Plan.Steps)
 
 			if _, err := GetPipelineCreate(ctx, s.store, s.sheetManager, s.licenseService, s.dbFactory, convertPlanSteps(request.Plan.GetSteps()), project); err != nil {
 				return nil, status.Errorf(codes.InvalidArgument, "failed to get pipeline from the plan, please check you request, error: %v", err)
@@ -338,6 +410,12 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePlanRe
 				return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
 			}
 
+			// The specs of the plans without issue and pipeline, e.g. the plans to check the pull requests, are replaced as a whole.
+			if issue == nil && oldPlan.PipelineUID == nil {
+				doUpdateSheet = true
+				break
+			}
+
 			removed, added, updated := diffSpecs(oldSteps, request.Plan.Steps)
 			if len(removed) > 0 {
 				return nil, status.Errorf(codes.InvalidArgument, "cannot remove specs from plan")
@@ -483,6 +561,44 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePlanRe
 						return nil, err
 					}
 
+					// BatchDetail
+					if err := func() error {
+						if task.Type != api.TaskDatabaseDataUpdate {
+							return nil
+						}
+						payload := &api.TaskDatabaseDataUpdatePayload{}
+						if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+							return status.Errorf(codes.Internal, "failed to unmarshal task payload: %v", err)
+						}
+						config, ok := spec.Config.(*v1pb.Plan_Spec_ChangeDatabaseConfig)
+						if !ok {
+							return nil
+						}
+
+						batchDetail := &api.BatchDetail{}
+						if d := config.ChangeDatabaseConfig.BatchDetail; d != nil {
+							if d.BatchSize <= 0 || d.SleepIntervalMs < 0 || d.MaxReplicaLagSeconds < 0 {
+								return status.Errorf(codes.InvalidArgument, "invalid batch detail, batch size must be positive and sleep interval and max replica lag must not be negative")
+							}
+							batchDetail = &api.BatchDetail{
+								BatchSize:            int(d.BatchSize),
+								SleepIntervalMs:      int(d.SleepIntervalMs),
+								MaxReplicaLagSeconds: int(d.MaxReplicaLagSeconds),
+							}
+						}
+						oldBatchDetail := &api.BatchDetail{}
+						if payload.BatchDetail != nil {
+							oldBatchDetail = payload.BatchDetail
+						}
+						if *batchDetail != *oldBatchDetail {
+							taskPatch.BatchDetail = batchDetail
+							doUpdate = true
+						}
+						return nil
+					}(); err != nil {
+						return nil, err
+					}
+
 					// Sheet
 					if err := func() error {
 						switch task.Type {
@@ -713,6 +829,19 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePlanRe
 					slog.Error("failed to update issue to refind approval", log.BBError(err))
 				}
 			}
+		case "vcs_source":
+			// Only the commit can be updated, e.g. for the new commits pushed to the pull request.
+			oldVCSSource, newVCSSource := oldPlan.Config.GetVcsSource(), request.Plan.GetVcsSource()
+			if oldVCSSource == nil {
+				return nil, status.Errorf(codes.InvalidArgument, "plan %q has no VCS source", request.Plan.Name)
+			}
+			if newVCSSource.GetVcsConnector() != oldVCSSource.GetVcsConnector() || newVCSSource.GetPullRequestUrl() != oldVCSSource.GetPullRequestUrl() {
+				return nil, status.Errorf(codes.InvalidArgument, "only the commit of the VCS source can be updated")
+			}
+			if planUpdate.Config == nil {
+				planUpdate.Config = proto.Clone(oldPlan.Config).(*storepb.PlanConfig)
+			}
+			planUpdate.Config.VcsSource.CommitId = newVCSSource.GetCommitId()
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask path %q", path)
 		}
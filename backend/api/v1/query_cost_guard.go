@@ -0,0 +1,393 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// queryCostEstimateTimeout is the timeout to EXPLAIN the query.
+	queryCostEstimateTimeout = 10 * time.Second
+	// queryCostApprovalExpiration is how long the approved query can be run after the approval.
+	queryCostApprovalExpiration = 1 * time.Hour
+	// queryCostApprovalLookback is how long ago the approval issues of the queries are looked up.
+	queryCostApprovalLookback = 7 * 24 * time.Hour
+)
+
+// queryCostEstimate is the cost of a query estimated by the optimizer.
+type queryCostEstimate struct {
+	// rows is the maximum number of rows estimated to be examined by a step of the plan.
+	rows int64
+	// cost is the total cost estimated by the optimizer.
+	cost     float64
+	fullScan bool
+}
+
+// worstQueryCostEstimate is the estimation to evaluate the rules for the query whose cost cannot be estimated.
+var worstQueryCostEstimate = &queryCostEstimate{
+	rows:     math.MaxInt64,
+	cost:     math.MaxFloat64,
+	fullScan: true,
+}
+
+// guardQueryCost estimates the cost of the query and takes the strictest action of the query cost guard rules matched.
+// It returns the advices if the query is warned, and the error if the query is blocked or requires approval.
+// The query whose cost cannot be estimated is guarded with the worst estimation, so that it fails closed.
+// The result is nil if the query is not guarded.
+func (s *SQLService) guardQueryCost(ctx context.Context, request *v1pb.QueryRequest, user *store.UserMessage, instance *store.InstanceMessage, database *store.DatabaseMessage) (*storepb.QueryCostGuardResult, []*v1pb.Advice, error) {
+	if request.Explain || s.licenseService.IsFeatureEnabled(api.FeatureAccessControl) != nil {
+		return nil, nil, nil
+	}
+	policy, err := s.store.GetQueryCostGuardPolicy(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get query cost guard policy: %v", err)
+	}
+	if len(policy.Rules) == 0 {
+		return nil, nil, nil
+	}
+
+	result := &storepb.QueryCostGuardResult{}
+	var summary string
+	estimate, err := s.estimateQueryCost(ctx, request, instance, database)
+	if err != nil {
+		slog.Warn("failed to estimate query cost", slog.String("database", database.DatabaseName), log.BBError(err))
+		result.EstimateError = err.Error()
+		result.Action = evaluateQueryCostGuardRules(policy.Rules, instance, database, worstQueryCostEstimate)
+		summary = fmt.Sprintf("The cost of the query cannot be estimated: %s", err.Error())
+	} else {
+		result.EstimatedRows = estimate.rows
+		result.EstimatedCost = estimate.cost
+		result.FullScan = estimate.fullScan
+		result.Action = evaluateQueryCostGuardRules(policy.Rules, instance, database, estimate)
+		summary = fmt.Sprintf("The query is estimated to examine %d rows at a cost of %.2f", estimate.rows, estimate.cost)
+		if estimate.fullScan {
+			summary += " with a full table scan"
+		}
+	}
+	switch result.Action {
+	case storepb.QueryCostGuardPolicy_Rule_WARN:
+		return result, []*v1pb.Advice{
+			{
+				Status:  v1pb.Advice_WARNING,
+				Code:    int32(advisor.ExpensiveQuery),
+				Title:   "Expensive query",
+				Content: summary + ".",
+			},
+		}, nil
+	case storepb.QueryCostGuardPolicy_Rule_REQUIRE_APPROVAL:
+		issue, approved, err := s.getOrCreateQueryApprovalIssue(ctx, request, user, instance, database, summary)
+		if err != nil {
+			return result, nil, status.Errorf(codes.Internal, "failed to request approval for the query: %v", err)
+		}
+		result.Issue = common.FormatIssue(issue.Project.ResourceID, issue.UID)
+		if approved {
+			return result, nil, nil
+		}
+		return result, nil, status.Errorf(codes.FailedPrecondition, "%s, the query requires approval which is requested in issue %q", summary, result.Issue)
+	case storepb.QueryCostGuardPolicy_Rule_BLOCK:
+		return result, nil, status.Errorf(codes.PermissionDenied, "%s, the query is blocked by the query cost guard policy", summary)
+	default:
+		return result, nil, nil
+	}
+}
+
+// estimateQueryCost explains the statements of the query and aggregates the estimations.
+func (s *SQLService) estimateQueryCost(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) (*queryCostEstimate, error) {
+	switch instance.Engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_POSTGRES:
+	default:
+		return nil, errors.Errorf("cannot explain query for %s", instance.Engine.String())
+	}
+	list, err := base.SplitMultiSQL(instance.Engine, request.Statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to split statement")
+	}
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, request.DataSourceId)
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		return nil, errors.Errorf("cannot explain query for %s", instance.Engine.String())
+	}
+	ctx, cancel := context.WithTimeout(ctx, queryCostEstimateTimeout)
+	defer cancel()
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return explainQueryCost(ctx, conn, instance.Engine, list)
+}
+
+// explainQueryCost explains the statements in the connection and aggregates the estimations.
+// It returns the error if any statement cannot be explained, e.g. SHOW, so that the query is guarded with the worst estimation.
+func explainQueryCost(ctx context.Context, conn *sql.Conn, engine storepb.Engine, list []base.SingleSQL) (*queryCostEstimate, error) {
+	estimate := &queryCostEstimate{}
+	for _, item := range base.FilterEmptySQL(list) {
+		statement := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(item.Text), ";"))
+		if statement == "" {
+			continue
+		}
+		explain := fmt.Sprintf("EXPLAIN FORMAT=JSON %s", statement)
+		if engine == storepb.Engine_POSTGRES {
+			explain = fmt.Sprintf("EXPLAIN (FORMAT JSON) %s", statement)
+		}
+		var plan string
+		if err := conn.QueryRowContext(ctx, explain).Scan(&plan); err != nil {
+			return nil, errors.Wrapf(err, "failed to explain statement %q", statement)
+		}
+		e, err := parseExplainPlan(plan)
+		if err != nil {
+			return nil, err
+		}
+		estimate.rows = max(estimate.rows, e.rows)
+		estimate.cost += e.cost
+		estimate.fullScan = estimate.fullScan || e.fullScan
+	}
+	return estimate, nil
+}
+
+// parseExplainPlan parses the plan of EXPLAIN in the JSON format of MySQL, MariaDB and PostgreSQL.
+func parseExplainPlan(plan string) (*queryCostEstimate, error) {
+	var v any
+	if err := json.Unmarshal([]byte(plan), &v); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal plan")
+	}
+	estimate := &queryCostEstimate{}
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case []any:
+			for _, item := range v {
+				walk(item)
+			}
+		case map[string]any:
+			for key, value := range v {
+				switch key {
+				case "access_type":
+					// The access type of MySQL and MariaDB.
+					if value == "ALL" {
+						estimate.fullScan = true
+					}
+				case "Node Type":
+					// The node type of PostgreSQL.
+					if value == "Seq Scan" {
+						estimate.fullScan = true
+					}
+				case "rows_examined_per_scan", "rows", "Plan Rows":
+					if rows, ok := getPlanNumber(value); ok {
+						estimate.rows = max(estimate.rows, int64(rows))
+					}
+				case "query_cost", "Total Cost":
+					// The cost of the outermost step covers the inner steps.
+					if cost, ok := getPlanNumber(value); ok {
+						estimate.cost = max(estimate.cost, cost)
+					}
+				default:
+					walk(value)
+				}
+			}
+		}
+	}
+	walk(v)
+	return estimate, nil
+}
+
+// getPlanNumber returns the number in the plan, MySQL formats some numbers as strings.
+func getPlanNumber(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// evaluateQueryCostGuardRules returns the strictest action of the rules matched by the estimation.
+func evaluateQueryCostGuardRules(rules []*storepb.QueryCostGuardPolicy_Rule, instance *store.InstanceMessage, database *store.DatabaseMessage, estimate *queryCostEstimate) storepb.QueryCostGuardPolicy_Rule_Action {
+	action := storepb.QueryCostGuardPolicy_Rule_ACTION_UNSPECIFIED
+	e, err := cel.NewEnv(common.QueryCostGuardCELAttributes...)
+	if err != nil {
+		slog.Error("failed to create cel env for query cost guard", log.BBError(err))
+		return action
+	}
+	input := map[string]any{
+		"environment_id": database.EffectiveEnvironmentID,
+		"project_id":     database.ProjectID,
+		"instance_id":    instance.ResourceID,
+		"database_name":  database.DatabaseName,
+		"db_engine":      instance.Engine.String(),
+		"estimated_rows": estimate.rows,
+		"estimated_cost": estimate.cost,
+		"full_scan":      estimate.fullScan,
+	}
+	for _, rule := range rules {
+		if rule.Action <= action {
+			continue
+		}
+		ast, issues := e.Compile(rule.GetCondition().GetExpression())
+		if issues != nil && issues.Err() != nil {
+			slog.Error("failed to compile query cost guard rule", slog.String("expression", rule.GetCondition().GetExpression()), log.BBError(issues.Err()))
+			continue
+		}
+		prg, err := e.Program(ast)
+		if err != nil {
+			slog.Error("failed to create program for query cost guard rule", slog.String("expression", rule.GetCondition().GetExpression()), log.BBError(err))
+			continue
+		}
+		out, _, err := prg.Eval(input)
+		if err != nil {
+			slog.Error("failed to evaluate query cost guard rule", slog.String("expression", rule.GetCondition().GetExpression()), log.BBError(err))
+			continue
+		}
+		if matched, ok := out.Value().(bool); ok && matched {
+			action = rule.Action
+		}
+	}
+	return action
+}
+
+// getOrCreateQueryApprovalIssue returns the grant request issue requesting the approval of the query, and whether the query is approved.
+// The query is approved if the approval of the issue is fully approved and it's unexpired from the time of the last approval.
+// The issue is created if there is neither an open request nor an approval unexpired for the query.
+func (s *SQLService) getOrCreateQueryApprovalIssue(ctx context.Context, request *v1pb.QueryRequest, user *store.UserMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, summary string) (*store.IssueMessage, bool, error) {
+	digest, err := getQueryDigest(request, instance, database)
+	if err != nil {
+		return nil, false, err
+	}
+	createdTsAfter := time.Now().Add(-queryCostApprovalLookback).Unix()
+	issues, err := s.store.ListIssueV2(ctx, &store.FindIssueMessage{
+		ProjectID:      &database.ProjectID,
+		CreatorID:      &user.ID,
+		Types:          &[]api.IssueType{api.IssueGrantRequest},
+		StatusList:     []api.IssueStatus{api.IssueOpen, api.IssueDone},
+		CreatedTsAfter: &createdTsAfter,
+	})
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to list issues")
+	}
+	for _, issue := range issues {
+		if issue.Payload.GetGrantRequest().GetQueryDigest() != digest {
+			continue
+		}
+		approved, err := utils.CheckIssueApproved(issue)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "failed to check if issue %d is approved", issue.UID)
+		}
+		if !approved {
+			if issue.Status == api.IssueOpen {
+				return issue, false, nil
+			}
+			continue
+		}
+		approveTime, err := s.getIssueApproveTime(ctx, issue)
+		if err != nil {
+			return nil, false, err
+		}
+		if time.Since(approveTime) < queryCostApprovalExpiration {
+			return issue, true, nil
+		}
+	}
+
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to get project")
+	}
+	if project == nil {
+		return nil, false, errors.Errorf("project %q not found", database.ProjectID)
+	}
+	databaseName := common.FormatDatabase(instance.ResourceID, database.DatabaseName)
+	issue, err := s.store.CreateIssueV2(ctx, &store.IssueMessage{
+		Project:     project,
+		Title:       fmt.Sprintf("Request to run an expensive query on %s", database.DatabaseName),
+		Status:      api.IssueOpen,
+		Type:        api.IssueGrantRequest,
+		Description: fmt.Sprintf("%s.\n\n```sql\n%s\n```", summary, request.Statement),
+		Payload: &storepb.IssuePayload{
+			GrantRequest: &storepb.GrantRequest{
+				Role: common.FormatRole(api.ProjectQuerier.String()),
+				User: common.FormatUserUID(user.ID),
+				Condition: &expr.Expr{
+					Expression: fmt.Sprintf("resource.database == %q", databaseName),
+				},
+				Expiration:  durationpb.New(queryCostApprovalExpiration),
+				QueryDigest: digest,
+			},
+			Approval: &storepb.IssuePayloadApproval{
+				ApprovalFindingDone: false,
+			},
+		},
+	}, user.ID)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to create issue")
+	}
+	s.stateCfg.ApprovalFinding.Store(issue.UID, issue)
+	return issue, false, nil
+}
+
+// getIssueApproveTime returns the time of the last approval of the approved issue.
+// The issue approved without any approval step is approved when it's created.
+func (s *SQLService) getIssueApproveTime(ctx context.Context, issue *store.IssueMessage) (time.Time, error) {
+	comments, err := s.store.ListIssueComment(ctx, &store.FindIssueCommentMessage{IssueUID: &issue.UID})
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to list comments of issue %d", issue.UID)
+	}
+	approveTime := issue.CreatedTime
+	for _, comment := range comments {
+		if comment.Payload.GetApproval().GetStatus() == storepb.IssueCommentPayload_Approval_APPROVED {
+			approveTime = time.Unix(comment.CreatedTs, 0)
+		}
+	}
+	return approveTime, nil
+}
+
+// getQueryDigest returns the digest of the normalized statement and the options of the query.
+func getQueryDigest(request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) (string, error) {
+	statement, err := normalizeQueryStatement(instance, request.Statement)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	for _, v := range []string{
+		instance.ResourceID,
+		database.DatabaseName,
+		request.DataSourceId,
+		strconv.Itoa(int(request.Limit)),
+		statement,
+	} {
+		// Writing to the hash never returns an error.
+		_, _ = h.Write([]byte(v))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// maxQueryResultCacheTTL is the maximum time-to-live of the cached query results.
+	maxQueryResultCacheTTL = 24 * time.Hour
+	// maxQueryResultCacheEntries is the maximum number of the cached query results.
+	maxQueryResultCacheEntries = 1000
+	// maxQueryResultCacheEntrySize is the maximum size in bytes of the cached query results of a query.
+	maxQueryResultCacheEntrySize = 1024 * 1024
+)
+
+// queryResultCacheEntry is the unmasked results of a read-only query, which are masked on every hit.
+type queryResultCacheEntry struct {
+	results    []*v1pb.QueryResult
+	cacheTime  time.Time
+	expireTime time.Time
+}
+
+// queryResultCache caches the unmasked results of the read-only queries for the instances opted in.
+type queryResultCache struct {
+	// mu serializes the lookups and the updates of the entries with the same key.
+	mu      sync.Mutex
+	entries *lru.Cache[string, *queryResultCacheEntry]
+}
+
+func newQueryResultCache() *queryResultCache {
+	entries, err := lru.New[string, *queryResultCacheEntry](maxQueryResultCacheEntries)
+	if err != nil {
+		panic(err)
+	}
+	return &queryResultCache{entries: entries}
+}
+
+// get returns the unexpired results of the key, which must not be modified.
+func (c *queryResultCache) get(key string) *queryResultCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries.Get(key)
+	if !ok {
+		return nil
+	}
+	if time.Now().After(entry.expireTime) {
+		c.entries.Remove(key)
+		return nil
+	}
+	return entry
+}
+
+// add caches the results of the key, the results larger than maxQueryResultCacheEntrySize are not cached.
+func (c *queryResultCache) add(key string, results []*v1pb.QueryResult, ttl time.Duration) {
+	size := 0
+	for _, result := range results {
+		size += proto.Size(result)
+	}
+	if size > maxQueryResultCacheEntrySize {
+		return
+	}
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries.Add(key, &queryResultCacheEntry{
+		results:    cloneQueryResults(results),
+		cacheTime:  now,
+		expireTime: now.Add(ttl),
+	})
+}
+
+// getQueryResultCacheTTL returns the time-to-live of the query result cache of the instance, zero if the cache is disabled.
+func getQueryResultCacheTTL(instance *store.InstanceMessage) time.Duration {
+	ttl := instance.Options.GetQueryResultCacheTtl().AsDuration()
+	return min(max(ttl, 0), maxQueryResultCacheTTL)
+}
+
+// getQueryResultCacheKey returns the key of the query results in the cache.
+// The key covers the normalized statement rewritten with the row filters and the options of the query,
+// and the schema version and the latest change of the database, so that the results are invalidated by the changes.
+func (s *SQLService) getQueryResultCacheKey(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) (string, error) {
+	statement, err := normalizeQueryStatement(instance, request.Statement)
+	if err != nil {
+		return "", err
+	}
+	limit := 1
+	changeHistories, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		InstanceID: &instance.UID,
+		DatabaseID: &database.UID,
+		Limit:      &limit,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list change histories")
+	}
+	latestChange := ""
+	if len(changeHistories) > 0 {
+		latestChange = changeHistories[0].UID + string(changeHistories[0].Status)
+	}
+
+	h := sha256.New()
+	for _, v := range []string{
+		instance.ResourceID,
+		database.DatabaseName,
+		request.DataSourceId,
+		strconv.Itoa(int(request.Limit)),
+		strconv.FormatBool(request.Explain),
+		database.SchemaVersion.Version,
+		latestChange,
+		statement,
+	} {
+		// Writing to the hash never returns an error.
+		_, _ = h.Write([]byte(v))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// normalizeQueryStatement normalizes the statement by trimming the spaces and the delimiters around the statements.
+func normalizeQueryStatement(instance *store.InstanceMessage, statement string) (string, error) {
+	list, err := base.SplitMultiSQL(instance.Engine, statement)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to split statement")
+	}
+	var statements []string
+	for _, sql := range base.FilterEmptySQL(list) {
+		text := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(sql.Text), ";"))
+		if text != "" {
+			statements = append(statements, text)
+		}
+	}
+	return strings.Join(statements, ";\n"), nil
+}
+
+func cloneQueryResults(results []*v1pb.QueryResult) []*v1pb.QueryResult {
+	var cloned []*v1pb.QueryResult
+	for _, result := range results {
+		cloned = append(cloned, proto.Clone(result).(*v1pb.QueryResult))
+	}
+	return cloned
+}
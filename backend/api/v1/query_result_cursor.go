@@ -0,0 +1,273 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// queryResultIdleTimeout is the timeout to release the connection of a paged query result without fetching.
+	queryResultIdleTimeout = 5 * time.Minute
+	// queryResultLifetime is the maximum lifetime of a paged query result, after which its query is canceled.
+	queryResultLifetime = time.Hour
+	// maxQueryResultPageSize is the maximum number of rows in a page.
+	maxQueryResultPageSize = 10000
+	// maxQueryResultsPerUser is the maximum number of the paged query results held for a user.
+	maxQueryResultsPerUser = 10
+)
+
+// queryResultCursor is the cursor of a paged query result, which holds the connection until all the rows are fetched.
+type queryResultCursor struct {
+	token    string
+	userID   int
+	instance *store.InstanceMessage
+	spans    []*base.QuerySpan
+
+	// mu serializes the fetches and the release of the cursor.
+	mu     sync.Mutex
+	cursor *util.QueryCursor
+	driver db.Driver
+	conn   *sql.Conn
+	cancel context.CancelFunc
+	timer  *time.Timer
+	// remaining is the number of rows can be fetched within the limit of the query, and negative if unlimited.
+	remaining int
+}
+
+// queryResultCursors holds the cursors of the paged query results.
+type queryResultCursors struct {
+	sync.Mutex
+	cursors map[string]*queryResultCursor
+}
+
+func newQueryResultCursors() *queryResultCursors {
+	return &queryResultCursors{cursors: map[string]*queryResultCursor{}}
+}
+
+func (m *queryResultCursors) add(c *queryResultCursor) error {
+	m.Lock()
+	defer m.Unlock()
+	count := 0
+	for _, v := range m.cursors {
+		if v.userID == c.userID {
+			count++
+		}
+	}
+	if count >= maxQueryResultsPerUser {
+		return status.Errorf(codes.ResourceExhausted, "too many paged query results, fetch all their pages or close them first")
+	}
+	m.cursors[c.token] = c
+	c.timer = time.AfterFunc(queryResultIdleTimeout, func() {
+		m.release(c)
+	})
+	return nil
+}
+
+func (m *queryResultCursors) get(token string) *queryResultCursor {
+	m.Lock()
+	defer m.Unlock()
+	return m.cursors[token]
+}
+
+// release closes the cursor and releases its connection.
+func (m *queryResultCursors) release(c *queryResultCursor) {
+	m.Lock()
+	delete(m.cursors, c.token)
+	m.Unlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cursor == nil {
+		return
+	}
+	c.timer.Stop()
+	if err := c.cursor.Close(); err != nil {
+		slog.Warn("failed to close query cursor", log.BBError(err))
+	}
+	if err := c.conn.Close(); err != nil {
+		slog.Warn("failed to close connection", log.BBError(err))
+	}
+	c.driver.Close(context.Background())
+	c.cancel()
+	c.cursor = nil
+}
+
+// isPagedQuerySupported returns true if the engine supports the readonly transaction of the cursor on its connection.
+func isPagedQuerySupported(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE, storepb.Engine_POSTGRES:
+		return true
+	default:
+		return false
+	}
+}
+
+// doPagedQuery opens the cursor of the query and returns the first page.
+func (s *SQLService) doPagedQuery(ctx context.Context, request *v1pb.QueryRequest, user *store.UserMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan) ([]*v1pb.QueryResult, int64, error) {
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, request.DataSourceId)
+	if err != nil {
+		return nil, 0, err
+	}
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		driver.Close(ctx)
+		return nil, 0, errors.Errorf("paged query is not supported for %s", instance.Engine.String())
+	}
+
+	// The connection outlives the request within the lifetime of the query result,
+	// and it is canceled if the request is canceled before the cursor is opened.
+	cursorCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), queryResultLifetime)
+	stop := context.AfterFunc(ctx, cancel)
+	start := time.Now().UnixNano()
+	conn, err := sqlDB.Conn(cursorCtx)
+	if err != nil {
+		cancel()
+		driver.Close(ctx)
+		return nil, 0, err
+	}
+	cursor, err := util.OpenQueryCursor(cursorCtx, instance.Engine, conn, request.Statement, isReadOnlyTransactionSupported(instance, database))
+	if err != nil || !stop() {
+		if err == nil {
+			_ = cursor.Close()
+			err = ctx.Err()
+		}
+		_ = conn.Close()
+		cancel()
+		driver.Close(ctx)
+		return nil, time.Now().UnixNano() - start, err
+	}
+
+	c := &queryResultCursor{
+		token:     uuid.NewString(),
+		userID:    user.ID,
+		instance:  instance,
+		spans:     spans,
+		cursor:    cursor,
+		driver:    driver,
+		conn:      conn,
+		cancel:    cancel,
+		remaining: -1,
+	}
+	if request.Limit > 0 {
+		c.remaining = int(request.Limit)
+	}
+	if err := s.queryResultCursors.add(c); err != nil {
+		_ = cursor.Close()
+		_ = conn.Close()
+		cancel()
+		driver.Close(ctx)
+		return nil, time.Now().UnixNano() - start, err
+	}
+	result, err := s.fetchQueryResultPage(ctx, c, int(request.PageSize))
+	if err != nil {
+		return nil, time.Now().UnixNano() - start, err
+	}
+	return []*v1pb.QueryResult{result}, time.Now().UnixNano() - start, nil
+}
+
+// fetchQueryResultPage fetches the next page of the cursor, and releases the cursor if all the rows are fetched.
+func (s *SQLService) fetchQueryResultPage(ctx context.Context, c *queryResultCursor, pageSize int) (*v1pb.QueryResult, error) {
+	c.mu.Lock()
+	if c.cursor == nil {
+		c.mu.Unlock()
+		return nil, status.Errorf(codes.NotFound, "query result not found or expired")
+	}
+	if c.remaining >= 0 && pageSize > c.remaining {
+		pageSize = c.remaining
+	}
+	var result *v1pb.QueryResult
+	var err error
+	if pageSize > 0 {
+		result, err = c.cursor.Next(pageSize)
+	} else {
+		// The limit of the query is reached.
+		result = &v1pb.QueryResult{}
+	}
+	done := err != nil || pageSize == 0 || c.cursor.Done()
+	if err == nil && c.remaining >= 0 {
+		c.remaining -= len(result.Rows)
+		done = done || c.remaining == 0
+	}
+	if !done {
+		c.timer.Reset(queryResultIdleTimeout)
+	}
+	c.mu.Unlock()
+
+	if done {
+		s.queryResultCursors.release(c)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sanitizeResults([]*v1pb.QueryResult{result})
+	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, c.instance) == nil {
+		masker := NewQueryResultMasker(s.store)
+		if err := masker.MaskResults(ctx, c.spans, []*v1pb.QueryResult{result}, c.instance, storepb.MaskingExceptionPolicy_MaskingException_QUERY); err != nil {
+			return nil, err
+		}
+	}
+	if !done {
+		result.NextPageToken = c.token
+	}
+	return result, nil
+}
+
+// FetchQueryResult fetches the next page of the paged query result.
+func (s *SQLService) FetchQueryResult(ctx context.Context, request *v1pb.FetchQueryResultRequest) (*v1pb.QueryResult, error) {
+	if request.PageSize <= 0 || request.PageSize > maxQueryResultPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must be in (0, %d]", maxQueryResultPageSize)
+	}
+	c, err := s.getQueryResultCursor(ctx, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.fetchQueryResultPage(ctx, c, int(request.PageSize))
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch query result: %v", err)
+	}
+	return result, nil
+}
+
+// CloseQueryResult releases the connection of the paged query result.
+func (s *SQLService) CloseQueryResult(ctx context.Context, request *v1pb.CloseQueryResultRequest) (*emptypb.Empty, error) {
+	c, err := s.getQueryResultCursor(ctx, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	s.queryResultCursors.release(c)
+	return &emptypb.Empty{}, nil
+}
+
+// getQueryResultCursor gets the cursor of the page token, which can only be used by the user running the query.
+func (s *SQLService) getQueryResultCursor(ctx context.Context, token string) (*queryResultCursor, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c := s.queryResultCursors.get(token)
+	if c == nil || c.userID != user.ID {
+		return nil, status.Errorf(codes.NotFound, "query result not found or expired")
+	}
+	return c, nil
+}
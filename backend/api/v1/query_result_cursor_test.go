@@ -0,0 +1,164 @@
+package v1
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// noMaskingLicenseService disables the sensitive data feature, so that the query results are not masked.
+type noMaskingLicenseService struct {
+	enterprise.LicenseService
+}
+
+func (noMaskingLicenseService) IsFeatureEnabledForInstance(api.FeatureType, *store.InstanceMessage) error {
+	return errors.New("feature disabled")
+}
+
+// closeCountingDriver counts the closes of the driver.
+type closeCountingDriver struct {
+	db.Driver
+	closed atomic.Int32
+}
+
+func (d *closeCountingDriver) Close(context.Context) error {
+	d.closed.Add(1)
+	return nil
+}
+
+// newMockQueryResultCursor opens the cursor of a query returning n rows, with the limit of the query or -1 for unlimited.
+func newMockQueryResultCursor(t *testing.T, userID, n, remaining int) (*queryResultCursor, *closeCountingDriver, sqlmock.Sqlmock) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	conn, err := sqlDB.Conn(ctx)
+	require.NoError(t, err)
+
+	rows := sqlmock.NewRowsWithColumnDefinition(sqlmock.NewColumn("id").OfType("INT", int64(0)))
+	for i := 1; i <= n; i++ {
+		rows.AddRow(int64(i))
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM t").WillReturnRows(rows)
+	cursor, err := util.OpenQueryCursor(ctx, storepb.Engine_POSTGRES, conn, "SELECT id FROM t", true)
+	require.NoError(t, err)
+	mock.ExpectRollback()
+
+	driver := &closeCountingDriver{}
+	return &queryResultCursor{
+		token:     "token",
+		userID:    userID,
+		instance:  &store.InstanceMessage{Engine: storepb.Engine_POSTGRES},
+		cursor:    cursor,
+		driver:    driver,
+		conn:      conn,
+		cancel:    cancel,
+		remaining: remaining,
+	}, driver, mock
+}
+
+func TestFetchQueryResultPage(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		rows      int
+		limit     int
+		pageSize  int
+		wantPages []int
+	}{
+		{rows: 5, limit: -1, pageSize: 2, wantPages: []int{2, 2, 1}},
+		// The last page is cut by the limit of the query.
+		{rows: 5, limit: 3, pageSize: 2, wantPages: []int{2, 1}},
+		// The cursor is released once the limit is reached even if the cursor has more rows.
+		{rows: 5, limit: 4, pageSize: 2, wantPages: []int{2, 2}},
+		{rows: 0, limit: -1, pageSize: 2, wantPages: []int{0}},
+	}
+
+	for _, test := range tests {
+		s := &SQLService{licenseService: noMaskingLicenseService{}, queryResultCursors: newQueryResultCursors()}
+		c, driver, mock := newMockQueryResultCursor(t, 1, test.rows, test.limit)
+		require.NoError(t, s.queryResultCursors.add(c))
+
+		for i, want := range test.wantPages {
+			result, err := s.fetchQueryResultPage(ctx, c, test.pageSize)
+			require.NoError(t, err)
+			require.Len(t, result.Rows, want)
+			last := i == len(test.wantPages)-1
+			if last {
+				require.Empty(t, result.NextPageToken)
+			} else {
+				require.Equal(t, c.token, result.NextPageToken)
+			}
+		}
+
+		// The cursor is released after the last page.
+		require.Nil(t, s.queryResultCursors.get(c.token))
+		require.EqualValues(t, 1, driver.closed.Load())
+		require.NoError(t, mock.ExpectationsWereMet())
+		_, err := s.fetchQueryResultPage(ctx, c, test.pageSize)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	}
+}
+
+func TestQueryResultCursorIdleRelease(t *testing.T) {
+	s := &SQLService{licenseService: noMaskingLicenseService{}, queryResultCursors: newQueryResultCursors()}
+	c, driver, mock := newMockQueryResultCursor(t, 1, 5, -1)
+	require.NoError(t, s.queryResultCursors.add(c))
+	result, err := s.fetchQueryResultPage(context.Background(), c, 2)
+	require.NoError(t, err)
+	require.Equal(t, c.token, result.NextPageToken)
+
+	// The idle timer releases the cursor without fetching.
+	c.timer.Reset(time.Millisecond)
+	require.Eventually(t, func() bool {
+		return s.queryResultCursors.get(c.token) == nil && driver.closed.Load() == 1
+	}, time.Second, time.Millisecond)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// The release is idempotent.
+	s.queryResultCursors.release(c)
+	require.EqualValues(t, 1, driver.closed.Load())
+}
+
+func TestQueryResultCursorsLimitPerUser(t *testing.T) {
+	m := newQueryResultCursors()
+	for i := 0; i < maxQueryResultsPerUser; i++ {
+		c := &queryResultCursor{token: string(rune('a' + i)), userID: 1}
+		require.NoError(t, m.add(c))
+		t.Cleanup(func() { c.timer.Stop() })
+	}
+	err := m.add(&queryResultCursor{token: "full", userID: 1})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The other users are not limited.
+	c := &queryResultCursor{token: "other", userID: 2}
+	require.NoError(t, m.add(c))
+	c.timer.Stop()
+}
+
+func TestCloseQueryResultCursor(t *testing.T) {
+	s := &SQLService{licenseService: noMaskingLicenseService{}, queryResultCursors: newQueryResultCursors()}
+	c, driver, mock := newMockQueryResultCursor(t, 1, 5, -1)
+	require.NoError(t, s.queryResultCursors.add(c))
+
+	// Closing the query result rolls back the transaction and closes the driver before all the rows are fetched.
+	s.queryResultCursors.release(c)
+	require.Nil(t, s.queryResultCursors.get(c.token))
+	require.EqualValues(t, 1, driver.closed.Load())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,12 +2,16 @@ package v1
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"log/slog"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/masker"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/store"
@@ -28,36 +32,29 @@ func NewQueryResultMasker(store *store.Store) *QueryResultMasker {
 
 // MaskResults masks the result in-place based on the dynamic masking policy, query-span, instance and action.
 func (s *QueryResultMasker) MaskResults(ctx context.Context, spans []*base.QuerySpan, results []*v1pb.QueryResult, instance *store.InstanceMessage, action storepb.MaskingExceptionPolicy_MaskingException_Action) error {
-	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find classification setting")
-	}
-
-	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
+	currentPrincipal, err := s.getCurrentPrincipal(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find masking rule policy")
+		return err
 	}
+	return s.maskResults(ctx, spans, results, instance, action, currentPrincipal)
+}
 
-	algorithmSetting, err := s.store.GetMaskingAlgorithmSetting(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find masking algorithm setting")
-	}
+// MaskResultsWithoutExceptions masks the results without applying the masking exceptions of the current principal,
+// for the results viewed by the others.
+func (s *QueryResultMasker) MaskResultsWithoutExceptions(ctx context.Context, spans []*base.QuerySpan, results []*v1pb.QueryResult, instance *store.InstanceMessage) error {
+	return s.maskResults(ctx, spans, results, instance, storepb.MaskingExceptionPolicy_MaskingException_QUERY, nil)
+}
 
-	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+func (s *QueryResultMasker) maskResults(ctx context.Context, spans []*base.QuerySpan, results []*v1pb.QueryResult, instance *store.InstanceMessage, action storepb.MaskingExceptionPolicy_MaskingException_Action, currentPrincipal *store.UserMessage) error {
+	m, err := s.newMaskingLevelEvaluator(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find semantic types setting")
+		return err
 	}
 
-	m := newEmptyMaskingLevelEvaluator().
-		withMaskingRulePolicy(maskingRulePolicy).
-		withDataClassificationSetting(classificationSetting).
-		withMaskingAlgorithmSetting(algorithmSetting).
-		withSemanticTypeSetting(semanticTypesSetting)
-
 	// We expect the len(spans) == len(results), but to avoid NPE, we use the min(len(spans), len(results)) here.
 	loopBoundary := min(len(spans), len(results))
 	for i := 0; i < loopBoundary; i++ {
-		maskers, err := s.getMaskersForQuerySpan(ctx, m, instance, spans[i], action)
+		maskers, err := s.getMaskersForQuerySpan(ctx, m, instance, spans[i], action, currentPrincipal)
 		if err != nil {
 			return errors.Wrapf(err, "failed to get maskers for query span")
 		}
@@ -67,13 +64,7 @@ func (s *QueryResultMasker) MaskResults(ctx context.Context, spans []*base.Query
 	return nil
 }
 
-// getMaskersForQuerySpan returns the maskers for the query span.
-func (s *QueryResultMasker) getMaskersForQuerySpan(ctx context.Context, m *maskingLevelEvaluator, instance *store.InstanceMessage, span *base.QuerySpan, action storepb.MaskingExceptionPolicy_MaskingException_Action) ([]masker.Masker, error) {
-	if span == nil {
-		return nil, nil
-	}
-	maskers := make([]masker.Masker, 0, len(span.Results))
-
+func (s *QueryResultMasker) getCurrentPrincipal(ctx context.Context) (*store.UserMessage, error) {
 	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "principal ID not found")
@@ -85,6 +76,76 @@ func (s *QueryResultMasker) getMaskersForQuerySpan(ctx context.Context, m *maski
 	if currentPrincipal == nil {
 		return nil, status.Errorf(codes.Internal, "current principal not found")
 	}
+	return currentPrincipal, nil
+}
+
+func (s *QueryResultMasker) newMaskingLevelEvaluator(ctx context.Context) (*maskingLevelEvaluator, error) {
+	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find classification setting")
+	}
+
+	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking rule policy")
+	}
+
+	algorithmSetting, err := s.store.GetMaskingAlgorithmSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking algorithm setting")
+	}
+
+	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find semantic types setting")
+	}
+
+	maskingKey, err := s.store.GetMaskingKey(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking key")
+	}
+
+	return newEmptyMaskingLevelEvaluator().
+		withMaskingRulePolicy(maskingRulePolicy).
+		withDataClassificationSetting(classificationSetting).
+		withMaskingAlgorithmSetting(algorithmSetting).
+		withSemanticTypeSetting(semanticTypesSetting).
+		withMaskingKey(maskingKey), nil
+}
+
+// UnmaskValues reverses the values masked by the format-preserving masking algorithm of the column.
+func (s *QueryResultMasker) UnmaskValues(ctx context.Context, instance *store.InstanceMessage, column base.ColumnResource, values []string) ([]string, error) {
+	m, err := s.newMaskingLevelEvaluator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The masking exceptions are ignored, because the values are masked by the algorithm of the column.
+	maskingAlgorithm, maskingLevel, err := s.getMaskingAlgorithmForColumnResource(ctx, m, instance, column, map[string]*storepb.MaskingExceptionPolicy{}, storepb.MaskingExceptionPolicy_MaskingException_ACTION_UNSPECIFIED, nil)
+	if err != nil {
+		return nil, err
+	}
+	fpeMasker, ok := getMaskerByMaskingAlgorithmAndLevel(maskingAlgorithm, maskingLevel, m.maskingKey).(*masker.FormatPreservingMasker)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "column %q is not masked by a format-preserving masking algorithm", column.String())
+	}
+	var unmaskedValues []string
+	for _, value := range values {
+		unmaskedValue, err := fpeMasker.Unmask(value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to unmask value %q: %v", value, err)
+		}
+		unmaskedValues = append(unmaskedValues, unmaskedValue)
+	}
+	return unmaskedValues, nil
+}
+
+// getMaskersForQuerySpan returns the maskers for the query span.
+// The masking exceptions are not applied if the current principal is nil.
+func (s *QueryResultMasker) getMaskersForQuerySpan(ctx context.Context, m *maskingLevelEvaluator, instance *store.InstanceMessage, span *base.QuerySpan, action storepb.MaskingExceptionPolicy_MaskingException_Action, currentPrincipal *store.UserMessage) ([]masker.Masker, error) {
+	if span == nil {
+		return nil, nil
+	}
+	maskers := make([]masker.Masker, 0, len(span.Results))
 
 	// Multiple databases may belong to the same project, to reduce the protojson unmarshal cost,
 	// we store the projectResourceID - maskingExceptionPolicy in a map.
@@ -135,34 +196,52 @@ func (s *QueryResultMasker) getMaskerForColumnResource(
 	action storepb.MaskingExceptionPolicy_MaskingException_Action,
 	currentPrincipal *store.UserMessage,
 ) (masker.Masker, error) {
+	maskingAlgorithm, maskingLevel, err := s.getMaskingAlgorithmForColumnResource(ctx, m, instance, sourceColumn, maskingExceptionPolicyMap, action, currentPrincipal)
+	if err != nil {
+		return nil, err
+	}
+	return getMaskerByMaskingAlgorithmAndLevel(maskingAlgorithm, maskingLevel, m.maskingKey), nil
+}
+
+// getMaskingAlgorithmForColumnResource returns the masking algorithm and level of the column for the current principal.
+// The masking exceptions are not applied if the current principal is nil.
+func (s *QueryResultMasker) getMaskingAlgorithmForColumnResource(
+	ctx context.Context,
+	m *maskingLevelEvaluator,
+	instance *store.InstanceMessage,
+	sourceColumn base.ColumnResource,
+	maskingExceptionPolicyMap map[string]*storepb.MaskingExceptionPolicy,
+	action storepb.MaskingExceptionPolicy_MaskingException_Action,
+	currentPrincipal *store.UserMessage,
+) (*storepb.MaskingAlgorithmSetting_Algorithm, storepb.MaskingLevel, error) {
 	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
 		InstanceID:   &instance.ResourceID,
 		DatabaseName: &sourceColumn.Database,
 	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to find database: %q", sourceColumn.Database)
+		return nil, storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, errors.Wrapf(err, "failed to find database: %q", sourceColumn.Database)
 	}
 	if database == nil {
-		return masker.NewNoneMasker(), nil
+		return nil, storepb.MaskingLevel_NONE, nil
 	}
 
 	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
 		ResourceID: &database.ProjectID,
 	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to find project: %q", database.ProjectID)
+		return nil, storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, errors.Wrapf(err, "failed to find project: %q", database.ProjectID)
 	}
 	if project == nil {
-		return masker.NewNoneMasker(), nil
+		return nil, storepb.MaskingLevel_NONE, nil
 	}
 
 	meta, config, err := s.getColumnForColumnResource(ctx, instance.ResourceID, &sourceColumn)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get database metadata for column resource: %q", sourceColumn.String())
+		return nil, storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, errors.Wrapf(err, "failed to get database metadata for column resource: %q", sourceColumn.String())
 	}
 	// Span and metadata are not the same in real time, so we fall back to none masker.
 	if meta == nil {
-		return masker.NewNoneMasker(), nil
+		return nil, storepb.MaskingLevel_NONE, nil
 	}
 
 	semanticTypeID := ""
@@ -172,7 +251,7 @@ func (s *QueryResultMasker) getMaskerForColumnResource(
 
 	maskingPolicy, err := s.store.GetMaskingPolicyByDatabaseUID(ctx, database.UID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get masking policy for database: %q", database.DatabaseName)
+		return nil, storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, errors.Wrapf(err, "failed to get masking policy for database: %q", database.DatabaseName)
 	}
 	maskingPolicyMap := make(map[maskingPolicyKey]*storepb.MaskData)
 	if maskingPolicy != nil {
@@ -191,7 +270,7 @@ func (s *QueryResultMasker) getMaskerForColumnResource(
 	if _, ok := maskingExceptionPolicyMap[database.ProjectID]; !ok {
 		policy, err := s.store.GetMaskingExceptionPolicyByProjectUID(ctx, project.UID)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to find masking exception policy for project %q", project.ResourceID)
+			return nil, storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, errors.Wrapf(err, "failed to find masking exception policy for project %q", project.ResourceID)
 		}
 		// It is safe if policy is nil.
 		maskingExceptionPolicyMap[database.ProjectID] = policy
@@ -200,7 +279,7 @@ func (s *QueryResultMasker) getMaskerForColumnResource(
 
 	// Build the filtered maskingExceptionPolicy for current principal.
 	var maskingExceptionContainsCurrentPrincipal []*storepb.MaskingExceptionPolicy_MaskingException
-	if maskingExceptionPolicy != nil {
+	if maskingExceptionPolicy != nil && currentPrincipal != nil {
 		for _, maskingException := range maskingExceptionPolicy.MaskingExceptions {
 			if maskingException.Action != action {
 				continue
@@ -218,9 +297,9 @@ func (s *QueryResultMasker) getMaskerForColumnResource(
 
 	maskingAlgorithm, maskingLevel, err := m.evaluateMaskingAlgorithmOfColumn(database, sourceColumn.Schema, sourceColumn.Table, sourceColumn.Column, semanticTypeID, config.ClassificationId, project.DataClassificationConfigID, maskingPolicyMap, maskingExceptionContainsCurrentPrincipal)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to evaluate masking level of database %q, schema %q, table %q, column %q", sourceColumn.Database, sourceColumn.Schema, sourceColumn.Table, sourceColumn.Column)
+		return nil, storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, errors.Wrapf(err, "failed to evaluate masking level of database %q, schema %q, table %q, column %q", sourceColumn.Database, sourceColumn.Schema, sourceColumn.Table, sourceColumn.Column)
 	}
-	return getMaskerByMaskingAlgorithmAndLevel(maskingAlgorithm, maskingLevel), nil
+	return maskingAlgorithm, maskingLevel, nil
 }
 
 func (s *QueryResultMasker) getColumnForColumnResource(ctx context.Context, instanceID string, sourceColumn *base.ColumnResource) (*storepb.ColumnMetadata, *storepb.ColumnConfig, error) {
@@ -275,7 +354,7 @@ func (s *QueryResultMasker) getColumnForColumnResource(ctx context.Context, inst
 	return columnMetadata, columnConfig, nil
 }
 
-func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.MaskingAlgorithmSetting_Algorithm, level storepb.MaskingLevel) masker.Masker {
+func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.MaskingAlgorithmSetting_Algorithm, level storepb.MaskingLevel, maskingKey string) masker.Masker {
 	if algorithm == nil {
 		switch level {
 		case storepb.MaskingLevel_FULL:
@@ -296,10 +375,28 @@ func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.MaskingAlgorithmSett
 		return masker.NewMD5Masker(m.Md5Mask.Salt)
 	case *storepb.MaskingAlgorithmSetting_Algorithm_InnerOuterMask_:
 		return masker.NewInnerOuterMasker(m.InnerOuterMask.Type, m.InnerOuterMask.PrefixLen, m.InnerOuterMask.SuffixLen, m.InnerOuterMask.Substitution)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_:
+		fpeMasker, err := masker.NewFormatPreservingMasker(m.FormatPreservingMask.Format, m.FormatPreservingMask.Mode, getMaskingAlgorithmKey(maskingKey, algorithm.Id), m.FormatPreservingMask.Tweak)
+		if err != nil {
+			slog.Error("failed to create format-preserving masker", slog.String("algorithm", algorithm.Id), log.BBError(err))
+			return masker.NewDefaultFullMasker()
+		}
+		return fpeMasker
+	case *storepb.MaskingAlgorithmSetting_Algorithm_TokenizationMask_:
+		return masker.NewTokenizationMasker(getMaskingAlgorithmKey(maskingKey, algorithm.Id), m.TokenizationMask.Prefix)
 	}
 	return masker.NewNoneMasker()
 }
 
+// getMaskingAlgorithmKey derives the 256-bit key of the masking algorithm from the workspace masking key,
+// so that each algorithm has its own key.
+func getMaskingAlgorithmKey(maskingKey, algorithmID string) []byte {
+	h := hmac.New(sha256.New, []byte(maskingKey))
+	// Writing to the hash never returns an error.
+	_, _ = h.Write([]byte(algorithmID))
+	return h.Sum(nil)
+}
+
 func convertRangeMaskSlices(slices []*storepb.MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) []*masker.MaskRangeSlice {
 	var result []*masker.MaskRangeSlice
 	for _, slice := range slices {
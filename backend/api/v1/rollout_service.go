@@ -76,6 +76,9 @@ func (s *RolloutService) PreviewRollout(ctx context.Context, request *v1pb.Previ
 	if err := validateSteps(request.Plan.Steps); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to validate plan steps, error: %v", err)
 	}
+	if err := validatePlanDependencies(ctx, s.store, s.iamManager, 0, request.Plan.DependsOnPlans); err != nil {
+		return nil, err
+	}
 	steps := convertPlanSteps(request.Plan.Steps)
 
 	rollout, err := GetPipelineCreate(ctx, s.store, s.sheetManager, s.licenseService, s.dbFactory, steps, project)
@@ -90,6 +93,13 @@ func (s *RolloutService) PreviewRollout(ctx context.Context, request *v1pb.Previ
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert to rollout, error: %v", err)
 	}
+	for i, stage := range rollout.Stages {
+		planDependencies, err := convertToStagePlanDependencies(ctx, s.store, request.Plan.DependsOnPlans, stage.EnvironmentID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert plan dependencies, error: %v", err)
+		}
+		rolloutV1.Stages[i].PlanDependencies = planDependencies
+	}
 	return rolloutV1, nil
 }
 
@@ -371,6 +381,13 @@ func (s *RolloutService) BatchRunTasks(ctx context.Context, request *v1pb.BatchR
 			Name:      fmt.Sprintf("%s %d", task.Name, time.Now().Unix()),
 			CreatorID: user.ID,
 		}
+		if request.ResumeFromFailedCommand {
+			payload, err := getResumedTaskRunPayload(ctx, s.store, task, sheetUID)
+			if err != nil {
+				return nil, err
+			}
+			create.Payload = payload
+		}
 		taskRunCreates = append(taskRunCreates, create)
 	}
 	sort.Slice(taskRunCreates, func(i, j int) bool {
@@ -979,6 +996,33 @@ func (s *RolloutService) createPipeline(ctx context.Context, project *store.Proj
 	return pipelineCreated, nil
 }
 
+// getResumedTaskRunPayload returns the payload for the task run resumed from the last failed task run of the task.
+// The resumed task run inherits the committed commands so that they will be skipped.
+func getResumedTaskRunPayload(ctx context.Context, s *store.Store, task *store.TaskMessage, sheetUID *int) (*storepb.TaskRunPayload, error) {
+	taskRuns, err := s.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{TaskUID: &task.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list task runs, error: %v", err)
+	}
+	if len(taskRuns) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "task %q has no task run to resume from", task.Name)
+	}
+	// Task runs are sorted by id in ascending order.
+	lastTaskRun := taskRuns[len(taskRuns)-1]
+	// A canceled batched data update is paused and can be resumed from its last committed batch.
+	paused := lastTaskRun.Status == api.TaskRunCanceled && (len(lastTaskRun.Payload.GetBatchCursor()) > 0 || lastTaskRun.Payload.GetPendingBatch() != nil)
+	if lastTaskRun.Status != api.TaskRunFailed && !paused {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot resume task %q because its last task run is %s", task.Name, lastTaskRun.Status)
+	}
+	if lastTaskRun.SheetUID == nil || sheetUID == nil || *lastTaskRun.SheetUID != *sheetUID {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot resume task %q because its statement has changed since the last task run", task.Name)
+	}
+	return &storepb.TaskRunPayload{
+		CommittedCommandIndexes: lastTaskRun.Payload.GetCommittedCommandIndexes(),
+		BatchCursor:             lastTaskRun.Payload.GetBatchCursor(),
+		PendingBatch:            lastTaskRun.Payload.GetPendingBatch(),
+	}, nil
+}
+
 // canUserRunStageTasks returns if a user can run the tasks in a stage.
 func canUserRunStageTasks(ctx context.Context, s *store.Store, user *store.UserMessage, issue *store.IssueMessage, stageEnvironmentID int) (bool, error) {
 	// For data export issues, only the creator can run tasks.
@@ -16,6 +16,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/state"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -44,10 +45,13 @@ func convertToPlan(ctx context.Context, s *store.Store, plan *store.PlanMessage)
 			VcsType:        v1pb.VCSType(plan.Config.GetVcsSource().GetVcsType()),
 			VcsConnector:   plan.Config.GetVcsSource().GetVcsConnector(),
 			PullRequestUrl: plan.Config.GetVcsSource().GetPullRequestUrl(),
+			CommitId:       plan.Config.GetVcsSource().GetCommitId(),
+			Tag:            plan.Config.GetVcsSource().GetTag(),
 		},
 		CreateTime:              timestamppb.New(time.Unix(plan.CreatedTs, 0)),
 		UpdateTime:              timestamppb.New(time.Unix(plan.UpdatedTs, 0)),
 		PlanCheckRunStatusCount: plan.PlanCheckRunStatusCount,
+		DependsOnPlans:          plan.Config.GetDependsOnPlans(),
 	}
 
 	creator, err := s.GetUserByID(ctx, plan.CreatorUID)
@@ -137,10 +141,23 @@ func convertToPlanSpecChangeDatabaseConfig(config *storepb.PlanConfig_Spec_Chang
 			PreUpdateBackupDetail: &v1pb.Plan_ChangeDatabaseConfig_PreUpdateBackupDetail{
 				Database: c.PreUpdateBackupDetail.GetDatabase(),
 			},
+			BatchDetail:   convertToPlanSpecChangeDatabaseConfigBatchDetail(c.BatchDetail),
+			Transactional: c.Transactional,
 		},
 	}
 }
 
+func convertToPlanSpecChangeDatabaseConfigBatchDetail(d *storepb.PlanConfig_ChangeDatabaseConfig_BatchDetail) *v1pb.Plan_ChangeDatabaseConfig_BatchDetail {
+	if d == nil {
+		return nil
+	}
+	return &v1pb.Plan_ChangeDatabaseConfig_BatchDetail{
+		BatchSize:            d.BatchSize,
+		SleepIntervalMs:      d.SleepIntervalMs,
+		MaxReplicaLagSeconds: d.MaxReplicaLagSeconds,
+	}
+}
+
 func convertToPlanSpecChangeDatabaseConfigType(t storepb.PlanConfig_ChangeDatabaseConfig_Type) v1pb.Plan_ChangeDatabaseConfig_Type {
 	switch t {
 	case storepb.PlanConfig_ChangeDatabaseConfig_TYPE_UNSPECIFIED:
@@ -242,6 +259,14 @@ func convertPlanSpecChangeDatabaseConfig(config *v1pb.Plan_Spec_ChangeDatabaseCo
 			Database: c.GetPreUpdateBackupDetail().GetDatabase(),
 		}
 	}
+	var batchDetail *storepb.PlanConfig_ChangeDatabaseConfig_BatchDetail
+	if c.BatchDetail != nil {
+		batchDetail = &storepb.PlanConfig_ChangeDatabaseConfig_BatchDetail{
+			BatchSize:            c.BatchDetail.BatchSize,
+			SleepIntervalMs:      c.BatchDetail.SleepIntervalMs,
+			MaxReplicaLagSeconds: c.BatchDetail.MaxReplicaLagSeconds,
+		}
+	}
 	return &storepb.PlanConfig_Spec_ChangeDatabaseConfig{
 		ChangeDatabaseConfig: &storepb.PlanConfig_ChangeDatabaseConfig{
 			Target:                c.Target,
@@ -250,6 +275,8 @@ func convertPlanSpecChangeDatabaseConfig(config *v1pb.Plan_Spec_ChangeDatabaseCo
 			SchemaVersion:         c.SchemaVersion,
 			GhostFlags:            c.GhostFlags,
 			PreUpdateBackupDetail: preUpdateBackupDetail,
+			BatchDetail:           batchDetail,
+			Transactional:         c.Transactional,
 		},
 	}
 }
@@ -386,10 +413,11 @@ func convertToPlanCheckRunResult(result *storepb.PlanCheckRunResult_Result) *v1p
 	case *storepb.PlanCheckRunResult_Result_SqlSummaryReport_:
 		resultV1.Report = &v1pb.PlanCheckRun_Result_SqlSummaryReport_{
 			SqlSummaryReport: &v1pb.PlanCheckRun_Result_SqlSummaryReport{
-				Code:             report.SqlSummaryReport.Code,
-				StatementTypes:   report.SqlSummaryReport.StatementTypes,
-				AffectedRows:     report.SqlSummaryReport.AffectedRows,
-				ChangedResources: convertToChangedResources(report.SqlSummaryReport.ChangedResources),
+				Code:              report.SqlSummaryReport.Code,
+				StatementTypes:    report.SqlSummaryReport.StatementTypes,
+				AffectedRows:      report.SqlSummaryReport.AffectedRows,
+				ChangedResources:  convertToChangedResources(report.SqlSummaryReport.ChangedResources),
+				RollbackStatement: report.SqlSummaryReport.RollbackStatement,
 			},
 		}
 	case *storepb.PlanCheckRunResult_Result_SqlReviewReport_:
@@ -534,6 +562,13 @@ func convertToRollout(ctx context.Context, s *store.Store, project *store.Projec
 			Uid:   fmt.Sprintf("%d", stage.ID),
 			Title: stage.Name,
 		}
+		if plan != nil {
+			planDependencies, err := convertToStagePlanDependencies(ctx, s, plan.Config.GetDependsOnPlans(), stage.EnvironmentID)
+			if err != nil {
+				return nil, err
+			}
+			rolloutStage.PlanDependencies = planDependencies
+		}
 		for _, task := range stage.TaskList {
 			rolloutTask, err := convertToTask(ctx, s, project, task)
 			if err != nil {
@@ -558,6 +593,25 @@ func convertToRollout(ctx context.Context, s *store.Store, project *store.Projec
 	return rolloutV1, nil
 }
 
+func convertToStagePlanDependencies(ctx context.Context, s *store.Store, dependsOnPlans []string, environmentID int) ([]*v1pb.Stage_PlanDependency, error) {
+	dependencies, err := utils.GetPlanDependencies(ctx, s, dependsOnPlans, environmentID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get plan dependencies")
+	}
+	var planDependencies []*v1pb.Stage_PlanDependency
+	for _, dependency := range dependencies {
+		planDependency := &v1pb.Stage_PlanDependency{
+			Plan: fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, dependency.Plan.ProjectID, common.PlanPrefix, dependency.Plan.UID),
+			Done: dependency.Done,
+		}
+		if dependency.Stage != nil {
+			planDependency.Stage = fmt.Sprintf("%s%s/%s%d/%s%d", common.ProjectNamePrefix, dependency.Plan.ProjectID, common.RolloutPrefix, dependency.Stage.PipelineID, common.StagePrefix, dependency.Stage.ID)
+		}
+		planDependencies = append(planDependencies, planDependency)
+	}
+	return planDependencies, nil
+}
+
 func convertToTask(ctx context.Context, s *store.Store, project *store.ProjectMessage, task *store.TaskMessage) (*v1pb.Task, error) {
 	switch task.Type {
 	case api.TaskDatabaseCreate:
@@ -401,6 +401,30 @@ func getTaskCreatesFromExportDataConfig(ctx context.Context, s *store.Store, spe
 	return []*store.TaskMessage{taskCreate}, nil, nil
 }
 
+// validateTransactionalChangeDatabaseConfig validates that the changes of the config can be prepared in open transactions.
+func validateTransactionalChangeDatabaseConfig(c *storepb.PlanConfig_ChangeDatabaseConfig, engine storepb.Engine) error {
+	if !c.Transactional {
+		return nil
+	}
+	if c.BatchDetail != nil {
+		return errors.Errorf("transactional change cannot be executed in batches")
+	}
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		if c.Type != storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE && c.Type != storepb.PlanConfig_ChangeDatabaseConfig_DATA {
+			return errors.Errorf("transactional change is not supported for change type %v", c.Type)
+		}
+	case storepb.Engine_MYSQL:
+		// DDL statements cause implicit commits in MySQL.
+		if c.Type != storepb.PlanConfig_ChangeDatabaseConfig_DATA {
+			return errors.Errorf("transactional change is only supported for data changes in MySQL")
+		}
+	default:
+		return errors.Errorf("transactional change is not supported for engine %v", engine)
+	}
+	return nil
+}
+
 func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s *store.Store, spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_ChangeDatabaseConfig, _ *store.ProjectMessage, registerEnvironmentID func(string) error) ([]*store.TaskMessage, []store.TaskIndexDAG, error) {
 	instanceID, databaseName, err := common.GetInstanceDatabaseID(c.Target)
 	if err != nil {
@@ -431,6 +455,9 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 	if err := registerEnvironmentID(database.EffectiveEnvironmentID); err != nil {
 		return nil, nil, err
 	}
+	if err := validateTransactionalChangeDatabaseConfig(c, instance.Engine); err != nil {
+		return nil, nil, err
+	}
 
 	switch c.Type {
 	case storepb.PlanConfig_ChangeDatabaseConfig_BASELINE:
@@ -462,6 +489,7 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 			SpecID:        spec.Id,
 			SheetID:       sheetUID,
 			SchemaVersion: getOrDefaultSchemaVersion(c.SchemaVersion),
+			Transactional: c.Transactional,
 		}
 		bytes, err := json.Marshal(payload)
 		if err != nil {
@@ -565,11 +593,24 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 		if c.GetPreUpdateBackupDetail().GetDatabase() != "" {
 			preUpdateBackupDetail.Database = c.GetPreUpdateBackupDetail().GetDatabase()
 		}
+		var batchDetail *api.BatchDetail
+		if d := c.GetBatchDetail(); d != nil {
+			if d.BatchSize <= 0 || d.SleepIntervalMs < 0 || d.MaxReplicaLagSeconds < 0 {
+				return nil, nil, errors.Errorf("invalid batch detail, batch size must be positive and sleep interval and max replica lag must not be negative")
+			}
+			batchDetail = &api.BatchDetail{
+				BatchSize:            int(d.BatchSize),
+				SleepIntervalMs:      int(d.SleepIntervalMs),
+				MaxReplicaLagSeconds: int(d.MaxReplicaLagSeconds),
+			}
+		}
 		payload := api.TaskDatabaseDataUpdatePayload{
 			SpecID:                spec.Id,
 			SheetID:               sheetUID,
 			SchemaVersion:         getOrDefaultSchemaVersion(c.SchemaVersion),
 			PreUpdateBackupDetail: preUpdateBackupDetail,
+			BatchDetail:           batchDetail,
+			Transactional:         c.Transactional,
 		}
 		bytes, err := json.Marshal(payload)
 		if err != nil {
@@ -0,0 +1,446 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// rowFilterTable is the key of a table with row filters.
+type rowFilterTable struct {
+	database string
+	schema   string
+	table    string
+}
+
+// rowFilterEvaluator evaluates the row filters of the tables for the user.
+type rowFilterEvaluator struct {
+	store               *store.Store
+	instance            *store.InstanceMessage
+	ignoreCaseSensitive bool
+
+	// rowFilters are the row filters bound to the user by the project UID.
+	rowFilters map[int][]*storepb.RowFilterPolicy_RowFilter
+	// predicates caches the predicates of the tables.
+	predicates map[rowFilterTable]string
+	// getSearchPath gets the schemas of the search path to resolve the unqualified tables of PostgreSQL.
+	getSearchPath func(ctx context.Context) ([]string, error)
+	searchPath    []string
+	// instanceRowFilter caches whether any table of the instance has row filters for the user.
+	instanceRowFilter *bool
+}
+
+func newRowFilterEvaluator(ctx context.Context, s *store.Store, instance *store.InstanceMessage, user *store.UserMessage) (*rowFilterEvaluator, error) {
+	pType := api.PolicyTypeRowFilter
+	policies, err := s.ListPoliciesV2(ctx, &store.FindPolicyMessage{Type: &pType})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list row filter policies")
+	}
+	e := &rowFilterEvaluator{
+		store:               s,
+		instance:            instance,
+		ignoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+		rowFilters:          map[int][]*storepb.RowFilterPolicy_RowFilter{},
+		predicates:          map[rowFilterTable]string{},
+	}
+	members := map[string]bool{}
+	for _, policy := range policies {
+		p := &storepb.RowFilterPolicy{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), p); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal row filter policy")
+		}
+		for _, rowFilter := range p.RowFilters {
+			isMember, ok := members[rowFilter.Member]
+			if !ok {
+				for _, u := range utils.GetUsersByMember(ctx, s, rowFilter.Member) {
+					if u.ID == user.ID {
+						isMember = true
+						break
+					}
+				}
+				members[rowFilter.Member] = isMember
+			}
+			if isMember {
+				e.rowFilters[policy.ResourceUID] = append(e.rowFilters[policy.ResourceUID], rowFilter)
+			}
+		}
+	}
+	return e, nil
+}
+
+// getRowFilter gets the predicate of the table combining the row filters applied, empty if the table has no row filter.
+func (e *rowFilterEvaluator) getRowFilter(ctx context.Context, databaseName, schemaName, tableName string) (rowFilterTable, string, error) {
+	database, err := e.findDatabase(ctx, databaseName)
+	if err != nil {
+		return rowFilterTable{}, "", err
+	}
+	if database == nil {
+		return rowFilterTable{database: databaseName, schema: schemaName, table: tableName}, "", nil
+	}
+	if schemaName == "" && e.instance.Engine == storepb.Engine_POSTGRES {
+		if schemaName, err = e.resolveSchemaName(ctx, database, tableName); err != nil {
+			return rowFilterTable{}, "", err
+		}
+	}
+	if e.ignoreCaseSensitive {
+		if tableName, err = e.findTableName(ctx, database, schemaName, tableName); err != nil {
+			return rowFilterTable{}, "", err
+		}
+	}
+	key := rowFilterTable{database: database.DatabaseName, schema: schemaName, table: tableName}
+	if predicate, ok := e.predicates[key]; ok {
+		return key, predicate, nil
+	}
+
+	project, err := e.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return rowFilterTable{}, "", errors.Wrapf(err, "failed to find project %q", database.ProjectID)
+	}
+	var predicates []string
+	if project != nil {
+		attributes := map[string]any{
+			"resource": map[string]any{
+				"instance_id":   e.instance.ResourceID,
+				"database_name": key.database,
+				"schema_name":   key.schema,
+				"table_name":    key.table,
+			},
+			"request": map[string]any{
+				"time": time.Now(),
+			},
+		}
+		for _, rowFilter := range e.rowFilters[project.UID] {
+			hit, err := evaluateRowFilterPolicyCondition(rowFilter.Condition.GetExpression(), attributes)
+			if err != nil {
+				return rowFilterTable{}, "", errors.Wrapf(err, "failed to evaluate row filter policy condition")
+			}
+			if hit {
+				predicates = append(predicates, fmt.Sprintf("(%s)", rowFilter.Predicate))
+			}
+		}
+	}
+	predicate := strings.Join(predicates, " AND ")
+	e.predicates[key] = predicate
+	return key, predicate, nil
+}
+
+// resolveSchemaName resolves the schema of the unqualified table by the search path, the same as PostgreSQL does.
+// The schema is public if the table is not found in the schemas of the search path.
+func (e *rowFilterEvaluator) resolveSchemaName(ctx context.Context, database *store.DatabaseMessage, tableName string) (string, error) {
+	if e.searchPath == nil {
+		if e.getSearchPath == nil {
+			return "public", nil
+		}
+		searchPath, err := e.getSearchPath(ctx)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get the search path of database %q", database.DatabaseName)
+		}
+		e.searchPath = searchPath
+	}
+	dbSchema, err := e.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get schema of database %q", database.DatabaseName)
+	}
+	if dbSchema == nil {
+		return "public", nil
+	}
+	for _, schemaName := range e.searchPath {
+		schema := dbSchema.GetDatabaseMetadata().GetSchema(schemaName)
+		if schema == nil {
+			continue
+		}
+		if schema.GetTable(tableName) != nil || schema.GetView(tableName) != nil || schema.GetMaterializedView(tableName) != nil || schema.GetExternalTable(tableName) != nil {
+			return schemaName, nil
+		}
+	}
+	return "public", nil
+}
+
+// hasRowFilter returns true if any table of the database has row filters for the user.
+// The tables are unknown if the database metadata is not synced, and all the row filters of the project are considered applied.
+func (e *rowFilterEvaluator) hasRowFilter(ctx context.Context, database *store.DatabaseMessage) (bool, error) {
+	project, err := e.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to find project %q", database.ProjectID)
+	}
+	if project == nil || len(e.rowFilters[project.UID]) == 0 {
+		return false, nil
+	}
+	dbSchema, err := e.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get schema of database %q", database.DatabaseName)
+	}
+	if dbSchema == nil {
+		return true, nil
+	}
+	for _, schema := range dbSchema.GetMetadata().GetSchemas() {
+		for _, table := range schema.GetTables() {
+			_, predicate, err := e.getRowFilter(ctx, database.DatabaseName, schema.GetName(), table.GetName())
+			if err != nil {
+				return false, err
+			}
+			if predicate != "" {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// hasInstanceRowFilter returns true if any table of the instance has row filters for the user.
+func (e *rowFilterEvaluator) hasInstanceRowFilter(ctx context.Context) (bool, error) {
+	if e.instanceRowFilter == nil {
+		databases, err := e.store.ListDatabases(ctx, &store.FindDatabaseMessage{InstanceID: &e.instance.ResourceID})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to list databases of instance %q", e.instance.ResourceID)
+		}
+		hasRowFilter := false
+		for _, database := range databases {
+			if hasRowFilter, err = e.hasRowFilter(ctx, database); err != nil {
+				return false, err
+			}
+			if hasRowFilter {
+				break
+			}
+		}
+		e.instanceRowFilter = &hasRowFilter
+	}
+	return *e.instanceRowFilter, nil
+}
+
+// checkViewOrFunction returns an error if the view or the function of the database is read while any table of the instance has row filters for the user,
+// because the tables read by the views and the functions cannot be rewritten, e.g. SELECT COUNT(*) FROM a view has no source column.
+// The views and the functions of the databases never synced are unknown.
+func (e *rowFilterEvaluator) checkViewOrFunction(ctx context.Context, databaseName, schemaName, name string, function bool) error {
+	database, err := e.findDatabase(ctx, databaseName)
+	if err != nil {
+		return err
+	}
+	if database == nil {
+		return nil
+	}
+	dbSchema, err := e.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get schema of database %q", database.DatabaseName)
+	}
+	if dbSchema == nil || !hasViewOrFunction(dbSchema.GetMetadata(), schemaName, name, function) {
+		return nil
+	}
+	hasRowFilter, err := e.hasInstanceRowFilter(ctx)
+	if err != nil {
+		return err
+	}
+	if hasRowFilter {
+		if function {
+			return errors.Errorf("function %q may read the tables with row filters", name)
+		}
+		return errors.Errorf("view %q may read the tables with row filters", name)
+	}
+	return nil
+}
+
+// hasViewOrFunction returns true if the schema has the view, the materialized view, or the function if function is true.
+// The names are matched case-insensitively, and all the schemas are matched if the schema is empty,
+// so that no view or function is missed by resolving the names.
+func hasViewOrFunction(metadata *storepb.DatabaseSchemaMetadata, schemaName, name string, function bool) bool {
+	for _, schema := range metadata.GetSchemas() {
+		if schemaName != "" && !strings.EqualFold(schema.GetName(), schemaName) {
+			continue
+		}
+		var names []string
+		if function {
+			for _, f := range schema.GetFunctions() {
+				names = append(names, f.GetName())
+			}
+		} else {
+			for _, view := range schema.GetViews() {
+				names = append(names, view.GetName())
+			}
+			for _, view := range schema.GetMaterializedViews() {
+				names = append(names, view.GetName())
+			}
+		}
+		for _, n := range names {
+			if strings.EqualFold(n, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (e *rowFilterEvaluator) findDatabase(ctx context.Context, databaseName string) (*store.DatabaseMessage, error) {
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:   &e.instance.ResourceID,
+		DatabaseName: &databaseName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find database %q", databaseName)
+	}
+	if database != nil || !e.ignoreCaseSensitive {
+		return database, nil
+	}
+	databases, err := e.store.ListDatabases(ctx, &store.FindDatabaseMessage{InstanceID: &e.instance.ResourceID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list databases of instance %q", e.instance.ResourceID)
+	}
+	for _, database := range databases {
+		if strings.EqualFold(database.DatabaseName, databaseName) {
+			return database, nil
+		}
+	}
+	return nil, nil
+}
+
+// findTableName finds the table name in the database metadata which is case insensitive to the table name.
+func (e *rowFilterEvaluator) findTableName(ctx context.Context, database *store.DatabaseMessage, schemaName, tableName string) (string, error) {
+	dbSchema, err := e.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get schema of database %q", database.DatabaseName)
+	}
+	if dbSchema == nil {
+		return tableName, nil
+	}
+	schema := dbSchema.GetDatabaseMetadata().GetSchema(schemaName)
+	if schema == nil {
+		return tableName, nil
+	}
+	for _, name := range schema.ListTableNames() {
+		if strings.EqualFold(name, tableName) {
+			return name, nil
+		}
+	}
+	return tableName, nil
+}
+
+// rewriteRowFilter rewrites the statement to read only the rows visible to the user by the row filter policies.
+// The statement is rejected if it cannot be safely rewritten, or if the engine has no rewriter and any table of the database has row filters.
+// It is also rejected if it reads a view or calls a function of the databases while any table of the instance has row filters.
+func rewriteRowFilter(ctx context.Context, s *store.Store, dbFactory *dbfactory.DBFactory, instance *store.InstanceMessage, database *store.DatabaseMessage, user *store.UserMessage, dataSourceID string, statement string, spans []*base.QuerySpan) (string, error) {
+	e, err := newRowFilterEvaluator(ctx, s, instance, user)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, err.Error())
+	}
+	if len(e.rowFilters) == 0 {
+		return statement, nil
+	}
+	if !base.IsRowFilterSupported(instance.Engine) {
+		// The tables read by the query cannot be told without the rewriter, e.g. SELECT COUNT(*) has no source column.
+		hasRowFilter, err := e.hasRowFilter(ctx, database)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, err.Error())
+		}
+		if hasRowFilter {
+			return "", status.Errorf(codes.PermissionDenied, "the query cannot be safely rewritten for row filters on %s", instance.Engine.String())
+		}
+		return statement, nil
+	}
+	e.getSearchPath = func(ctx context.Context) ([]string, error) {
+		return getSearchPath(ctx, dbFactory, instance, database, dataSourceID)
+	}
+
+	rewritten := map[rowFilterTable]bool{}
+	// The schema of the unqualified tables is resolved by the evaluator.
+	statement, err = base.RewriteRowFilter(instance.Engine, statement, database.DatabaseName, "", func(databaseName, schemaName, tableName string) (string, error) {
+		key, predicate, err := e.getRowFilter(ctx, databaseName, schemaName, tableName)
+		if err != nil {
+			return "", err
+		}
+		if predicate != "" {
+			rewritten[key] = true
+			return predicate, nil
+		}
+		return "", e.checkViewOrFunction(ctx, databaseName, schemaName, tableName, false /* function */)
+	}, func(databaseName, schemaName, functionName string) error {
+		return e.checkViewOrFunction(ctx, databaseName, schemaName, functionName, true /* function */)
+	})
+	if err != nil {
+		return "", status.Errorf(codes.PermissionDenied, "the query cannot be safely rewritten for row filters: %v", err)
+	}
+
+	// The tables in the query span but not rewritten are accessed indirectly, for example, by views with source columns.
+	for _, span := range spans {
+		for column := range span.SourceColumns {
+			key, predicate, err := e.getRowFilter(ctx, column.Database, column.Schema, column.Table)
+			if err != nil {
+				return "", status.Errorf(codes.Internal, err.Error())
+			}
+			if predicate != "" && !rewritten[key] {
+				table := column
+				table.Column = ""
+				return "", status.Errorf(codes.PermissionDenied, "the query cannot be safely rewritten for row filters of table %q", table.String())
+			}
+		}
+	}
+	return statement, nil
+}
+
+// getSearchPath gets the existing schemas of the search path of the PostgreSQL database in order.
+func getSearchPath(ctx context.Context, dbFactory *dbfactory.DBFactory, instance *store.InstanceMessage, database *store.DatabaseMessage, dataSourceID string) ([]string, error) {
+	driver, err := dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, dataSourceID)
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		return nil, errors.Errorf("search path is not supported for %s", instance.Engine.String())
+	}
+	var searchPath string
+	if err := sqlDB.QueryRowContext(ctx, "SELECT array_to_string(current_schemas(false), ',')").Scan(&searchPath); err != nil {
+		return nil, err
+	}
+	if searchPath == "" {
+		return []string{}, nil
+	}
+	return strings.Split(searchPath, ","), nil
+}
+
+func evaluateRowFilterPolicyCondition(expression string, attributes map[string]any) (bool, error) {
+	if expression == "" {
+		return true, nil
+	}
+	rowFilterPolicyEnv, err := cel.NewEnv(
+		cel.Variable("resource", cel.MapType(cel.StringType, cel.AnyType)),
+		cel.Variable("request", cel.MapType(cel.StringType, cel.AnyType)),
+	)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create CEL environment for row filter policy")
+	}
+	ast, issues := rowFilterPolicyEnv.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return false, errors.Wrapf(issues.Err(), "failed to get the ast of CEL program for row filter policy")
+	}
+	prg, err := rowFilterPolicyEnv.Program(ast)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create CEL program for row filter policy")
+	}
+	out, _, err := prg.Eval(attributes)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to eval CEL program for row filter policy")
+	}
+	val, err := out.ConvertToNative(reflect.TypeOf(false))
+	if err != nil {
+		return false, errors.Wrap(err, "expect bool result for row filter policy")
+	}
+	boolVar, ok := val.(bool)
+	if !ok {
+		return false, errors.New("expect bool result for row filter policy")
+	}
+	return boolVar, nil
+}
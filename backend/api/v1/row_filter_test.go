@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestHasViewOrFunction(t *testing.T) {
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name:              "public",
+				Tables:            []*storepb.TableMetadata{{Name: "customers"}},
+				Views:             []*storepb.ViewMetadata{{Name: "customer_view"}},
+				MaterializedViews: []*storepb.MaterializedViewMetadata{{Name: "customer_stats"}},
+				Functions:         []*storepb.FunctionMetadata{{Name: "count_customers"}},
+			},
+			{
+				Name: "other",
+			},
+		},
+	}
+	tests := []struct {
+		schema   string
+		name     string
+		function bool
+		want     bool
+	}{
+		// SELECT COUNT(*) FROM customer_view has no source column, so the view is found by the name.
+		{schema: "public", name: "customer_view", want: true},
+		{schema: "", name: "customer_view", want: true},
+		{schema: "public", name: "CUSTOMER_STATS", want: true},
+		{schema: "other", name: "customer_view", want: false},
+		{schema: "public", name: "customers", want: false},
+		{schema: "public", name: "count_customers", function: true, want: true},
+		{schema: "", name: "Count_Customers", function: true, want: true},
+		{schema: "", name: "count", function: true, want: false},
+		{schema: "public", name: "count_customers", want: false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, hasViewOrFunction(metadata, test.schema, test.name, test.function), "%s.%s", test.schema, test.name)
+	}
+}
@@ -246,6 +246,11 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 				oldSetting.EnforceIdentityDomain = payload.EnforceIdentityDomain
 			case "value.workspace_profile_setting_value.database_change_mode":
 				oldSetting.DatabaseChangeMode = payload.DatabaseChangeMode
+			case "value.workspace_profile_setting_value.maximum_export_size":
+				if payload.MaximumExportSize < 0 {
+					return nil, status.Errorf(codes.InvalidArgument, "maximum export size must not be negative")
+				}
+				oldSetting.MaximumExportSize = payload.MaximumExportSize
 			default:
 				return nil, status.Errorf(codes.InvalidArgument, "invalid update mask path %v", path)
 			}
@@ -1274,6 +1279,10 @@ func validateMaskingAlgorithm(algorithm *v1pb.MaskingAlgorithmSetting_Algorithm)
 			if err := checkSubstitution(m.InnerOuterMask.Substitution); err != nil {
 				return err
 			}
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_:
+			if m.FormatPreservingMask.Format == v1pb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_FORMAT_UNSPECIFIED {
+				return status.Errorf(codes.InvalidArgument, "the format for format-preserving masks is required")
+			}
 		default:
 			return status.Errorf(codes.InvalidArgument, "mismatch masking algorithm category and mask type: %T, %s", algorithm.Mask, algorithm.Category)
 		}
@@ -1281,8 +1290,12 @@ func validateMaskingAlgorithm(algorithm *v1pb.MaskingAlgorithmSetting_Algorithm)
 		if algorithm.Mask == nil {
 			return nil
 		}
-		switch algorithm.Mask.(type) {
+		switch m := algorithm.Mask.(type) {
 		case *v1pb.MaskingAlgorithmSetting_Algorithm_Md5Mask:
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_TokenizationMask_:
+			if len(m.TokenizationMask.Prefix) > 16 {
+				return status.Errorf(codes.InvalidArgument, "the token prefix should less than 16 bytes")
+			}
 		default:
 			return status.Errorf(codes.InvalidArgument, "mismatch masking algorithm category and mask type: %T, %s", algorithm.Mask, algorithm.Category)
 		}
@@ -26,6 +26,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	"github.com/bytebase/bytebase/backend/component/sheet"
+	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
@@ -35,6 +36,7 @@ import (
 	mapperparser "github.com/bytebase/bytebase/backend/plugin/parser/mybatis/mapper"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
 	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/runner/cleaner"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/store/model"
@@ -58,6 +60,11 @@ type SQLService struct {
 	licenseService enterprise.LicenseService
 	profile        *config.Profile
 	iamManager     *iam.Manager
+	stateCfg       *state.State
+
+	queryResultCursors *queryResultCursors
+	queryResultCache   *queryResultCache
+	editorSessions     *editorSessions
 }
 
 // NewSQLService creates a SQLService.
@@ -69,6 +76,7 @@ func NewSQLService(
 	licenseService enterprise.LicenseService,
 	profile *config.Profile,
 	iamManager *iam.Manager,
+	stateCfg *state.State,
 ) *SQLService {
 	return &SQLService{
 		store:          store,
@@ -78,6 +86,11 @@ func NewSQLService(
 		licenseService: licenseService,
 		profile:        profile,
 		iamManager:     iamManager,
+		stateCfg:       stateCfg,
+
+		queryResultCursors: newQueryResultCursors(),
+		queryResultCache:   newQueryResultCache(),
+		editorSessions:     newEditorSessions(),
 	}
 }
 
@@ -129,7 +142,7 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 		result, durationNs, queryErr := s.doAdminExecute(ctx, driver, conn, request)
 		sanitizeResults(result)
 
-		if err := s.postQuery(ctx, database, request.Statement, user.ID, durationNs, queryErr); err != nil {
+		if err := s.postQuery(ctx, instance, database, request.Statement, user.ID, durationNs, queryErr, nil /* spans */, nil /* costGuard */); err != nil {
 			slog.Error("failed to post admin execute activity", log.BBError(err))
 		}
 
@@ -295,7 +308,7 @@ func (s *SQLService) doExecute(ctx context.Context, instance *store.InstanceMess
 func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*v1pb.ExportResponse, error) {
 	// Prehandle export from issue.
 	if strings.HasPrefix(request.Name, common.ProjectNamePrefix) {
-		return s.doExportFromIssue(ctx, request.Name)
+		return s.doExportFromIssue(ctx, request)
 	}
 	// Prepare related message.
 	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Name, request.ConnectionDatabase)
@@ -335,6 +348,9 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 		if err := s.accessCheck(ctx, instance, user, spans, request.Limit, false /* isAdmin */, true /* isExport */); err != nil {
 			return nil, err
 		}
+		if request.Statement, err = rewriteRowFilter(ctx, s.store, s.dbFactory, instance, database, user, "" /* dataSourceID */, request.Statement, spans); err != nil {
+			return nil, err
+		}
 	}
 
 	// Run SQL review.
@@ -344,7 +360,7 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 
 	bytes, durationNs, exportErr := DoExport(ctx, s.store, s.dbFactory, s.licenseService, request, instance, database, spans)
 
-	if err := s.postExport(ctx, database, statement, user.ID, durationNs, exportErr); err != nil {
+	if err := s.postExport(ctx, instance, database, statement, user.ID, durationNs, exportErr, spans); err != nil {
 		return nil, err
 	}
 
@@ -362,7 +378,14 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 	}, nil
 }
 
-func (s *SQLService) doExportFromIssue(ctx context.Context, issueName string) (*v1pb.ExportResponse, error) {
+func (s *SQLService) doExportFromIssue(ctx context.Context, request *v1pb.ExportRequest) (*v1pb.ExportResponse, error) {
+	issueName := request.Name
+	if request.Offset < 0 || request.Length < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset and length must not be negative")
+	}
+	if request.Length > maxExportDownloadSize {
+		return nil, status.Errorf(codes.InvalidArgument, "length must not exceed %d bytes", maxExportDownloadSize)
+	}
 	issueUID, err := common.GetIssueID(issueName)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to get issue ID: %v", err)
@@ -409,20 +432,84 @@ func (s *SQLService) doExportFromIssue(ctx context.Context, issueName string) (*
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get export archive: %v", err)
 	}
-	if exportArchive == nil {
-		return nil, status.Errorf(codes.NotFound, "export archive %d not found", exportArchiveUID)
+	// The expired export archive is deleted by the cleaner later.
+	if exportArchive == nil || time.Since(exportArchive.CreatedTime) > cleaner.ExportArchiveRetention {
+		return nil, status.Errorf(codes.NotFound, "export archive %d not found or expired", exportArchiveUID)
 	}
-	// Delete the export archive after it's fetched.
-	if err := s.store.DeleteExportArchive(ctx, exportArchiveUID); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete export archive: %v", err)
+	size := getExportArchiveSize(exportArchive)
+	if request.Offset > size {
+		return nil, status.Errorf(codes.InvalidArgument, "offset %d exceeds the export archive size %d", request.Offset, size)
+	}
+	length := request.Length
+	if length == 0 || request.Offset+length > size {
+		length = size - request.Offset
+	}
+	if length > maxExportDownloadSize {
+		return nil, status.Errorf(codes.FailedPrecondition, "the export archive of %d bytes exceeds %d bytes, download it by range with offset and length", size, maxExportDownloadSize)
+	}
+	content, err := readExportArchive(ctx, s.store, exportArchive, request.Offset, length)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read export archive: %v", err)
 	}
 	return &v1pb.ExportResponse{
-		Content: exportArchive.Bytes,
+		Content:   content,
+		TotalSize: size,
 	}, nil
 }
 
 // DoExport does the export.
 func DoExport(ctx context.Context, storeInstance *store.Store, dbFactory *dbfactory.DBFactory, licenseService enterprise.LicenseService, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan) ([]byte, int64, error) {
+	result, durationNs, err := queryExportResult(ctx, storeInstance, dbFactory, licenseService, request, instance, database, spans)
+	if err != nil {
+		return nil, durationNs, err
+	}
+
+	var content []byte
+	switch request.Format {
+	case v1pb.ExportFormat_CSV:
+		if content, err = exportCSV(result); err != nil {
+			return nil, durationNs, err
+		}
+	case v1pb.ExportFormat_JSON:
+		if content, err = exportJSON(result); err != nil {
+			return nil, durationNs, err
+		}
+	case v1pb.ExportFormat_SQL:
+		resourceList, err := extractResourceList(ctx, storeInstance, instance.Engine, database.DatabaseName, request.Statement, instance)
+		if err != nil {
+			return nil, 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
+		}
+		statementPrefix, err := getSQLStatementPrefix(instance.Engine, resourceList, result.ColumnNames)
+		if err != nil {
+			return nil, 0, err
+		}
+		if content, err = exportSQL(instance.Engine, statementPrefix, result); err != nil {
+			return nil, durationNs, err
+		}
+	case v1pb.ExportFormat_XLSX:
+		if content, err = exportXLSX(result); err != nil {
+			return nil, durationNs, err
+		}
+	case v1pb.ExportFormat_PARQUET:
+		if content, err = exportParquet(result); err != nil {
+			return nil, durationNs, err
+		}
+	case v1pb.ExportFormat_AVRO:
+		if content, err = exportAvro(result); err != nil {
+			return nil, durationNs, err
+		}
+	case v1pb.ExportFormat_MARKDOWN:
+		if content, err = exportMarkdown(result); err != nil {
+			return nil, durationNs, err
+		}
+	default:
+		return nil, durationNs, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
+	}
+	return content, durationNs, nil
+}
+
+// queryExportResult queries the last result of the export statement and masks it.
+func queryExportResult(ctx context.Context, storeInstance *store.Store, dbFactory *dbfactory.DBFactory, licenseService enterprise.LicenseService, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan) (*v1pb.QueryResult, int64, error) {
 	driver, err := dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
 	if err != nil {
 		return nil, 0, err
@@ -466,49 +553,21 @@ func DoExport(ctx context.Context, storeInstance *store.Store, dbFactory *dbfact
 			return nil, durationNs, err
 		}
 	}
-
-	var content []byte
-	switch request.Format {
-	case v1pb.ExportFormat_CSV:
-		if content, err = exportCSV(result[0]); err != nil {
-			return nil, durationNs, err
-		}
-	case v1pb.ExportFormat_JSON:
-		if content, err = exportJSON(result[0]); err != nil {
-			return nil, durationNs, err
-		}
-	case v1pb.ExportFormat_SQL:
-		resourceList, err := extractResourceList(ctx, storeInstance, instance.Engine, database.DatabaseName, request.Statement, instance)
-		if err != nil {
-			return nil, 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
-		}
-		statementPrefix, err := getSQLStatementPrefix(instance.Engine, resourceList, result[0].ColumnNames)
-		if err != nil {
-			return nil, 0, err
-		}
-		if content, err = exportSQL(instance.Engine, statementPrefix, result[0]); err != nil {
-			return nil, durationNs, err
-		}
-	case v1pb.ExportFormat_XLSX:
-		if content, err = exportXLSX(result[0]); err != nil {
-			return nil, durationNs, err
-		}
-	default:
-		return nil, durationNs, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
-	}
-	return content, durationNs, nil
+	return result[0], durationNs, nil
 }
 
-func (s *SQLService) postExport(ctx context.Context, database *store.DatabaseMessage, statement string, userUID int, durationNs int64, queryErr error) error {
+func (s *SQLService) postExport(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, statement string, userUID int, durationNs int64, queryErr error, spans []*base.QuerySpan) error {
 	qh := &store.QueryHistoryMessage{
-		CreatorUID: userUID,
-		ProjectID:  database.ProjectID,
-		Database:   common.FormatDatabase(database.InstanceID, database.DatabaseName),
-		Statement:  statement,
-		Type:       store.QueryHistoryTypeExport,
+		CreatorUID:  userUID,
+		ProjectID:   database.ProjectID,
+		Database:    common.FormatDatabase(database.InstanceID, database.DatabaseName),
+		Statement:   statement,
+		Type:        store.QueryHistoryTypeExport,
+		Fingerprint: getQueryFingerprint(instance.Engine, statement),
 		Payload: &storepb.QueryHistoryPayload{
 			Error:    nil,
 			Duration: durationpb.New(time.Duration(durationNs)),
+			Tables:   getQueryTables(spans),
 		},
 	}
 	if queryErr != nil {
@@ -527,13 +586,28 @@ func DoEncrypt(data []byte, request *v1pb.ExportRequest) ([]byte, error) {
 		return data, nil
 	}
 	var b bytes.Buffer
-	fzip := io.Writer(&b)
-
-	zipw := zip.NewWriter(fzip)
-	defer zipw.Close()
+	writer, err := newEncryptWriter(&b, request)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, errors.Wrapf(err, "failed to write export file")
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close zip writer")
+	}
+	return b.Bytes(), nil
+}
 
+// newEncryptWriter creates the writer encrypting the export in the zip file with the password of the request,
+// and the export is written as is without the password. Closing it doesn't close the underlying writer.
+func newEncryptWriter(w io.Writer, request *v1pb.ExportRequest) (io.WriteCloser, error) {
+	if request.Password == "" {
+		return nopWriteCloser{w}, nil
+	}
+	zipw := zip.NewWriter(w)
 	fh := &zip.FileHeader{
-		Name:   fmt.Sprintf("export.%s", strings.ToLower(request.Format.String())),
+		Name:   fmt.Sprintf("export.%s", getExportFileExtension(request.Format)),
 		Method: zip.Deflate,
 	}
 	fh.ModifiedDate, fh.ModifiedTime = timeToMsDosTime(time.Now())
@@ -542,15 +616,25 @@ func DoEncrypt(data []byte, request *v1pb.ExportRequest) ([]byte, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create encrypt export file")
 	}
+	return &zipWriteCloser{Writer: writer, zipw: zipw}, nil
+}
 
-	if _, err := io.Copy(writer, bytes.NewReader(data)); err != nil {
-		return nil, errors.Wrapf(err, "failed to write export file")
-	}
-	if err := zipw.Close(); err != nil {
-		return nil, errors.Wrap(err, "failed to close zip writer")
-	}
+type nopWriteCloser struct {
+	io.Writer
+}
 
-	return b.Bytes(), nil
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// zipWriteCloser writes the file in the zip, and closing it writes the end of the zip.
+type zipWriteCloser struct {
+	io.Writer
+	zipw *zip.Writer
+}
+
+func (w *zipWriteCloser) Close() error {
+	return w.zipw.Close()
 }
 
 // timeToMsDosTime converts a time.Time to an MS-DOS date and time.
@@ -580,28 +664,8 @@ func (s *SQLService) SearchQueryHistories(ctx context.Context, request *v1pb.Sea
 		Offset:     &offset,
 	}
 
-	filters, err := parseFilter(request.Filter)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
-	}
-
-	for _, spec := range filters {
-		if spec.operator != comparatorTypeEqual {
-			return nil, status.Errorf(codes.InvalidArgument, `only support "=" operation for "%v" filter`, spec.key)
-		}
-		switch spec.key {
-		case "database":
-			database := spec.value
-			find.Database = &database
-		case "instance":
-			instance := spec.value
-			find.Instance = &instance
-		case "type":
-			historyType := store.QueryHistoryType(spec.value)
-			find.Type = &historyType
-		default:
-			return nil, status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
-		}
+	if err := s.parseQueryHistoryFilter(ctx, request.Filter, false /* allowUser */, find); err != nil {
+		return nil, err
 	}
 
 	historyList, err := s.store.ListQueryHistories(ctx, find)
@@ -655,23 +719,44 @@ func (s *SQLService) convertToV1QueryHistory(ctx context.Context, history *store
 	}
 
 	return &v1pb.QueryHistory{
-		Name:       fmt.Sprintf("queryHistories/%d", history.UID),
-		Statement:  history.Statement,
-		Error:      history.Payload.Error,
-		Database:   history.Database,
-		Creator:    common.FormatUserEmail(user.Email),
-		CreateTime: timestamppb.New(history.CreatedTime),
-		Duration:   history.Payload.Duration,
-		Type:       historyType,
+		Name:        fmt.Sprintf("queryHistories/%d", history.UID),
+		Statement:   history.Statement,
+		Error:       history.Payload.Error,
+		Database:    history.Database,
+		Creator:     common.FormatUserEmail(user.Email),
+		CreateTime:  timestamppb.New(history.CreatedTime),
+		Duration:    history.Payload.Duration,
+		Type:        historyType,
+		CostGuard:   convertToV1QueryCostGuardResult(history.Payload.CostGuard),
+		Fingerprint: history.Fingerprint,
 	}, nil
 }
 
+func convertToV1QueryCostGuardResult(result *storepb.QueryCostGuardResult) *v1pb.QueryCostGuardResult {
+	if result == nil {
+		return nil
+	}
+	return &v1pb.QueryCostGuardResult{
+		EstimatedRows: result.EstimatedRows,
+		EstimatedCost: result.EstimatedCost,
+		FullScan:      result.FullScan,
+		Action:        v1pb.QueryCostGuardPolicy_Rule_Action(result.Action),
+		Issue:         result.Issue,
+		EstimateError: result.EstimateError,
+	}
+}
+
 // Query executes a SQL query.
 // We have the following stages:
 //  1. pre-query
 //  2. do query
 //  3. post-query
 func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1pb.QueryResponse, error) {
+	return s.query(ctx, request, false /* isShare */)
+}
+
+// query executes a SQL query, the results are masked without the masking exceptions of the user if isShare is true.
+func (s *SQLService) query(ctx context.Context, request *v1pb.QueryRequest, isShare bool) (*v1pb.QueryResponse, error) {
 	// Prepare related message.
 	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Name, request.ConnectionDatabase)
 	if err != nil {
@@ -688,6 +773,11 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 	if err := validateQueryRequest(instance, statement); err != nil {
 		return nil, err
 	}
+	if request.PageSize > 0 {
+		if err := validatePagedQueryRequest(request, instance); err != nil {
+			return nil, err
+		}
+	}
 
 	// Get query span.
 	spans, err := base.GetQuerySpan(
@@ -711,6 +801,10 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 		if err := s.accessCheck(ctx, instance, user, spans, request.Limit, false /* isAdmin */, false /* isExport */); err != nil {
 			return nil, err
 		}
+		// The statement executed reads only the rows visible to the user, and the original statement is kept in the query history.
+		if request.Statement, err = rewriteRowFilter(ctx, s.store, s.dbFactory, instance, database, user, request.DataSourceId, request.Statement, spans); err != nil {
+			return nil, err
+		}
 	}
 
 	// Run SQL review.
@@ -719,24 +813,43 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 		return nil, err
 	}
 
+	// The results of the paged query are not cached.
+	var cacheKey string
+	var cacheTime *timestamppb.Timestamp
+	if ttl := getQueryResultCacheTTL(instance); ttl > 0 && request.PageSize <= 0 {
+		if cacheKey, err = s.getQueryResultCacheKey(ctx, request, instance, database); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get query result cache key: %v", err)
+		}
+	}
+
 	var results []*v1pb.QueryResult
 	var queryErr error
 	var durationNs int64
+	var costGuard *storepb.QueryCostGuardResult
 	if adviceStatus != storepb.Advice_ERROR {
-		results, durationNs, queryErr = s.doQuery(ctx, request, instance, database)
-		if queryErr == nil && s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil {
-			masker := NewQueryResultMasker(s.store)
-			if err := masker.MaskResults(ctx, spans, results, instance, storepb.MaskingExceptionPolicy_MaskingException_QUERY); err != nil {
-				return nil, status.Errorf(codes.Internal, err.Error())
+		if entry := s.getCachedQueryResults(cacheKey); entry != nil {
+			// The cached results are masked on every hit with the masking state of the time.
+			results = cloneQueryResults(entry.results)
+			cacheTime = timestamppb.New(entry.cacheTime)
+			queryErr = s.maskQueryResults(ctx, instance, spans, results, isShare)
+		} else {
+			var costGuardAdvices []*v1pb.Advice
+			costGuard, costGuardAdvices, queryErr = s.guardQueryCost(ctx, request, user, instance, database)
+			advices = append(advices, costGuardAdvices...)
+			if queryErr == nil {
+				results, durationNs, queryErr = s.doQueryAndMask(ctx, request, user, instance, database, spans, isShare, cacheKey)
 			}
 		}
 	}
 
 	// Update activity.
-	if err = s.postQuery(ctx, database, statement, user.ID, durationNs, queryErr); err != nil {
+	if err = s.postQuery(ctx, instance, database, statement, user.ID, durationNs, queryErr, spans, costGuard); err != nil {
 		return nil, err
 	}
 	if queryErr != nil {
+		if _, ok := status.FromError(queryErr); ok {
+			return nil, queryErr
+		}
 		return nil, status.Errorf(codes.Internal, queryErr.Error())
 	}
 
@@ -751,11 +864,78 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 		Results:     results,
 		Advices:     advices,
 		AllowExport: allowExport,
+		CacheTime:   cacheTime,
 	}
 
 	return response, nil
 }
 
+// doQueryAndMask does query and masks the results, the unmasked results of the query not paged are cached if the cache key is not empty.
+func (s *SQLService) doQueryAndMask(ctx context.Context, request *v1pb.QueryRequest, user *store.UserMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan, isShare bool, cacheKey string) ([]*v1pb.QueryResult, int64, error) {
+	if request.PageSize > 0 {
+		// The pages are masked when they are fetched.
+		return s.doPagedQuery(ctx, request, user, instance, database, spans)
+	}
+	results, durationNs, queryErr := s.doQuery(ctx, request, instance, database)
+	if queryErr != nil {
+		return nil, durationNs, queryErr
+	}
+	if cacheKey != "" {
+		s.queryResultCache.add(cacheKey, results, getQueryResultCacheTTL(instance))
+	}
+	if err := s.maskQueryResults(ctx, instance, spans, results, isShare); err != nil {
+		return nil, durationNs, err
+	}
+	return results, durationNs, nil
+}
+
+// maskQueryResults masks the query results in place, the masking exceptions are not applied to the shared results.
+func (s *SQLService) maskQueryResults(ctx context.Context, instance *store.InstanceMessage, spans []*base.QuerySpan, results []*v1pb.QueryResult, isShare bool) error {
+	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) != nil {
+		return nil
+	}
+	masker := NewQueryResultMasker(s.store)
+	var err error
+	if isShare {
+		err = masker.MaskResultsWithoutExceptions(ctx, spans, results, instance)
+	} else {
+		err = masker.MaskResults(ctx, spans, results, instance, storepb.MaskingExceptionPolicy_MaskingException_QUERY)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// getCachedQueryResults returns the cached query results of the key, nil if the key is empty or not cached.
+func (s *SQLService) getCachedQueryResults(cacheKey string) *queryResultCacheEntry {
+	if cacheKey == "" {
+		return nil
+	}
+	return s.queryResultCache.get(cacheKey)
+}
+
+// validatePagedQueryRequest validates the query whose result is fetched in pages, which must be a single statement.
+func validatePagedQueryRequest(request *v1pb.QueryRequest, instance *store.InstanceMessage) error {
+	if request.PageSize > maxQueryResultPageSize {
+		return status.Errorf(codes.InvalidArgument, "page size must be in (0, %d]", maxQueryResultPageSize)
+	}
+	if !isPagedQuerySupported(instance.Engine) {
+		return status.Errorf(codes.InvalidArgument, "paged query is not supported for %s", instance.Engine.String())
+	}
+	if request.Explain {
+		return status.Errorf(codes.InvalidArgument, "explain query cannot be paged")
+	}
+	list, err := base.SplitMultiSQL(instance.Engine, request.Statement)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to split statement: %v", err)
+	}
+	if len(base.FilterEmptySQL(list)) != 1 {
+		return status.Errorf(codes.InvalidArgument, "paged query must be a single statement")
+	}
+	return nil
+}
+
 // doQuery does query.
 func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) ([]*v1pb.QueryResult, int64, error) {
 	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, request.DataSourceId)
@@ -801,16 +981,19 @@ func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, in
 	return results, time.Now().UnixNano() - start, err
 }
 
-func (s *SQLService) postQuery(ctx context.Context, database *store.DatabaseMessage, statement string, userUID int, durationNs int64, queryErr error) error {
+func (s *SQLService) postQuery(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, statement string, userUID int, durationNs int64, queryErr error, spans []*base.QuerySpan, costGuard *storepb.QueryCostGuardResult) error {
 	qh := &store.QueryHistoryMessage{
-		CreatorUID: userUID,
-		ProjectID:  database.ProjectID,
-		Database:   common.FormatDatabase(database.InstanceID, database.DatabaseName),
-		Statement:  statement,
-		Type:       store.QueryHistoryTypeQuery,
+		CreatorUID:  userUID,
+		ProjectID:   database.ProjectID,
+		Database:    common.FormatDatabase(database.InstanceID, database.DatabaseName),
+		Statement:   statement,
+		Type:        store.QueryHistoryTypeQuery,
+		Fingerprint: getQueryFingerprint(instance.Engine, statement),
 		Payload: &storepb.QueryHistoryPayload{
-			Error:    nil,
-			Duration: durationpb.New(time.Duration(durationNs)),
+			Error:     nil,
+			Duration:  durationpb.New(time.Duration(durationNs)),
+			CostGuard: costGuard,
+			Tables:    getQueryTables(spans),
 		},
 	}
 	if queryErr != nil {
@@ -1571,6 +1754,48 @@ func (s *SQLService) GenerateRestoreSQL(ctx context.Context, request *v1pb.Gener
 	}, nil
 }
 
+// Unmask reverses the values masked by the format-preserving masking algorithm of the column.
+func (s *SQLService) Unmask(ctx context.Context, request *v1pb.UnmaskRequest) (*v1pb.UnmaskResponse, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance")
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	if err := s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database")
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+
+	values, err := NewQueryResultMasker(s.store).UnmaskValues(ctx, instance, base.ColumnResource{
+		Database: database.DatabaseName,
+		Schema:   request.Schema,
+		Table:    request.Table,
+		Column:   request.Column,
+	}, request.Values)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.UnmaskResponse{
+		Values: values,
+	}, nil
+}
+
 func getOffsetAndOriginTable(backupTable string) (int, string, error) {
 	if backupTable == "" {
 		return 0, "", nil
@@ -0,0 +1,417 @@
+package v1
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// exportColumnType is the logical type of the column in the typed export formats, i.e. Parquet and Avro.
+type exportColumnType int
+
+const (
+	exportColumnTypeString exportColumnType = iota
+	exportColumnTypeBool
+	exportColumnTypeInt64
+	exportColumnTypeFloat
+	exportColumnTypeDouble
+	exportColumnTypeDecimal
+	exportColumnTypeTimestamp
+	exportColumnTypeDate
+	exportColumnTypeBytes
+)
+
+// maxExportDecimalPrecision is the maximum precision of the decimal columns, the larger decimals are exported as strings.
+const maxExportDecimalPrecision = 38
+
+// exportColumn is the column of the typed export formats.
+type exportColumn struct {
+	name string
+	// typeName is the upper case column type name without the length, precision and scale.
+	typeName  string
+	tp        exportColumnType
+	precision int32
+	scale     int32
+}
+
+var (
+	decimalValueRE = regexp.MustCompile(`^[+-]?([0-9]+)(?:\.([0-9]*))?$`)
+	// timestampLayouts are the layouts of the timestamps returned by the drivers, the timestamps without time zone are in UTC.
+	timestampLayouts = []string{
+		time.RFC3339Nano,
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999 -0700 MST",
+		"2006-01-02 15:04:05.999999999 -07:00",
+		"2006-01-02 15:04:05.999999999-07",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02T15:04:05.999999999",
+	}
+)
+
+// getExportColumns gets the columns of the typed export formats from the column type names and the values of the result.
+// A column falls back to string if any of its values cannot be converted to the logical type, e.g. the masked values.
+func getExportColumns(result *v1pb.QueryResult) []*exportColumn {
+	var columns []*exportColumn
+	for i, name := range result.ColumnNames {
+		typeName := ""
+		if i < len(result.ColumnTypeNames) {
+			typeName = strings.ToUpper(result.ColumnTypeNames[i])
+		}
+		if j := strings.Index(typeName, "("); j >= 0 {
+			typeName = strings.TrimSpace(typeName[:j])
+		}
+		var values []*v1pb.RowValue
+		for _, row := range result.Rows {
+			if i < len(row.Values) && !isNullRowValue(row.Values[i]) {
+				values = append(values, row.Values[i])
+			}
+		}
+		column := &exportColumn{name: name, typeName: typeName}
+		column.tp, column.precision, column.scale = getExportColumnType(typeName, values)
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+func getExportColumnType(typeName string, values []*v1pb.RowValue) (exportColumnType, int32, int32) {
+	if len(values) == 0 {
+		return exportColumnTypeString, 0, 0
+	}
+	allKinds := func(match func(*v1pb.RowValue) bool) bool {
+		for _, v := range values {
+			if !match(v) {
+				return false
+			}
+		}
+		return true
+	}
+	allStrings := func(match func(string) bool) bool {
+		return allKinds(func(v *v1pb.RowValue) bool {
+			s, ok := v.Kind.(*v1pb.RowValue_StringValue)
+			return ok && match(s.StringValue)
+		})
+	}
+
+	switch {
+	case allKinds(func(v *v1pb.RowValue) bool { _, ok := v.Kind.(*v1pb.RowValue_BoolValue); return ok }):
+		return exportColumnTypeBool, 0, 0
+	case allKinds(func(v *v1pb.RowValue) bool { _, ok := v.Kind.(*v1pb.RowValue_FloatValue); return ok }):
+		return exportColumnTypeFloat, 0, 0
+	case allKinds(func(v *v1pb.RowValue) bool {
+		switch v.Kind.(type) {
+		case *v1pb.RowValue_FloatValue, *v1pb.RowValue_DoubleValue:
+			return true
+		}
+		return false
+	}):
+		return exportColumnTypeDouble, 0, 0
+	case allKinds(func(v *v1pb.RowValue) bool { _, ok := v.Kind.(*v1pb.RowValue_BytesValue); return ok }):
+		return exportColumnTypeBytes, 0, 0
+	case allKinds(func(v *v1pb.RowValue) bool {
+		switch v.Kind.(type) {
+		case *v1pb.RowValue_Int32Value, *v1pb.RowValue_Int64Value, *v1pb.RowValue_Uint32Value:
+			return true
+		case *v1pb.RowValue_Uint64Value:
+			return v.GetUint64Value() <= math.MaxInt64
+		}
+		return false
+	}):
+		return exportColumnTypeInt64, 0, 0
+	case allKinds(func(v *v1pb.RowValue) bool {
+		switch v.Kind.(type) {
+		case *v1pb.RowValue_Int32Value, *v1pb.RowValue_Int64Value, *v1pb.RowValue_Uint32Value, *v1pb.RowValue_Uint64Value:
+			return true
+		}
+		return false
+	}):
+		// The unsigned integers larger than the maximum int64 are exported as decimals.
+		return exportColumnTypeDecimal, maxExportDecimalPrecision, 0
+	}
+
+	// Most drivers return the values as strings, and the logical type is inferred from the column type name.
+	switch typeName {
+	case "BOOL", "BOOLEAN":
+		if allStrings(func(s string) bool { _, err := strconv.ParseBool(s); return err == nil }) {
+			return exportColumnTypeBool, 0, 0
+		}
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "INTEGER", "BIGINT", "INT2", "INT4", "INT8", "SERIAL", "BIGSERIAL", "SMALLSERIAL",
+		"UNSIGNED TINYINT", "UNSIGNED SMALLINT", "UNSIGNED MEDIUMINT", "UNSIGNED INT", "UNSIGNED BIGINT", "YEAR":
+		if allStrings(func(s string) bool { _, err := strconv.ParseInt(s, 10, 64); return err == nil }) {
+			return exportColumnTypeInt64, 0, 0
+		}
+		if allStrings(decimalValueRE.MatchString) {
+			return getDecimalColumnType(values)
+		}
+	case "FLOAT4", "REAL":
+		if allStrings(func(s string) bool { _, err := strconv.ParseFloat(s, 32); return err == nil }) {
+			return exportColumnTypeFloat, 0, 0
+		}
+	case "FLOAT", "FLOAT8", "DOUBLE", "DOUBLE PRECISION", "BINARY_FLOAT", "BINARY_DOUBLE":
+		if allStrings(func(s string) bool { _, err := strconv.ParseFloat(s, 64); return err == nil }) {
+			return exportColumnTypeDouble, 0, 0
+		}
+	case "DECIMAL", "NUMERIC", "NUMBER", "DEC", "MONEY", "SMALLMONEY":
+		if allStrings(decimalValueRE.MatchString) {
+			return getDecimalColumnType(values)
+		}
+	case "DATE":
+		if allStrings(func(s string) bool { _, err := time.Parse(time.DateOnly, s); return err == nil }) {
+			return exportColumnTypeDate, 0, 0
+		}
+		if allStrings(func(s string) bool { _, ok := parseExportTimestamp(s); return ok }) {
+			return exportColumnTypeTimestamp, 0, 0
+		}
+	case "TIMESTAMP", "TIMESTAMPTZ", "TIMESTAMP WITH TIME ZONE", "TIMESTAMP WITHOUT TIME ZONE", "DATETIME", "DATETIME2", "SMALLDATETIME", "DATETIMEOFFSET", "TIMESTAMP_NTZ", "TIMESTAMP_LTZ", "TIMESTAMP_TZ":
+		if allStrings(func(s string) bool { _, ok := parseExportTimestamp(s); return ok }) {
+			return exportColumnTypeTimestamp, 0, 0
+		}
+	}
+	return exportColumnTypeString, 0, 0
+}
+
+// declaredScaleRE matches the declared precision and scale of the column type name, e.g. DECIMAL(10,2).
+var declaredScaleRE = regexp.MustCompile(`\(\s*[0-9]+\s*,\s*([0-9]+)\s*\)`)
+
+// getPagedExportColumns widens the columns inferred from the first page of the result written in pages,
+// so that the values of the following pages fit in the columns:
+// the floats are widened to doubles, the unsigned big integers are widened to decimals,
+// and the decimals use the scale declared by the column type name, or fall back to strings if the scale is not declared.
+func getPagedExportColumns(result *v1pb.QueryResult, columns []*exportColumn) []*exportColumn {
+	for i, column := range columns {
+		rawTypeName := ""
+		if i < len(result.ColumnTypeNames) {
+			rawTypeName = strings.ToUpper(result.ColumnTypeNames[i])
+		}
+		unsignedBigInt := strings.Contains(column.typeName, "BIGINT") && strings.Contains(column.typeName, "UNSIGNED")
+		switch column.tp {
+		case exportColumnTypeFloat:
+			column.tp = exportColumnTypeDouble
+		case exportColumnTypeInt64:
+			if unsignedBigInt || hasUint64Values(result, i) {
+				column.tp, column.precision, column.scale = exportColumnTypeDecimal, maxExportDecimalPrecision, 0
+			}
+		case exportColumnTypeDecimal:
+			if unsignedBigInt || hasUint64Values(result, i) {
+				column.precision, column.scale = maxExportDecimalPrecision, 0
+				continue
+			}
+			matches := declaredScaleRE.FindStringSubmatch(rawTypeName)
+			if matches == nil {
+				column.tp, column.precision, column.scale = exportColumnTypeString, 0, 0
+				continue
+			}
+			scale, err := strconv.Atoi(matches[1])
+			if err != nil || scale > maxExportDecimalPrecision {
+				column.tp, column.precision, column.scale = exportColumnTypeString, 0, 0
+				continue
+			}
+			column.precision, column.scale = maxExportDecimalPrecision, int32(scale)
+		}
+	}
+	return columns
+}
+
+// hasUint64Values returns true if the column has the uint64 values in the result.
+func hasUint64Values(result *v1pb.QueryResult, i int) bool {
+	for _, row := range result.Rows {
+		if i < len(row.Values) {
+			if _, ok := row.Values[i].GetKind().(*v1pb.RowValue_Uint64Value); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getDecimalColumnType gets the scale of the decimal column from the values matching decimalValueRE.
+// The precision is always the maximum precision so that the larger values in the following pages of the result fit in.
+func getDecimalColumnType(values []*v1pb.RowValue) (exportColumnType, int32, int32) {
+	var integerDigits, scale int
+	for _, v := range values {
+		matches := decimalValueRE.FindStringSubmatch(v.GetStringValue())
+		integerDigits = max(integerDigits, len(strings.TrimLeft(matches[1], "0")))
+		scale = max(scale, len(matches[2]))
+	}
+	if integerDigits+scale > maxExportDecimalPrecision {
+		return exportColumnTypeString, 0, 0
+	}
+	return exportColumnTypeDecimal, maxExportDecimalPrecision, int32(scale)
+}
+
+// accepts returns true if the non-null value can be converted to the type of the column.
+// The type of the column is inferred from the first page of the result, and the values of the following pages are checked.
+func (c *exportColumn) accepts(value *v1pb.RowValue) bool {
+	switch c.tp {
+	case exportColumnTypeString:
+		return true
+	case exportColumnTypeDecimal:
+		matches := decimalValueRE.FindStringSubmatch(getExportDecimalString(value))
+		return matches != nil && len(matches[2]) <= int(c.scale) && len(strings.TrimLeft(matches[1], "0"))+int(c.scale) <= int(c.precision)
+	case exportColumnTypeDouble:
+		if tp, _, _ := getExportColumnType(c.typeName, []*v1pb.RowValue{value}); tp == exportColumnTypeFloat {
+			return true
+		}
+	case exportColumnTypeDate:
+		if _, ok := parseExportTimestamp(value.GetStringValue()); ok {
+			return true
+		}
+	}
+	tp, _, _ := getExportColumnType(c.typeName, []*v1pb.RowValue{value})
+	return tp == c.tp
+}
+
+func parseExportTimestamp(s string) (time.Time, bool) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func isNullRowValue(value *v1pb.RowValue) bool {
+	if value == nil || value.Kind == nil {
+		return true
+	}
+	_, ok := value.Kind.(*v1pb.RowValue_NullValue)
+	return ok
+}
+
+// getExportInt64 gets the int64 of the value of the int64 column.
+func getExportInt64(value *v1pb.RowValue) int64 {
+	switch value.Kind.(type) {
+	case *v1pb.RowValue_Int32Value:
+		return int64(value.GetInt32Value())
+	case *v1pb.RowValue_Int64Value:
+		return value.GetInt64Value()
+	case *v1pb.RowValue_Uint32Value:
+		return int64(value.GetUint32Value())
+	case *v1pb.RowValue_Uint64Value:
+		return int64(value.GetUint64Value())
+	}
+	v, _ := strconv.ParseInt(value.GetStringValue(), 10, 64)
+	return v
+}
+
+func getExportFloat64(value *v1pb.RowValue) float64 {
+	switch value.Kind.(type) {
+	case *v1pb.RowValue_FloatValue:
+		return float64(value.GetFloatValue())
+	case *v1pb.RowValue_DoubleValue:
+		return value.GetDoubleValue()
+	}
+	v, _ := strconv.ParseFloat(value.GetStringValue(), 64)
+	return v
+}
+
+func getExportBool(value *v1pb.RowValue) bool {
+	if v, ok := value.Kind.(*v1pb.RowValue_BoolValue); ok {
+		return v.BoolValue
+	}
+	v, _ := strconv.ParseBool(value.GetStringValue())
+	return v
+}
+
+// getExportDecimalString gets the decimal string of the value of the decimal column.
+func getExportDecimalString(value *v1pb.RowValue) string {
+	switch value.Kind.(type) {
+	case *v1pb.RowValue_StringValue:
+		return value.GetStringValue()
+	case *v1pb.RowValue_Uint64Value:
+		return strconv.FormatUint(value.GetUint64Value(), 10)
+	case *v1pb.RowValue_Int32Value, *v1pb.RowValue_Int64Value, *v1pb.RowValue_Uint32Value:
+		return strconv.FormatInt(getExportInt64(value), 10)
+	}
+	return ""
+}
+
+// getExportUnscaledDecimal gets the unscaled integer of the decimal string, e.g. 12.3 is 1230 with scale 2.
+func getExportUnscaledDecimal(s string, scale int32) *big.Int {
+	matches := decimalValueRE.FindStringSubmatch(s)
+	digits := matches[1] + matches[2] + strings.Repeat("0", int(scale)-len(matches[2]))
+	v, _ := new(big.Int).SetString(digits, 10)
+	if strings.HasPrefix(s, "-") {
+		v.Neg(v)
+	}
+	return v
+}
+
+func getExportTimestamp(value *v1pb.RowValue) time.Time {
+	t, _ := parseExportTimestamp(value.GetStringValue())
+	return t
+}
+
+func getExportDate(value *v1pb.RowValue) time.Time {
+	t, err := time.Parse(time.DateOnly, value.GetStringValue())
+	if err != nil {
+		t, _ = parseExportTimestamp(value.GetStringValue())
+	}
+	return t
+}
+
+// getExportString gets the string of the value of the string column, and the bytes are encoded in base64.
+func getExportString(value *v1pb.RowValue) string {
+	return convertValueToStringInJSON(value)
+}
+
+func appendAvroBytes(data []byte, v []byte) []byte {
+	data = binary.AppendVarint(data, int64(len(v)))
+	return append(data, v...)
+}
+
+func appendAvroString(data []byte, v string) []byte {
+	data = binary.AppendVarint(data, int64(len(v)))
+	return append(data, v...)
+}
+
+// getTwosComplement gets the big-endian two's complement of the integer.
+func getTwosComplement(v *big.Int) []byte {
+	if v.Sign() >= 0 {
+		b := v.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	}
+	// The two's complement of -x in n bytes is 2^(8n) - x.
+	n := (new(big.Int).Neg(v).BitLen() + 8) / 8
+	b := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), uint(8*n)), v).Bytes()
+	for len(b) < n {
+		b = append([]byte{0xff}, b...)
+	}
+	return b
+}
+
+func exportParquet(result *v1pb.QueryResult) ([]byte, error) {
+	return exportByWriter(result, func(w io.Writer) exportWriter { return &parquetExportWriter{w: w} })
+}
+
+func exportAvro(result *v1pb.QueryResult) ([]byte, error) {
+	return exportByWriter(result, func(w io.Writer) exportWriter { return &avroExportWriter{w: w} })
+}
+
+func exportMarkdown(result *v1pb.QueryResult) ([]byte, error) {
+	return exportByWriter(result, func(w io.Writer) exportWriter { return &markdownExportWriter{w: w} })
+}
+
+func escapeMarkdownCell(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", "<br>")
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+// getExportFileExtension gets the file extension of the export format.
+func getExportFileExtension(format v1pb.ExportFormat) string {
+	if format == v1pb.ExportFormat_MARKDOWN {
+		return "md"
+	}
+	return strings.ToLower(format.String())
+}
@@ -0,0 +1,289 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// exportPageSize is the number of rows read from the database for each page of the streaming export.
+	exportPageSize = 10000
+	// exportArchiveChunkSize is the size of the chunks of the export archive.
+	exportArchiveChunkSize = 8 * 1024 * 1024
+	// DefaultMaximumExportSize is the maximum size of the export archive if the workspace doesn't set it.
+	DefaultMaximumExportSize = 10 * 1024 * 1024 * 1024
+	// maxExportDownloadSize is the maximum size of the export archive downloaded in a request.
+	maxExportDownloadSize = 64 * 1024 * 1024
+)
+
+// streamExportEngines are the engines whose drivers query the statements with util.Query,
+// and the rows of their export statements are read by the query cursor.
+// The cursor opens the read-only transaction only if isReadOnlyTransactionSupported returns true.
+var streamExportEngines = map[storepb.Engine]bool{
+	storepb.Engine_POSTGRES:         true,
+	storepb.Engine_MYSQL:            true,
+	storepb.Engine_MARIADB:          true,
+	storepb.Engine_OCEANBASE:        true,
+	storepb.Engine_TIDB:             true,
+	storepb.Engine_MSSQL:            true,
+	storepb.Engine_ORACLE:           true,
+	storepb.Engine_OCEANBASE_ORACLE: true,
+	storepb.Engine_DM:               true,
+	storepb.Engine_REDSHIFT:         true,
+	storepb.Engine_RISINGWAVE:       true,
+	storepb.Engine_SNOWFLAKE:        true,
+	storepb.Engine_SQLITE:           true,
+	storepb.Engine_STARROCKS:        true,
+	storepb.Engine_DORIS:            true,
+}
+
+// isReadOnlyTransactionSupported returns true if the driver of the database accepts the read-only transactions.
+// It follows the drivers clearing the ReadOnly of the query context in QueryConn, because the query cursor doesn't go through QueryConn.
+func isReadOnlyTransactionSupported(instance *store.InstanceMessage, database *store.DatabaseMessage) bool {
+	switch instance.Engine {
+	case storepb.Engine_MSSQL, storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_DM, storepb.Engine_SNOWFLAKE,
+		storepb.Engine_TIDB, storepb.Engine_OCEANBASE, storepb.Engine_STARROCKS, storepb.Engine_DORIS:
+		return false
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
+		// MariaDB 5.5 doesn't support READ ONLY transactions.
+		v, err := semver.Make(instance.EngineVersion)
+		if err != nil {
+			return true
+		}
+		return v.GT(semver.Version{Major: 5, Minor: 5})
+	case storepb.Engine_REDSHIFT:
+		// Datashare doesn't support read-only transactions.
+		return database == nil || !database.DataShare
+	default:
+		return true
+	}
+}
+
+// DoExportToArchive exports the result of the request to a new export archive.
+// The rows are streamed from the database to the export format and the encryption, and the archive is stored in chunks.
+// The export fails if the archive exceeds the maximum size.
+func DoExportToArchive(ctx context.Context, storeInstance *store.Store, dbFactory *dbfactory.DBFactory, licenseService enterprise.LicenseService, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan, maximumSize int64) (*store.ExportArchiveMessage, int64, error) {
+	archive, err := storeInstance.CreateExportArchive(ctx, &store.ExportArchiveMessage{
+		Payload: &storepb.ExportArchivePayload{
+			FileFormat: storepb.ExportFormat(request.Format),
+			ChunkSize:  exportArchiveChunkSize,
+		},
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to create export archive")
+	}
+	archiveWriter := &exportArchiveWriter{
+		ctx:         ctx,
+		store:       storeInstance,
+		archiveUID:  archive.UID,
+		maximumSize: maximumSize,
+	}
+	durationNs, err := doStreamExport(ctx, storeInstance, dbFactory, licenseService, request, instance, database, spans, archiveWriter)
+	if err == nil {
+		err = archiveWriter.flush()
+	}
+	if err == nil {
+		archive.Payload.Size = archiveWriter.size
+		archive.Payload.ChunkCount = int32(archiveWriter.chunkCount)
+		err = storeInstance.UpdateExportArchivePayload(ctx, archive.UID, archive.Payload)
+	}
+	if err != nil {
+		// The chunks are deleted with the archive.
+		if deleteErr := storeInstance.DeleteExportArchive(context.WithoutCancel(ctx), archive.UID); deleteErr != nil {
+			slog.Error("failed to delete export archive", slog.Int("archive", archive.UID), log.BBError(deleteErr))
+		}
+		return nil, durationNs, err
+	}
+	return archive, durationNs, nil
+}
+
+// doStreamExport exports the result of the request to the writer page by page.
+func doStreamExport(ctx context.Context, storeInstance *store.Store, dbFactory *dbfactory.DBFactory, licenseService enterprise.LicenseService, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan, w *exportArchiveWriter) (int64, error) {
+	var resourceList []base.SchemaResource
+	if request.Format == v1pb.ExportFormat_SQL {
+		list, err := extractResourceList(ctx, storeInstance, instance.Engine, database.DatabaseName, request.Statement, instance)
+		if err != nil {
+			return 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
+		}
+		resourceList = list
+	}
+	encryptWriter, err := newEncryptWriter(w, request)
+	if err != nil {
+		return 0, err
+	}
+	writer, err := newExportWriter(encryptWriter, request.Format, instance.Engine, resourceList)
+	if err != nil {
+		return 0, err
+	}
+
+	var durationNs int64
+	if isStreamExport(instance.Engine, request.Statement) {
+		durationNs, err = streamExportResult(ctx, storeInstance, dbFactory, licenseService, request, instance, database, spans, writer.Write)
+	} else {
+		var result *v1pb.QueryResult
+		result, durationNs, err = queryExportResult(ctx, storeInstance, dbFactory, licenseService, request, instance, database, spans)
+		if err == nil {
+			err = writer.Write(result)
+		}
+	}
+	if err != nil {
+		return durationNs, err
+	}
+	if err := writer.Close(); err != nil {
+		return durationNs, err
+	}
+	if err := encryptWriter.Close(); err != nil {
+		return durationNs, errors.Wrap(err, "failed to close zip writer")
+	}
+	return durationNs, nil
+}
+
+// isStreamExport returns true if the export statement is a single statement of the engine in streamExportEngines.
+func isStreamExport(engine storepb.Engine, statement string) bool {
+	if !streamExportEngines[engine] {
+		return false
+	}
+	list, err := base.SplitMultiSQL(engine, statement)
+	if err != nil {
+		return false
+	}
+	return len(base.FilterEmptySQL(list)) == 1
+}
+
+// streamExportResult reads the rows of the export statement by the query cursor, and masks and writes them page by page.
+func streamExportResult(ctx context.Context, storeInstance *store.Store, dbFactory *dbfactory.DBFactory, licenseService enterprise.LicenseService, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan, write func(*v1pb.QueryResult) error) (int64, error) {
+	driver, err := dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
+	if err != nil {
+		return 0, err
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		return 0, errors.Errorf("streaming export is not supported for %s", instance.Engine.String())
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	start := time.Now()
+	cursor, err := util.OpenQueryCursor(ctx, instance.Engine, conn, request.Statement, isReadOnlyTransactionSupported(instance, database))
+	if err != nil {
+		return time.Since(start).Nanoseconds(), err
+	}
+	defer cursor.Close()
+
+	maskEnabled := licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil
+	masker := NewQueryResultMasker(storeInstance)
+	remaining := int(request.Limit)
+	for {
+		pageSize := exportPageSize
+		if request.Limit > 0 {
+			pageSize = min(pageSize, remaining)
+		}
+		result, err := cursor.Next(pageSize)
+		if err != nil {
+			return time.Since(start).Nanoseconds(), err
+		}
+		if maskEnabled {
+			if err := masker.MaskResults(ctx, spans, []*v1pb.QueryResult{result}, instance, storepb.MaskingExceptionPolicy_MaskingException_EXPORT); err != nil {
+				return time.Since(start).Nanoseconds(), err
+			}
+		}
+		if err := write(result); err != nil {
+			return time.Since(start).Nanoseconds(), err
+		}
+		remaining -= len(result.Rows)
+		if cursor.Done() || (request.Limit > 0 && remaining <= 0) {
+			return time.Since(start).Nanoseconds(), nil
+		}
+	}
+}
+
+// exportArchiveWriter writes the export archive in chunks, and fails if the archive exceeds the maximum size.
+type exportArchiveWriter struct {
+	ctx         context.Context
+	store       *store.Store
+	archiveUID  int
+	maximumSize int64
+
+	buf        []byte
+	size       int64
+	chunkCount int
+}
+
+func (w *exportArchiveWriter) Write(p []byte) (int, error) {
+	if w.maximumSize > 0 && w.size+int64(len(p)) > w.maximumSize {
+		return 0, errors.Errorf("the export exceeds the maximum export size of %d bytes", w.maximumSize)
+	}
+	n := len(p)
+	for len(p) > 0 {
+		size := min(exportArchiveChunkSize-len(w.buf), len(p))
+		w.buf = append(w.buf, p[:size]...)
+		p = p[size:]
+		if len(w.buf) == exportArchiveChunkSize {
+			if err := w.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	w.size += int64(n)
+	return n, nil
+}
+
+// flush writes the buffered bytes as a chunk.
+func (w *exportArchiveWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	if err := w.store.CreateExportArchiveChunk(w.ctx, w.archiveUID, w.chunkCount, w.buf); err != nil {
+		return errors.Wrapf(err, "failed to create chunk %d of export archive %d", w.chunkCount, w.archiveUID)
+	}
+	w.chunkCount++
+	w.buf = w.buf[:0]
+	return nil
+}
+
+// getExportArchiveSize gets the size of the export archive, which is stored in chunks or in bytes.
+func getExportArchiveSize(archive *store.ExportArchiveMessage) int64 {
+	if archive.Payload.ChunkCount == 0 {
+		return int64(len(archive.Bytes))
+	}
+	return archive.Payload.Size
+}
+
+// readExportArchive reads the range of the export archive.
+func readExportArchive(ctx context.Context, s *store.Store, archive *store.ExportArchiveMessage, offset, length int64) ([]byte, error) {
+	if archive.Payload.ChunkCount == 0 {
+		return archive.Bytes[offset : offset+length], nil
+	}
+	chunkSize := archive.Payload.ChunkSize
+	content := make([]byte, 0, length)
+	for i := offset / chunkSize; i*chunkSize < offset+length; i++ {
+		chunk, err := s.GetExportArchiveChunk(ctx, archive.UID, int(i))
+		if err != nil {
+			return nil, err
+		}
+		start := max(offset-i*chunkSize, 0)
+		end := min(offset+length-i*chunkSize, int64(len(chunk)))
+		content = append(content, chunk[start:end]...)
+	}
+	return content, nil
+}
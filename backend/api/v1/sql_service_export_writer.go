@@ -0,0 +1,564 @@
+package v1
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/decimal128"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet"
+	"github.com/apache/arrow/go/v15/parquet/compress"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"github.com/pkg/errors"
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// exportWriter encodes the query result to the export format page by page.
+// All the pages have the same columns, and the first page is written even if it has no rows.
+type exportWriter interface {
+	Write(result *v1pb.QueryResult) error
+	// Close writes the end of the export, and it doesn't close the underlying writer.
+	Close() error
+}
+
+// newExportWriter creates the export writer of the format, and the resource list is used by the SQL format.
+func newExportWriter(w io.Writer, format v1pb.ExportFormat, engine storepb.Engine, resourceList []base.SchemaResource) (exportWriter, error) {
+	switch format {
+	case v1pb.ExportFormat_CSV:
+		return &csvExportWriter{w: w}, nil
+	case v1pb.ExportFormat_JSON:
+		return &jsonExportWriter{w: w}, nil
+	case v1pb.ExportFormat_SQL:
+		return &sqlExportWriter{w: w, engine: engine, resourceList: resourceList}, nil
+	case v1pb.ExportFormat_XLSX:
+		return &xlsxExportWriter{w: w}, nil
+	case v1pb.ExportFormat_PARQUET:
+		return &parquetExportWriter{w: w, paged: true}, nil
+	case v1pb.ExportFormat_AVRO:
+		return &avroExportWriter{w: w, paged: true}, nil
+	case v1pb.ExportFormat_MARKDOWN:
+		return &markdownExportWriter{w: w}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", format.String())
+	}
+}
+
+// exportByWriter exports the whole result by the export writer.
+func exportByWriter(result *v1pb.QueryResult, newWriter func(io.Writer) exportWriter) ([]byte, error) {
+	var buf bytes.Buffer
+	w := newWriter(&buf)
+	if err := w.Write(result); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type csvExportWriter struct {
+	w       io.Writer
+	started bool
+	hasRows bool
+}
+
+func (e *csvExportWriter) Write(result *v1pb.QueryResult) error {
+	var buf bytes.Buffer
+	if !e.started {
+		e.started = true
+		buf.WriteString(strings.Join(result.ColumnNames, ","))
+		buf.WriteByte('\n')
+	}
+	for _, row := range result.Rows {
+		if e.hasRows {
+			buf.WriteByte('\n')
+		}
+		e.hasRows = true
+		for i, value := range row.Values {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			buf.Write(convertValueToBytesInCSV(value))
+		}
+	}
+	_, err := e.w.Write(buf.Bytes())
+	return err
+}
+
+func (*csvExportWriter) Close() error {
+	return nil
+}
+
+type jsonExportWriter struct {
+	w       io.Writer
+	hasRows bool
+}
+
+func (e *jsonExportWriter) Write(result *v1pb.QueryResult) error {
+	var buf bytes.Buffer
+	for _, row := range result.Rows {
+		m := make(map[string]any)
+		for i, value := range row.Values {
+			m[result.ColumnNames[i]] = convertValueToStringInJSON(value)
+		}
+		b, err := json.MarshalIndent(m, "  ", "  ")
+		if err != nil {
+			return err
+		}
+		if e.hasRows {
+			buf.WriteString(",\n  ")
+		} else {
+			buf.WriteString("[\n  ")
+		}
+		e.hasRows = true
+		buf.Write(b)
+	}
+	_, err := e.w.Write(buf.Bytes())
+	return err
+}
+
+func (e *jsonExportWriter) Close() error {
+	end := "\n]"
+	if !e.hasRows {
+		end = "[]"
+	}
+	_, err := io.WriteString(e.w, end)
+	return err
+}
+
+type sqlExportWriter struct {
+	w               io.Writer
+	engine          storepb.Engine
+	resourceList    []base.SchemaResource
+	statementPrefix string
+	hasRows         bool
+}
+
+func (e *sqlExportWriter) Write(result *v1pb.QueryResult) error {
+	if e.statementPrefix == "" {
+		statementPrefix, err := getSQLStatementPrefix(e.engine, e.resourceList, result.ColumnNames)
+		if err != nil {
+			return err
+		}
+		e.statementPrefix = statementPrefix
+	}
+	var buf bytes.Buffer
+	for _, row := range result.Rows {
+		if e.hasRows {
+			buf.WriteByte('\n')
+		}
+		e.hasRows = true
+		buf.WriteString(e.statementPrefix)
+		for i, value := range row.Values {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			buf.Write(convertValueToBytesInSQL(e.engine, value))
+		}
+		buf.WriteString(");")
+	}
+	_, err := e.w.Write(buf.Bytes())
+	return err
+}
+
+func (*sqlExportWriter) Close() error {
+	return nil
+}
+
+// xlsxMaxRows is the maximum number of rows in a sheet including the header.
+const xlsxMaxRows = 1048576
+
+type xlsxExportWriter struct {
+	w      io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	rows   int
+}
+
+func (e *xlsxExportWriter) Write(result *v1pb.QueryResult) error {
+	if e.file == nil {
+		e.file = excelize.NewFile()
+		stream, err := e.file.NewStreamWriter(sheet1Name)
+		if err != nil {
+			return err
+		}
+		e.stream = stream
+		if err := e.writeRow(result.ColumnNames); err != nil {
+			return err
+		}
+	}
+	for _, row := range result.Rows {
+		var values []string
+		for _, value := range row.Values {
+			values = append(values, convertValueToStringInXLSX(value))
+		}
+		if err := e.writeRow(values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *xlsxExportWriter) writeRow(values []string) error {
+	if e.rows >= xlsxMaxRows {
+		return errors.Errorf("the export exceeds the maximum %d rows of XLSX", xlsxMaxRows-1)
+	}
+	if len(values) > excelMaxColumn {
+		return errors.Errorf("the export exceeds the maximum %d columns of XLSX", excelMaxColumn)
+	}
+	e.rows++
+	var cells []any
+	for _, v := range values {
+		cells = append(cells, v)
+	}
+	cell, err := excelize.CoordinatesToCellName(1, e.rows)
+	if err != nil {
+		return err
+	}
+	return e.stream.SetRow(cell, cells)
+}
+
+func (e *xlsxExportWriter) Close() error {
+	if e.file == nil {
+		return nil
+	}
+	defer e.file.Close()
+	if err := e.stream.Flush(); err != nil {
+		return err
+	}
+	return e.file.Write(e.w)
+}
+
+type markdownExportWriter struct {
+	w       io.Writer
+	started bool
+}
+
+// Write writes the result as a Markdown table, and the NULL values are empty cells.
+func (e *markdownExportWriter) Write(result *v1pb.QueryResult) error {
+	var buf bytes.Buffer
+	writeRow := func(cells []string) {
+		buf.WriteString("|")
+		for _, cell := range cells {
+			buf.WriteString(" ")
+			buf.WriteString(escapeMarkdownCell(cell))
+			buf.WriteString(" |")
+		}
+		buf.WriteString("\n")
+	}
+	if !e.started {
+		e.started = true
+		writeRow(result.ColumnNames)
+		var separators []string
+		for range result.ColumnNames {
+			separators = append(separators, "---")
+		}
+		writeRow(separators)
+	}
+	for _, row := range result.Rows {
+		var cells []string
+		for i := range result.ColumnNames {
+			if i >= len(row.Values) || isNullRowValue(row.Values[i]) {
+				cells = append(cells, "")
+				continue
+			}
+			cells = append(cells, getExportString(row.Values[i]))
+		}
+		writeRow(cells)
+	}
+	_, err := e.w.Write(buf.Bytes())
+	return err
+}
+
+func (*markdownExportWriter) Close() error {
+	return nil
+}
+
+// parquetExportWriter writes each page as a row group, and the schema is inferred from the first page.
+// If the result is written in pages, the schema is widened by getPagedExportColumns for the following pages.
+type parquetExportWriter struct {
+	w       io.Writer
+	paged   bool
+	columns []*exportColumn
+	schema  *arrow.Schema
+	writer  *pqarrow.FileWriter
+}
+
+func (e *parquetExportWriter) Write(result *v1pb.QueryResult) error {
+	if e.writer == nil {
+		e.columns = getExportColumns(result)
+		if e.paged {
+			e.columns = getPagedExportColumns(result, e.columns)
+		}
+		var fields []arrow.Field
+		for _, column := range e.columns {
+			fields = append(fields, arrow.Field{Name: column.name, Type: getParquetType(column), Nullable: true})
+		}
+		e.schema = arrow.NewSchema(fields, nil)
+		// The parquet writer closes the underlying writer if it's a closer.
+		writer, err := pqarrow.NewFileWriter(e.schema, struct{ io.Writer }{e.w}, parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy)), pqarrow.DefaultWriterProps())
+		if err != nil {
+			return errors.Wrapf(err, "failed to create parquet writer")
+		}
+		e.writer = writer
+	}
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, e.schema)
+	defer builder.Release()
+	for _, row := range result.Rows {
+		for i, column := range e.columns {
+			fieldBuilder := builder.Field(i)
+			if i >= len(row.Values) || isNullRowValue(row.Values[i]) {
+				fieldBuilder.AppendNull()
+				continue
+			}
+			value := row.Values[i]
+			if !column.accepts(value) {
+				return errors.Errorf("value %q of column %q does not match the type inferred from the first page", getExportString(value), column.name)
+			}
+			switch column.tp {
+			case exportColumnTypeBool:
+				fieldBuilder.(*array.BooleanBuilder).Append(getExportBool(value))
+			case exportColumnTypeInt64:
+				fieldBuilder.(*array.Int64Builder).Append(getExportInt64(value))
+			case exportColumnTypeFloat:
+				fieldBuilder.(*array.Float32Builder).Append(float32(getExportFloat64(value)))
+			case exportColumnTypeDouble:
+				fieldBuilder.(*array.Float64Builder).Append(getExportFloat64(value))
+			case exportColumnTypeDecimal:
+				v, err := decimal128.FromString(getExportDecimalString(value), column.precision, column.scale)
+				if err != nil {
+					return errors.Wrapf(err, "failed to convert decimal of column %q", column.name)
+				}
+				fieldBuilder.(*array.Decimal128Builder).Append(v)
+			case exportColumnTypeTimestamp:
+				fieldBuilder.(*array.TimestampBuilder).Append(arrow.Timestamp(getExportTimestamp(value).UnixMicro()))
+			case exportColumnTypeDate:
+				fieldBuilder.(*array.Date32Builder).Append(arrow.Date32FromTime(getExportDate(value)))
+			case exportColumnTypeBytes:
+				fieldBuilder.(*array.BinaryBuilder).Append(value.GetBytesValue())
+			default:
+				fieldBuilder.(*array.StringBuilder).Append(getExportString(value))
+			}
+		}
+	}
+	record := builder.NewRecord()
+	defer record.Release()
+	if err := e.writer.Write(record); err != nil {
+		return errors.Wrapf(err, "failed to write parquet")
+	}
+	return nil
+}
+
+func (e *parquetExportWriter) Close() error {
+	if e.writer == nil {
+		return nil
+	}
+	if err := e.writer.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close parquet writer")
+	}
+	return nil
+}
+
+func getParquetType(column *exportColumn) arrow.DataType {
+	switch column.tp {
+	case exportColumnTypeBool:
+		return arrow.FixedWidthTypes.Boolean
+	case exportColumnTypeInt64:
+		return arrow.PrimitiveTypes.Int64
+	case exportColumnTypeFloat:
+		return arrow.PrimitiveTypes.Float32
+	case exportColumnTypeDouble:
+		return arrow.PrimitiveTypes.Float64
+	case exportColumnTypeDecimal:
+		return &arrow.Decimal128Type{Precision: column.precision, Scale: column.scale}
+	case exportColumnTypeTimestamp:
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}
+	case exportColumnTypeDate:
+		return arrow.FixedWidthTypes.Date32
+	case exportColumnTypeBytes:
+		return arrow.BinaryTypes.Binary
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+const (
+	// avroBlockRows is the number of rows in a block of the Avro object container file.
+	avroBlockRows = 1000
+)
+
+var avroInvalidNameCharRE = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// avroExportWriter writes the Avro object container file compressed with deflate, and the schema is inferred from the first page.
+// The column names are converted to the valid Avro names, and the original names are kept in the docs.
+// The typed fields are unions with string, and the values not matching the types of the fields are written as strings.
+type avroExportWriter struct {
+	w       io.Writer
+	paged   bool
+	columns []*exportColumn
+	sync    []byte
+}
+
+func (e *avroExportWriter) Write(result *v1pb.QueryResult) error {
+	if e.columns == nil {
+		e.columns = getExportColumns(result)
+		if e.paged {
+			e.columns = getPagedExportColumns(result, e.columns)
+		}
+		schema, err := getAvroSchema(e.columns)
+		if err != nil {
+			return err
+		}
+		e.sync = make([]byte, 16)
+		if _, err := rand.Read(e.sync); err != nil {
+			return errors.Wrapf(err, "failed to generate sync marker")
+		}
+		// The file metadata is a map with a single block.
+		header := []byte("Obj\x01")
+		header = binary.AppendVarint(header, 2)
+		header = appendAvroString(header, "avro.schema")
+		header = appendAvroBytes(header, schema)
+		header = appendAvroString(header, "avro.codec")
+		header = appendAvroBytes(header, []byte("deflate"))
+		header = binary.AppendVarint(header, 0)
+		header = append(header, e.sync...)
+		if _, err := e.w.Write(header); err != nil {
+			return err
+		}
+	}
+
+	for start := 0; start < len(result.Rows); start += avroBlockRows {
+		end := min(start+avroBlockRows, len(result.Rows))
+		var data []byte
+		for _, row := range result.Rows[start:end] {
+			for i, column := range e.columns {
+				if i >= len(row.Values) || isNullRowValue(row.Values[i]) {
+					data = binary.AppendVarint(data, 0)
+					continue
+				}
+				if column.tp != exportColumnTypeString && !column.accepts(row.Values[i]) {
+					data = binary.AppendVarint(data, 2)
+					data = appendAvroString(data, getExportString(row.Values[i]))
+					continue
+				}
+				data = binary.AppendVarint(data, 1)
+				data = appendAvroValue(data, column, row.Values[i])
+			}
+		}
+		var compressed bytes.Buffer
+		w, err := flate.NewWriter(&compressed, flate.DefaultCompression)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create deflate writer")
+		}
+		if _, err := w.Write(data); err != nil {
+			return errors.Wrapf(err, "failed to compress avro block")
+		}
+		if err := w.Close(); err != nil {
+			return errors.Wrapf(err, "failed to compress avro block")
+		}
+		var block []byte
+		block = binary.AppendVarint(block, int64(end-start))
+		block = binary.AppendVarint(block, int64(compressed.Len()))
+		block = append(block, compressed.Bytes()...)
+		block = append(block, e.sync...)
+		if _, err := e.w.Write(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*avroExportWriter) Close() error {
+	return nil
+}
+
+func getAvroSchema(columns []*exportColumn) ([]byte, error) {
+	var fields []map[string]any
+	names := map[string]bool{}
+	for i, column := range columns {
+		name := avroInvalidNameCharRE.ReplaceAllString(column.name, "_")
+		if name == "" || (name[0] >= '0' && name[0] <= '9') {
+			name = "_" + name
+		}
+		for names[name] {
+			name = fmt.Sprintf("%s_%d", name, i)
+		}
+		names[name] = true
+
+		var tp any
+		switch column.tp {
+		case exportColumnTypeBool:
+			tp = "boolean"
+		case exportColumnTypeInt64:
+			tp = "long"
+		case exportColumnTypeFloat:
+			tp = "float"
+		case exportColumnTypeDouble:
+			tp = "double"
+		case exportColumnTypeDecimal:
+			tp = map[string]any{"type": "bytes", "logicalType": "decimal", "precision": column.precision, "scale": column.scale}
+		case exportColumnTypeTimestamp:
+			tp = map[string]any{"type": "long", "logicalType": "timestamp-micros"}
+		case exportColumnTypeDate:
+			tp = map[string]any{"type": "int", "logicalType": "date"}
+		case exportColumnTypeBytes:
+			tp = "bytes"
+		default:
+			tp = "string"
+		}
+		types := []any{"null", tp}
+		if column.tp != exportColumnTypeString {
+			types = append(types, "string")
+		}
+		field := map[string]any{"name": name, "type": types, "default": nil}
+		if name != column.name {
+			field["doc"] = column.name
+		}
+		fields = append(fields, field)
+	}
+	return json.Marshal(map[string]any{"type": "record", "name": "export", "fields": fields})
+}
+
+// appendAvroValue appends the value accepted by the column.
+func appendAvroValue(data []byte, column *exportColumn, value *v1pb.RowValue) []byte {
+	switch column.tp {
+	case exportColumnTypeBool:
+		if getExportBool(value) {
+			return append(data, 1)
+		}
+		return append(data, 0)
+	case exportColumnTypeInt64:
+		return binary.AppendVarint(data, getExportInt64(value))
+	case exportColumnTypeFloat:
+		return binary.LittleEndian.AppendUint32(data, math.Float32bits(float32(getExportFloat64(value))))
+	case exportColumnTypeDouble:
+		return binary.LittleEndian.AppendUint64(data, math.Float64bits(getExportFloat64(value)))
+	case exportColumnTypeDecimal:
+		return appendAvroBytes(data, getTwosComplement(getExportUnscaledDecimal(getExportDecimalString(value), column.scale)))
+	case exportColumnTypeTimestamp:
+		return binary.AppendVarint(data, getExportTimestamp(value).UnixMicro())
+	case exportColumnTypeDate:
+		return binary.AppendVarint(data, int64(arrow.Date32FromTime(getExportDate(value))))
+	case exportColumnTypeBytes:
+		return appendAvroBytes(data, value.GetBytesValue())
+	default:
+		return appendAvroString(data, getExportString(value))
+	}
+}
@@ -0,0 +1,213 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	pgquery "github.com/pganalyze/pg_query_go/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// maxQueryFingerprintLength is the maximum number of characters of the fingerprint kept in the query history.
+	// The longer fingerprint is truncated with its digest appended, so that it can be indexed.
+	maxQueryFingerprintLength = 512
+	// defaultQueryHistoryAggregateLimit is the default number of the aggregates returned.
+	defaultQueryHistoryAggregateLimit = 100
+	// maxQueryHistoryAggregateLimit is the maximum number of the aggregates returned.
+	maxQueryHistoryAggregateLimit = 1000
+)
+
+var (
+	// stringLiteralRegexp matches the quoted string literals.
+	stringLiteralRegexp = regexp.MustCompile(`'(?:[^'\\]|''|\\.)*'`)
+	// numberLiteralRegexp matches the numeric literals.
+	numberLiteralRegexp = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+	whitespaceRegexp    = regexp.MustCompile(`\s+`)
+)
+
+// getQueryFingerprint returns the normalized template of the statement, the literals are replaced by placeholders.
+// The statements of the engines without fingerprinting parsers are normalized by replacing the string and numeric literals.
+func getQueryFingerprint(engine storepb.Engine, statement string) string {
+	var fingerprint string
+	var err error
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		fingerprint, err = mysqlparser.GetFingerprint(statement)
+	case storepb.Engine_POSTGRES:
+		fingerprint, err = pgquery.Normalize(statement)
+	default:
+		err = errors.Errorf("fingerprint is not supported for %s", engine.String())
+	}
+	if err != nil {
+		fingerprint = stringLiteralRegexp.ReplaceAllString(statement, "?")
+		fingerprint = numberLiteralRegexp.ReplaceAllString(fingerprint, "?")
+	}
+	fingerprint = strings.TrimRight(whitespaceRegexp.ReplaceAllString(strings.TrimSpace(fingerprint), " "), "; ")
+
+	if truncated, ok := common.TruncateString(fingerprint, maxQueryFingerprintLength); ok {
+		digest := sha256.Sum256([]byte(fingerprint))
+		fingerprint = fmt.Sprintf("%s /* %s */", truncated, hex.EncodeToString(digest[:8]))
+	}
+	return fingerprint
+}
+
+// getQueryTables returns the tables read by the query in the ascending order.
+func getQueryTables(spans []*base.QuerySpan) []string {
+	var tables []string
+	for _, span := range spans {
+		for column := range span.SourceColumns {
+			tables = append(tables, base.ColumnResource{
+				Server:   column.Server,
+				Database: column.Database,
+				Schema:   column.Schema,
+				Table:    column.Table,
+			}.String())
+		}
+	}
+	slices.Sort(tables)
+	return slices.Compact(tables)
+}
+
+// parseQueryHistoryFilter parses the filter of the query histories into the find message.
+// The user filter is allowed only if allowUser is true.
+func (s *SQLService) parseQueryHistoryFilter(ctx context.Context, filter string, allowUser bool, find *store.FindQueryHistoryMessage) error {
+	filters, err := parseFilter(filter)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	for _, spec := range filters {
+		if spec.key == "create_time" {
+			t, err := time.Parse(time.RFC3339, spec.value)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid create_time %q: %v", spec.value, err)
+			}
+			ts := t.Unix()
+			switch spec.operator {
+			case comparatorTypeGreaterEqual:
+				find.CreatedTsAfter = &ts
+			case comparatorTypeLessEqual:
+				find.CreatedTsBefore = &ts
+			default:
+				return status.Errorf(codes.InvalidArgument, `only support ">=" and "<=" operation for "create_time" filter`)
+			}
+			continue
+		}
+		if spec.operator != comparatorTypeEqual {
+			return status.Errorf(codes.InvalidArgument, `only support "=" operation for "%v" filter`, spec.key)
+		}
+		switch spec.key {
+		case "database":
+			database := spec.value
+			find.Database = &database
+		case "instance":
+			instance := spec.value
+			find.Instance = &instance
+		case "type":
+			historyType := store.QueryHistoryType(spec.value)
+			find.Type = &historyType
+		case "statement":
+			statement := spec.value
+			find.Statement = &statement
+		case "fingerprint":
+			fingerprint := spec.value
+			find.Fingerprint = &fingerprint
+		case "user":
+			if !allowUser {
+				return status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
+			}
+			email, err := common.GetUserEmail(spec.value)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			user, err := s.store.GetUserByEmail(ctx, email)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to get user %q: %v", email, err)
+			}
+			if user == nil {
+				return status.Errorf(codes.NotFound, "user %q not found", email)
+			}
+			find.CreatorUID = &user.ID
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
+		}
+	}
+	return nil
+}
+
+// AggregateQueryHistories aggregates the query histories of all the users in the project by the fingerprints of the statements.
+func (s *SQLService) AggregateQueryHistories(ctx context.Context, request *v1pb.AggregateQueryHistoriesRequest) (*v1pb.AggregateQueryHistoriesResponse, error) {
+	projectID, err := common.GetProjectID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	limit := int(request.PageSize)
+	if limit <= 0 {
+		limit = defaultQueryHistoryAggregateLimit
+	}
+	if limit > maxQueryHistoryAggregateLimit {
+		limit = maxQueryHistoryAggregateLimit
+	}
+	find := &store.FindQueryHistoryMessage{
+		ProjectID: &projectID,
+		Limit:     &limit,
+	}
+	if err := s.parseQueryHistoryFilter(ctx, request.Filter, true /* allowUser */, find); err != nil {
+		return nil, err
+	}
+
+	aggregates, err := s.store.AggregateQueryHistories(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to aggregate query histories: %v", err)
+	}
+	response := &v1pb.AggregateQueryHistoriesResponse{}
+	users := map[int]string{}
+	for _, aggregate := range aggregates {
+		v := &v1pb.QueryHistoryAggregate{
+			Fingerprint:     aggregate.Fingerprint,
+			SampleStatement: aggregate.SampleStatement,
+			Count:           aggregate.Count,
+			P50Duration:     durationpb.New(aggregate.P50Duration),
+			P95Duration:     durationpb.New(aggregate.P95Duration),
+			Tables:          aggregate.Tables,
+			LastQueryTime:   timestamppb.New(aggregate.LastQueryTime),
+		}
+		if aggregate.Count > 0 {
+			v.ErrorRate = float64(aggregate.ErrorCount) / float64(aggregate.Count)
+		}
+		for _, uid := range aggregate.CreatorUIDs {
+			if _, ok := users[uid]; !ok {
+				user, err := s.store.GetUserByID(ctx, uid)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get user %d: %v", uid, err)
+				}
+				if user == nil {
+					continue
+				}
+				users[uid] = common.FormatUserEmail(user.Email)
+			}
+			v.Users = append(v.Users, users[uid])
+		}
+		slices.Sort(v.Users)
+		response.Aggregates = append(response.Aggregates, v)
+	}
+	return response, nil
+}
@@ -0,0 +1,484 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// editorSessionIdleTimeout is the timeout to roll back and close the editor session without executing.
+	editorSessionIdleTimeout = 10 * time.Minute
+	// editorSessionHeartbeatTimeout is the timeout to roll back and close the editor session whose client is disconnected,
+	// which sends neither heartbeats nor executions.
+	editorSessionHeartbeatTimeout = time.Minute
+	// editorSessionRollbackTimeout is the timeout to roll back the open transaction when the session is closed.
+	editorSessionRollbackTimeout = 10 * time.Second
+	// maxEditorSessionsPerUser is the maximum number of the editor sessions held for a user.
+	maxEditorSessionsPerUser = 10
+)
+
+var (
+	// sessionVariableNameRegexp matches the names of the session variables, e.g. time_zone, @@sql_mode, statement_timeout.
+	sessionVariableNameRegexp = regexp.MustCompile(`^@{0,2}[A-Za-z_][A-Za-z0-9_.$]*$`)
+	// setSessionVariableRegexp matches the statements setting a session variable, e.g. SET time_zone = 'UTC', SET SESSION sql_mode = '', SET search_path TO public.
+	setSessionVariableRegexp = regexp.MustCompile(`(?is)^SET\s+(?:SESSION\s+)?(@{0,2}[A-Za-z_][A-Za-z0-9_.$]*)\s*(?:=|\sTO\s)\s*(.+?)[\s;]*$`)
+)
+
+// editorSession is the connection pinned to an editor tab, which holds the transaction across the requests.
+type editorSession struct {
+	id         string
+	tab        string
+	creator    *store.UserMessage
+	instance   *store.InstanceMessage
+	database   *store.DatabaseMessage
+	createTime time.Time
+
+	// mu serializes the executions and the close of the session.
+	mu         sync.Mutex
+	driver     db.Driver
+	conn       *sql.Conn
+	timer      *time.Timer
+	expireTime time.Time
+	// heartbeatExpireTime is the time the client is considered disconnected without a heartbeat.
+	heartbeatExpireTime time.Time
+	variables           map[string]string
+	// transactionStartTime is nil if there is no open transaction.
+	transactionStartTime *time.Time
+}
+
+// resetTimer extends the deadlines of the session by the activity of the client and resets the timer to the earlier one.
+// The idle deadline is extended only if the session is used.
+func (es *editorSession) resetTimer(used bool) {
+	now := time.Now()
+	if used {
+		es.expireTime = now.Add(editorSessionIdleTimeout)
+	}
+	es.heartbeatExpireTime = now.Add(editorSessionHeartbeatTimeout)
+	es.timer.Reset(time.Until(es.getDeadline()))
+}
+
+// getDeadline returns the time the session is closed without the activity of the client.
+func (es *editorSession) getDeadline() time.Time {
+	if es.heartbeatExpireTime.Before(es.expireTime) {
+		return es.heartbeatExpireTime
+	}
+	return es.expireTime
+}
+
+func (es *editorSession) name() string {
+	return fmt.Sprintf("%s/%s%s", common.FormatDatabase(es.instance.ResourceID, es.database.DatabaseName), common.EditorSessionPrefix, es.id)
+}
+
+// editorSessions holds the editor sessions.
+type editorSessions struct {
+	sync.Mutex
+	sessions map[string]*editorSession
+}
+
+func newEditorSessions() *editorSessions {
+	return &editorSessions{sessions: map[string]*editorSession{}}
+}
+
+func (m *editorSessions) add(es *editorSession) error {
+	m.Lock()
+	defer m.Unlock()
+	count := 0
+	for _, v := range m.sessions {
+		if v.creator.ID != es.creator.ID {
+			continue
+		}
+		if v.tab == es.tab {
+			return status.Errorf(codes.AlreadyExists, "editor session %q already exists for the tab", v.name())
+		}
+		count++
+	}
+	if count >= maxEditorSessionsPerUser {
+		return status.Errorf(codes.ResourceExhausted, "too many editor sessions, close them first")
+	}
+	m.sessions[es.id] = es
+	return nil
+}
+
+func (m *editorSessions) get(id string) *editorSession {
+	m.Lock()
+	defer m.Unlock()
+	return m.sessions[id]
+}
+
+func (m *editorSessions) remove(id string) {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.sessions, id)
+}
+
+// CreateEditorSession pins a connection to the editor tab.
+func (s *SQLService) CreateEditorSession(ctx context.Context, request *v1pb.CreateEditorSessionRequest) (*v1pb.EditorSession, error) {
+	if request.Tab == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "tab is required")
+	}
+	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Parent, "")
+	if err != nil {
+		return nil, err
+	}
+	switch instance.Engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_POSTGRES:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "editor session is not supported for %s", instance.Engine.String())
+	}
+	for name := range request.Variables {
+		if !sessionVariableNameRegexp.MatchString(name) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid session variable name %q", name)
+		}
+	}
+
+	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+	}
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		driver.Close(ctx)
+		return nil, status.Errorf(codes.Internal, "failed to get database connection: %v", err)
+	}
+	es := &editorSession{
+		id:         uuid.NewString(),
+		tab:        request.Tab,
+		creator:    user,
+		instance:   instance,
+		database:   database,
+		createTime: time.Now(),
+		driver:     driver,
+		conn:       conn,
+		expireTime: time.Now().Add(editorSessionIdleTimeout),
+		variables:  map[string]string{},
+
+		heartbeatExpireTime: time.Now().Add(editorSessionHeartbeatTimeout),
+	}
+	for name, value := range request.Variables {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET %s = %s", name, value)); err != nil {
+			_ = conn.Close()
+			driver.Close(ctx)
+			return nil, status.Errorf(codes.InvalidArgument, "failed to set session variable %q: %v", name, err)
+		}
+		es.variables[name] = value
+	}
+	es.timer = time.AfterFunc(time.Until(es.getDeadline()), func() {
+		s.closeExpiredEditorSession(es)
+	})
+	if err := s.editorSessions.add(es); err != nil {
+		es.timer.Stop()
+		_ = conn.Close()
+		driver.Close(ctx)
+		return nil, err
+	}
+	return convertToEditorSession(es), nil
+}
+
+// GetEditorSession gets the editor session.
+func (s *SQLService) GetEditorSession(ctx context.Context, request *v1pb.GetEditorSessionRequest) (*v1pb.EditorSession, error) {
+	es, err := s.getEditorSession(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if es.conn == nil {
+		return nil, status.Errorf(codes.NotFound, "editor session %q not found or expired", request.Name)
+	}
+	return convertToEditorSession(es), nil
+}
+
+// ExecuteEditorSession executes the statements on the connection of the session one by one until a statement fails.
+func (s *SQLService) ExecuteEditorSession(ctx context.Context, request *v1pb.ExecuteEditorSessionRequest) (*v1pb.ExecuteEditorSessionResponse, error) {
+	es, err := s.getEditorSession(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	list, err := base.SplitMultiSQL(es.instance.Engine, request.Statement)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to split statement: %v", err)
+	}
+
+	es.mu.Lock()
+	if es.conn == nil {
+		es.mu.Unlock()
+		return nil, status.Errorf(codes.NotFound, "editor session %q not found or expired", request.Name)
+	}
+	// The session is not closed by the timeouts while executing.
+	es.timer.Stop()
+	timeout := defaultTimeout
+	if request.Timeout != nil {
+		timeout = request.Timeout.AsDuration()
+	}
+	executeCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	start := time.Now()
+	var results []*v1pb.QueryResult
+	var queryErr error
+	for _, singleSQL := range base.FilterEmptySQL(list) {
+		result, err := es.driver.RunStatement(executeCtx, es.conn, singleSQL.Text)
+		if err == nil && executeCtx.Err() != nil {
+			err = errors.Errorf("timeout reached: %v", timeout)
+		}
+		if err == nil {
+			for _, r := range result {
+				if r.Error != "" {
+					err = errors.New(r.Error)
+					break
+				}
+			}
+		}
+		if err != nil {
+			queryErr = err
+			results = append(results, &v1pb.QueryResult{
+				Error:     err.Error(),
+				Statement: singleSQL.Text,
+			})
+			break
+		}
+		sanitizeResults(result)
+		results = append(results, result...)
+		es.applyStatement(singleSQL.Text)
+	}
+	durationNs := time.Since(start).Nanoseconds()
+	disconnected := queryErr != nil && (errors.Is(queryErr, sqldriver.ErrBadConn) || errors.Is(queryErr, sql.ErrConnDone))
+	if !disconnected {
+		es.resetTimer(true /* used */)
+	}
+	session := convertToEditorSession(es)
+	es.mu.Unlock()
+
+	if disconnected {
+		s.closeEditorSession(es, status.Errorf(codes.Unavailable, "editor session is disconnected: %v", queryErr))
+		return nil, status.Errorf(codes.Unavailable, "editor session %q is disconnected: %v", request.Name, queryErr)
+	}
+	if err := s.postQuery(ctx, es.instance, es.database, request.Statement, es.creator.ID, durationNs, queryErr, nil /* spans */, nil /* costGuard */); err != nil {
+		slog.Error("failed to post editor session execute activity", log.BBError(err))
+	}
+	return &v1pb.ExecuteEditorSessionResponse{
+		Results: results,
+		Session: session,
+	}, nil
+}
+
+// HeartbeatEditorSession extends the heartbeat deadline of the session, without which the session is closed as disconnected.
+func (s *SQLService) HeartbeatEditorSession(ctx context.Context, request *v1pb.HeartbeatEditorSessionRequest) (*v1pb.EditorSession, error) {
+	es, err := s.getEditorSession(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if es.conn == nil {
+		return nil, status.Errorf(codes.NotFound, "editor session %q not found or expired", request.Name)
+	}
+	es.resetTimer(false /* used */)
+	return convertToEditorSession(es), nil
+}
+
+// DeleteEditorSession rolls back the open transaction of the session and closes its connection.
+func (s *SQLService) DeleteEditorSession(ctx context.Context, request *v1pb.DeleteEditorSessionRequest) (*emptypb.Empty, error) {
+	es, err := s.getEditorSession(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	s.closeEditorSession(es, nil)
+	return &emptypb.Empty{}, nil
+}
+
+// getEditorSession gets the editor session of the name, which can only be used by the creator.
+func (s *SQLService) getEditorSession(ctx context.Context, name string) (*editorSession, error) {
+	instanceID, databaseName, id, err := common.GetInstanceDatabaseIDEditorSession(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	es := s.editorSessions.get(id)
+	if es == nil || es.creator.ID != user.ID || es.instance.ResourceID != instanceID || es.database.DatabaseName != databaseName {
+		return nil, status.Errorf(codes.NotFound, "editor session %q not found or expired", name)
+	}
+	return es, nil
+}
+
+// closeEditorSession rolls back the open transaction of the session and closes its connection.
+// The reason is nil if the session is closed by the creator, otherwise the close is audited.
+func (s *SQLService) closeEditorSession(es *editorSession, reason error) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	s.closeEditorSessionLocked(es, reason)
+}
+
+// closeExpiredEditorSession closes the session by the timer if it's idle or its client is disconnected.
+// The deadlines are checked again because they may be extended by an execution holding the lock when the timer fires.
+func (s *SQLService) closeExpiredEditorSession(es *editorSession) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	now := time.Now()
+	switch {
+	case !now.Before(es.expireTime):
+		s.closeEditorSessionLocked(es, status.Errorf(codes.DeadlineExceeded, "editor session is idle for %v", editorSessionIdleTimeout))
+	case !now.Before(es.heartbeatExpireTime):
+		s.closeEditorSessionLocked(es, status.Errorf(codes.Unavailable, "editor session client is disconnected without heartbeat for %v", editorSessionHeartbeatTimeout))
+	}
+}
+
+// closeEditorSessionLocked closes the session with es.mu held.
+func (s *SQLService) closeEditorSessionLocked(es *editorSession, reason error) {
+	s.editorSessions.remove(es.id)
+	if es.conn == nil {
+		return
+	}
+	session := convertToEditorSession(es)
+	es.timer.Stop()
+	if es.transactionStartTime != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), editorSessionRollbackTimeout)
+		if _, err := es.conn.ExecContext(ctx, "ROLLBACK"); err != nil {
+			slog.Warn("failed to roll back editor session", slog.String("session", session.Name), log.BBError(err))
+		}
+		cancel()
+	}
+	if err := es.conn.Close(); err != nil {
+		slog.Warn("failed to close connection", log.BBError(err))
+	}
+	es.driver.Close(context.Background())
+	es.conn = nil
+
+	if reason != nil {
+		if err := s.auditEditorSessionClose(context.Background(), es, session, reason); err != nil {
+			slog.Warn("failed to audit editor session close", slog.String("session", session.Name), log.BBError(err))
+		}
+	}
+}
+
+func (s *SQLService) auditEditorSessionClose(ctx context.Context, es *editorSession, session *v1pb.EditorSession, reason error) error {
+	request, err := protojson.Marshal(session)
+	if err != nil {
+		return err
+	}
+	return s.store.CreateAuditLog(ctx, &storepb.AuditLog{
+		Parent:   common.FormatProject(es.database.ProjectID),
+		Method:   store.AuditLogMethodEditorSessionClose.String(),
+		Resource: session.Name,
+		User:     common.FormatUserUID(es.creator.ID),
+		Severity: storepb.AuditLog_WARNING,
+		Request:  string(request),
+		Response: "",
+		Status:   status.Convert(reason).Proto(),
+	})
+}
+
+// applyStatement updates the transaction state and the session variables by the statement executed successfully.
+func (es *editorSession) applyStatement(statement string) {
+	switch getTransactionAction(es.instance.Engine, statement) {
+	case transactionActionBegin:
+		if es.transactionStartTime == nil {
+			now := time.Now()
+			es.transactionStartTime = &now
+		}
+	case transactionActionEnd:
+		es.transactionStartTime = nil
+	}
+	if name, value, ok := getSessionVariable(es.instance.Engine, statement); ok {
+		es.variables[name] = value
+	}
+}
+
+type transactionAction int
+
+const (
+	transactionActionNone transactionAction = iota
+	transactionActionBegin
+	transactionActionEnd
+)
+
+// getTransactionAction returns how the statement changes the transaction state of the session.
+func getTransactionAction(engine storepb.Engine, statement string) transactionAction {
+	fields := strings.Fields(strings.ToUpper(strings.TrimRight(strings.TrimSpace(statement), ";")))
+	if len(fields) == 0 {
+		return transactionActionNone
+	}
+	switch fields[0] {
+	case "BEGIN":
+		return transactionActionBegin
+	case "START":
+		if len(fields) > 1 && fields[1] == "TRANSACTION" {
+			return transactionActionBegin
+		}
+	case "COMMIT", "END", "ROLLBACK", "ABORT":
+		// ROLLBACK TO SAVEPOINT and COMMIT AND CHAIN keep the transaction open.
+		if slices.Contains(fields, "TO") || (slices.Contains(fields, "CHAIN") && !slices.Contains(fields, "NO")) {
+			return transactionActionNone
+		}
+		return transactionActionEnd
+	case "CREATE", "ALTER", "DROP", "RENAME", "TRUNCATE", "GRANT", "REVOKE":
+		// The DDL statements commit the transaction implicitly in MySQL.
+		switch engine {
+		case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB:
+			return transactionActionEnd
+		}
+	}
+	return transactionActionNone
+}
+
+// getSessionVariable returns the session variable set by the statement.
+func getSessionVariable(engine storepb.Engine, statement string) (string, string, bool) {
+	matches := setSessionVariableRegexp.FindStringSubmatch(strings.TrimSpace(statement))
+	if matches == nil {
+		return "", "", false
+	}
+	name, value := matches[1], matches[2]
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB:
+		// The statement sets multiple variables, e.g. SET a = 1, b = 2.
+		if strings.Contains(value, ",") {
+			return "", "", false
+		}
+	}
+	return name, value, true
+}
+
+func convertToEditorSession(es *editorSession) *v1pb.EditorSession {
+	session := &v1pb.EditorSession{
+		Name:          es.name(),
+		Tab:           es.tab,
+		Creator:       common.FormatUserEmail(es.creator.Email),
+		CreateTime:    timestamppb.New(es.createTime),
+		ExpireTime:    timestamppb.New(es.expireTime),
+		Variables:     map[string]string{},
+		InTransaction: es.transactionStartTime != nil,
+	}
+	for name, value := range es.variables {
+		session.Variables[name] = value
+	}
+	if es.transactionStartTime != nil {
+		session.TransactionStartTime = timestamppb.New(*es.transactionStartTime)
+	}
+	return session
+}
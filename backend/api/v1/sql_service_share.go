@@ -0,0 +1,159 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// defaultQueryResultShareTTL is the default time-to-live of the shared query results.
+	defaultQueryResultShareTTL = 24 * time.Hour
+	// maxQueryResultShareTTL is the maximum time-to-live of the shared query results.
+	maxQueryResultShareTTL = 30 * 24 * time.Hour
+	// maxQueryResultShareSize is the maximum size in bytes of the shared query results.
+	maxQueryResultShareSize = 10 * 1024 * 1024
+)
+
+// CreateQueryResultShare runs the query and saves the masked result as a snapshot which can be viewed until it expires.
+func (s *SQLService) CreateQueryResultShare(ctx context.Context, request *v1pb.CreateQueryResultShareRequest) (*v1pb.QueryResultShare, error) {
+	ttl := defaultQueryResultShareTTL
+	if request.Ttl != nil {
+		ttl = request.Ttl.AsDuration()
+	}
+	if ttl <= 0 || ttl > maxQueryResultShareTTL {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl must be in (0, %v]", maxQueryResultShareTTL)
+	}
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	queryRequest := &v1pb.QueryRequest{
+		Name:         request.Parent,
+		Statement:    request.Statement,
+		Limit:        request.Limit,
+		DataSourceId: request.DataSourceId,
+	}
+	response, err := s.query(ctx, queryRequest, true /* isShare */)
+	if err != nil {
+		return nil, err
+	}
+	// The query rewrites the statement with the row filters of the creator, which is compared with the ones of the viewers.
+	var rowFilterStatement string
+	if queryRequest.Statement != request.Statement {
+		rowFilterStatement = queryRequest.Statement
+	}
+	results, err := proto.Marshal(&v1pb.QueryResponse{Results: response.Results})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal query results: %v", err)
+	}
+	if len(results) > maxQueryResultShareSize {
+		return nil, status.Errorf(codes.InvalidArgument, "query results of %d bytes exceed the limit %d bytes of sharing, lower the limit of rows", len(results), maxQueryResultShareSize)
+	}
+
+	share, err := s.store.CreateQueryResultShare(ctx, &store.QueryResultShareMessage{
+		ResourceID: uuid.NewString(),
+		CreatorUID: user.ID,
+		Database:   request.Parent,
+		Statement:  request.Statement,
+		ExpireTime: time.Now().Add(ttl),
+		Results:    results,
+
+		RowFilterStatement: rowFilterStatement,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create query result share: %v", err)
+	}
+	return convertToQueryResultShare(share, user, response.Results), nil
+}
+
+// GetQueryResultShare gets the snapshot of the shared query result.
+// The viewer must be able to query the data read by the statement of the snapshot,
+// and the rows of the snapshot must be filtered by the same row filters as the viewer.
+func (s *SQLService) GetQueryResultShare(ctx context.Context, request *v1pb.GetQueryResultShareRequest) (*v1pb.QueryResultShare, error) {
+	instanceID, databaseName, shareID, err := common.GetInstanceDatabaseIDQueryResultShare(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	share, err := s.store.GetQueryResultShare(ctx, shareID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if share == nil || share.Database != common.FormatDatabase(instanceID, databaseName) {
+		return nil, status.Errorf(codes.NotFound, "query result share %q not found or expired", request.Name)
+	}
+
+	user, instance, database, err := s.prepareRelatedMessage(ctx, share.Database, "")
+	if err != nil {
+		return nil, err
+	}
+	if s.licenseService.IsFeatureEnabled(api.FeatureAccessControl) == nil {
+		spans, err := base.GetQuerySpan(
+			ctx,
+			base.GetQuerySpanContext{
+				GetDatabaseMetadataFunc:       BuildGetDatabaseMetadataFunc(s.store, instance),
+				ListDatabaseNamesFunc:         BuildListDatabaseNamesFunc(s.store, instance),
+				GetLinkedDatabaseMetadataFunc: BuildGetLinkedDatabaseMetadataFunc(s.store, instance),
+			},
+			instance.Engine,
+			share.Statement,
+			database.DatabaseName,
+			"",
+			store.IgnoreDatabaseAndTableCaseSensitive(instance),
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get query span: %v", err.Error())
+		}
+		if err := s.accessCheck(ctx, instance, user, spans, 0 /* limit */, false /* isAdmin */, false /* isExport */); err != nil {
+			return nil, err
+		}
+		viewerStatement, err := rewriteRowFilter(ctx, s.store, s.dbFactory, instance, database, user, "", share.Statement, spans)
+		if err != nil {
+			return nil, err
+		}
+		var viewerRowFilterStatement string
+		if viewerStatement != share.Statement {
+			viewerRowFilterStatement = viewerStatement
+		}
+		if viewerRowFilterStatement != share.RowFilterStatement {
+			return nil, status.Errorf(codes.PermissionDenied, "the row filters of the viewer differ from the ones of the creator of query result share %q", request.Name)
+		}
+	}
+
+	creator, err := s.store.GetUserByID(ctx, share.CreatorUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get creator: %v", err)
+	}
+	if creator == nil {
+		return nil, status.Errorf(codes.NotFound, "creator %d not found", share.CreatorUID)
+	}
+	response := &v1pb.QueryResponse{}
+	if err := proto.Unmarshal(share.Results, response); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal query results: %v", err)
+	}
+	return convertToQueryResultShare(share, creator, response.Results), nil
+}
+
+func convertToQueryResultShare(share *store.QueryResultShareMessage, creator *store.UserMessage, results []*v1pb.QueryResult) *v1pb.QueryResultShare {
+	return &v1pb.QueryResultShare{
+		Name:       fmt.Sprintf("%s/%s%s", share.Database, common.QueryResultSharePrefix, share.ResourceID),
+		Statement:  share.Statement,
+		Creator:    common.FormatUserEmail(creator.Email),
+		CreateTime: timestamppb.New(share.CreatedTime),
+		ExpireTime: timestamppb.New(share.ExpireTime),
+		Results:    results,
+	}
+}
@@ -1,11 +1,24 @@
 package v1
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"github.com/hamba/avro/v2/ocf"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -260,6 +273,208 @@ func TestGetExcelColumnName(t *testing.T) {
 	}
 }
 
+func TestGetExportColumns(t *testing.T) {
+	stringValue := func(s string) *v1pb.RowValue {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+	}
+	nullValue := &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}}
+	result := &v1pb.QueryResult{
+		ColumnNames:     []string{"id", "price", "created_at", "birthday", "email", "big", "data"},
+		ColumnTypeNames: []string{"BIGINT", "DECIMAL", "TIMESTAMP", "DATE", "VARCHAR", "BIGINT UNSIGNED", "BLOB"},
+		Rows: []*v1pb.QueryRow{
+			{Values: []*v1pb.RowValue{
+				stringValue("1"),
+				stringValue("12.5"),
+				stringValue("2024-01-02 03:04:05"),
+				stringValue("2024-01-02"),
+				stringValue("a@b.com"),
+				{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: 18446744073709551615}},
+				{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte{1}}},
+			}},
+			{Values: []*v1pb.RowValue{
+				stringValue("-20"),
+				stringValue("-123.456"),
+				stringValue("2024-01-02T03:04:05.123456Z"),
+				nullValue,
+				nullValue,
+				{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: 1}},
+				nullValue,
+			}},
+		},
+	}
+	want := []*exportColumn{
+		{name: "id", typeName: "BIGINT", tp: exportColumnTypeInt64},
+		{name: "price", typeName: "DECIMAL", tp: exportColumnTypeDecimal, precision: 38, scale: 3},
+		{name: "created_at", typeName: "TIMESTAMP", tp: exportColumnTypeTimestamp},
+		{name: "birthday", typeName: "DATE", tp: exportColumnTypeDate},
+		{name: "email", typeName: "VARCHAR", tp: exportColumnTypeString},
+		{name: "big", typeName: "BIGINT UNSIGNED", tp: exportColumnTypeDecimal, precision: 38},
+		{name: "data", typeName: "BLOB", tp: exportColumnTypeBytes},
+	}
+	a := assert.New(t)
+	a.Equal(want, getExportColumns(result))
+
+	// The masked values fall back the column to string.
+	result.Rows[1].Values[1] = stringValue("******")
+	a.Equal(exportColumnTypeString, getExportColumns(result)[1].tp)
+}
+
+func TestGetPagedExportColumns(t *testing.T) {
+	stringValue := func(s string) *v1pb.RowValue {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+	}
+	result := &v1pb.QueryResult{
+		ColumnNames:     []string{"price", "amount", "big", "ratio"},
+		ColumnTypeNames: []string{"DECIMAL(10,4)", "NUMERIC", "UNSIGNED BIGINT", "REAL"},
+		Rows: []*v1pb.QueryRow{
+			{Values: []*v1pb.RowValue{
+				stringValue("12.5"),
+				stringValue("1.25"),
+				stringValue("1"),
+				{Kind: &v1pb.RowValue_FloatValue{FloatValue: 0.5}},
+			}},
+		},
+	}
+	want := []*exportColumn{
+		{name: "price", typeName: "DECIMAL", tp: exportColumnTypeDecimal, precision: 38, scale: 4},
+		{name: "amount", typeName: "NUMERIC", tp: exportColumnTypeString},
+		{name: "big", typeName: "UNSIGNED BIGINT", tp: exportColumnTypeDecimal, precision: 38},
+		{name: "ratio", typeName: "REAL", tp: exportColumnTypeDouble},
+	}
+	assert.New(t).Equal(want, getPagedExportColumns(result, getExportColumns(result)))
+}
+
+func TestGetTwosComplement(t *testing.T) {
+	tests := []struct {
+		value int64
+		want  []byte
+	}{
+		{value: 0, want: []byte{0x00}},
+		{value: 127, want: []byte{0x7f}},
+		{value: 128, want: []byte{0x00, 0x80}},
+		{value: -1, want: []byte{0xff}},
+		{value: -129, want: []byte{0xff, 0x7f}},
+		{value: -32768, want: []byte{0xff, 0x80, 0x00}},
+	}
+	a := assert.New(t)
+	for _, test := range tests {
+		got := getTwosComplement(big.NewInt(test.value))
+		a.Equal(test.want, got, test.value)
+		a.Equal(test.value, new(big.Int).SetBytes(got).Int64()-int64(got[0]>>7)<<(8*len(got)), test.value)
+	}
+}
+
+func TestExportMarkdown(t *testing.T) {
+	result := &v1pb.QueryResult{
+		ColumnNames: []string{"id", "note"},
+		Rows: []*v1pb.QueryRow{
+			{Values: []*v1pb.RowValue{
+				{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "a|b\nc"}},
+			}},
+			{Values: []*v1pb.RowValue{
+				{Kind: &v1pb.RowValue_Int64Value{Int64Value: 2}},
+				{Kind: &v1pb.RowValue_NullValue{}},
+			}},
+		},
+	}
+	got, err := exportMarkdown(result)
+	a := assert.New(t)
+	a.NoError(err)
+	a.Equal("| id | note |\n| --- | --- |\n| 1 | a\\|b<br>c |\n| 2 |  |\n", string(got))
+}
+
+func TestExportParquet(t *testing.T) {
+	result := &v1pb.QueryResult{
+		ColumnNames:     []string{"id", "price"},
+		ColumnTypeNames: []string{"INT", "NUMERIC"},
+		Rows: []*v1pb.QueryRow{
+			{Values: []*v1pb.RowValue{
+				{Kind: &v1pb.RowValue_Int32Value{Int32Value: 1}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "1.50"}},
+			}},
+			{Values: []*v1pb.RowValue{
+				{Kind: &v1pb.RowValue_NullValue{}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "-2"}},
+			}},
+		},
+	}
+	content, err := exportParquet(result)
+	a := assert.New(t)
+	a.NoError(err)
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(content), parquet.NewReaderProperties(memory.DefaultAllocator), pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	a.NoError(err)
+	defer table.Release()
+	a.Equal(int64(2), table.NumRows())
+	a.Equal("decimal(38, 2)", table.Schema().Field(1).Type.String())
+}
+
+func TestExportAvroRoundTrip(t *testing.T) {
+	columnNames := []string{"id", "unit price", "created_at", "day", "ok", "data", "name"}
+	columnTypeNames := []string{"INT", "NUMERIC(10,2)", "TIMESTAMP", "DATE", "BOOL", "BYTEA", "VARCHAR"}
+	pages := [][]*v1pb.QueryRow{
+		{
+			{Values: []*v1pb.RowValue{
+				{Kind: &v1pb.RowValue_Int32Value{Int32Value: 1}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "1.50"}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-01-02 03:04:05"}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-01-02"}},
+				{Kind: &v1pb.RowValue_BoolValue{BoolValue: true}},
+				{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte{1, 2}}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "a"}},
+			}},
+			{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_NullValue{}}}},
+		},
+		nil,
+	}
+	// The second page spans two blocks, and its last value doesn't match the type of the column inferred from the first page.
+	for i := 0; i < avroBlockRows; i++ {
+		pages[1] = append(pages[1], &v1pb.QueryRow{Values: []*v1pb.RowValue{
+			{Kind: &v1pb.RowValue_Int64Value{Int64Value: int64(i)}},
+			{Kind: &v1pb.RowValue_StringValue{StringValue: "-2"}},
+		}})
+	}
+	pages[1] = append(pages[1], &v1pb.QueryRow{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_StringValue{StringValue: "x"}}}})
+
+	a := assert.New(t)
+	var buf bytes.Buffer
+	writer, err := newExportWriter(&buf, v1pb.ExportFormat_AVRO, storepb.Engine_POSTGRES, nil)
+	a.NoError(err)
+	for _, rows := range pages {
+		a.NoError(writer.Write(&v1pb.QueryResult{ColumnNames: columnNames, ColumnTypeNames: columnTypeNames, Rows: rows}))
+	}
+	a.NoError(writer.Close())
+
+	decoder, err := ocf.NewDecoder(&buf)
+	a.NoError(err)
+	a.Equal("deflate", string(decoder.Metadata()["avro.codec"]))
+	var records []map[string]any
+	for decoder.HasNext() {
+		var record map[string]any
+		a.NoError(decoder.Decode(&record))
+		records = append(records, record)
+	}
+	a.NoError(decoder.Error())
+	a.Len(records, 2+avroBlockRows+1)
+
+	// The invalid Avro names are converted, and the typed values are read back as the logical types.
+	a.Equal(map[string]any{
+		"id":         map[string]any{"long": int64(1)},
+		"unit_price": map[string]any{"bytes.decimal": big.NewRat(3, 2)},
+		"created_at": map[string]any{"long.timestamp-micros": time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)},
+		"day":        map[string]any{"int.date": time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		"ok":         map[string]any{"boolean": true},
+		"data":       map[string]any{"bytes": []byte{1, 2}},
+		"name":       map[string]any{"string": "a"},
+	}, records[0])
+	for _, value := range records[1] {
+		a.Nil(value)
+	}
+	a.Equal(map[string]any{"long": int64(avroBlockRows - 1)}, records[avroBlockRows+1]["id"])
+	a.Equal(map[string]any{"bytes.decimal": big.NewRat(-2, 1)}, records[avroBlockRows+1]["unit_price"])
+	a.Equal(map[string]any{"string": "x"}, records[avroBlockRows+2]["id"])
+}
+
 func TestGetOffsetAndOriginTable(t *testing.T) {
 	tests := []struct {
 		input     string
@@ -291,3 +506,259 @@ func TestGetOffsetAndOriginTable(t *testing.T) {
 		a.Equal(test.tableName, tabaleName)
 	}
 }
+
+func TestExportWriterPages(t *testing.T) {
+	result := &v1pb.QueryResult{
+		ColumnNames:     []string{"id", "name"},
+		ColumnTypeNames: []string{"INT", "VARCHAR"},
+	}
+	for i := 0; i < 5; i++ {
+		result.Rows = append(result.Rows, &v1pb.QueryRow{Values: []*v1pb.RowValue{
+			{Kind: &v1pb.RowValue_Int64Value{Int64Value: int64(i)}},
+			{Kind: &v1pb.RowValue_StringValue{StringValue: fmt.Sprintf("name-%d", i)}},
+		}})
+	}
+	tests := []struct {
+		format v1pb.ExportFormat
+		export func(*v1pb.QueryResult) ([]byte, error)
+	}{
+		{format: v1pb.ExportFormat_CSV, export: exportCSV},
+		{format: v1pb.ExportFormat_JSON, export: exportJSON},
+		{format: v1pb.ExportFormat_MARKDOWN, export: exportMarkdown},
+	}
+
+	a := assert.New(t)
+	for _, test := range tests {
+		want, err := test.export(result)
+		a.NoError(err)
+
+		var buf bytes.Buffer
+		writer, err := newExportWriter(&buf, test.format, storepb.Engine_MYSQL, nil)
+		a.NoError(err)
+		for _, rows := range [][]*v1pb.QueryRow{result.Rows[:2], result.Rows[2:], nil} {
+			a.NoError(writer.Write(&v1pb.QueryResult{ColumnNames: result.ColumnNames, ColumnTypeNames: result.ColumnTypeNames, Rows: rows}))
+		}
+		a.NoError(writer.Close())
+		a.Equal(string(want), buf.String(), test.format.String())
+	}
+}
+
+func TestIsReadOnlyTransactionSupported(t *testing.T) {
+	// The drivers of these engines reject the read-only transactions.
+	rejected := map[storepb.Engine]bool{
+		storepb.Engine_MSSQL:            true,
+		storepb.Engine_ORACLE:           true,
+		storepb.Engine_OCEANBASE_ORACLE: true,
+		storepb.Engine_DM:               true,
+		storepb.Engine_SNOWFLAKE:        true,
+		storepb.Engine_TIDB:             true,
+		storepb.Engine_OCEANBASE:        true,
+		storepb.Engine_STARROCKS:        true,
+		storepb.Engine_DORIS:            true,
+	}
+	a := assert.New(t)
+	for engine := range streamExportEngines {
+		a.Equal(!rejected[engine], isReadOnlyTransactionSupported(&store.InstanceMessage{Engine: engine}, nil), engine.String())
+	}
+	for _, engine := range []storepb.Engine{storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE, storepb.Engine_POSTGRES} {
+		a.True(isPagedQuerySupported(engine))
+		a.Equal(!rejected[engine], isReadOnlyTransactionSupported(&store.InstanceMessage{Engine: engine}, nil), engine.String())
+	}
+
+	tests := []struct {
+		instance *store.InstanceMessage
+		database *store.DatabaseMessage
+		want     bool
+	}{
+		{instance: &store.InstanceMessage{Engine: storepb.Engine_MARIADB, EngineVersion: "5.5.0"}, want: false},
+		{instance: &store.InstanceMessage{Engine: storepb.Engine_MARIADB, EngineVersion: "10.6.12"}, want: true},
+		{instance: &store.InstanceMessage{Engine: storepb.Engine_MYSQL, EngineVersion: "8.0.33"}, want: true},
+		{instance: &store.InstanceMessage{Engine: storepb.Engine_REDSHIFT}, database: &store.DatabaseMessage{}, want: true},
+		{instance: &store.InstanceMessage{Engine: storepb.Engine_REDSHIFT}, database: &store.DatabaseMessage{DataShare: true}, want: false},
+	}
+	for _, test := range tests {
+		a.Equal(test.want, isReadOnlyTransactionSupported(test.instance, test.database), test.instance.EngineVersion)
+	}
+}
+
+func TestNormalizeQueryStatement(t *testing.T) {
+	a := assert.New(t)
+	instance := &store.InstanceMessage{Engine: storepb.Engine_MYSQL}
+	want, err := normalizeQueryStatement(instance, "SELECT * FROM t WHERE a = '  x  ';\nSELECT 1")
+	a.NoError(err)
+	for _, statement := range []string{
+		"  SELECT * FROM t WHERE a = '  x  ';SELECT 1;  ",
+		"\nSELECT * FROM t WHERE a = '  x  '  ;\n\nSELECT 1\n",
+	} {
+		got, err := normalizeQueryStatement(instance, statement)
+		a.NoError(err)
+		a.Equal(want, got)
+	}
+	got, err := normalizeQueryStatement(instance, "SELECT * FROM t WHERE a = ' x ';\nSELECT 1")
+	a.NoError(err)
+	a.NotEqual(want, got)
+}
+
+func TestQueryResultCache(t *testing.T) {
+	a := assert.New(t)
+	cache := newQueryResultCache()
+	results := []*v1pb.QueryResult{{ColumnNames: []string{"a"}}}
+	cache.add("key", results, time.Minute)
+	// The cached results are not affected by the modification of the results.
+	results[0].ColumnNames[0] = "b"
+	entry := cache.get("key")
+	a.NotNil(entry)
+	a.Equal([]string{"a"}, entry.results[0].ColumnNames)
+
+	cache.add("expired", results, -time.Minute)
+	a.Nil(cache.get("expired"))
+
+	large := []*v1pb.QueryResult{{ColumnNames: []string{strings.Repeat("a", maxQueryResultCacheEntrySize)}}}
+	cache.add("large", large, time.Minute)
+	a.Nil(cache.get("large"))
+}
+
+func TestParseExplainPlan(t *testing.T) {
+	tests := []struct {
+		plan string
+		want *queryCostEstimate
+	}{
+		{
+			// MySQL.
+			plan: `{"query_block": {"select_id": 1, "cost_info": {"query_cost": "1021.25"}, "table": {"table_name": "t", "access_type": "ALL", "rows_examined_per_scan": 10000, "rows_produced_per_join": 10000}}}`,
+			want: &queryCostEstimate{rows: 10000, cost: 1021.25, fullScan: true},
+		},
+		{
+			// PostgreSQL.
+			plan: `[{"Plan": {"Node Type": "Index Scan", "Total Cost": 8.29, "Plan Rows": 1, "Plans": [{"Node Type": "Index Only Scan", "Total Cost": 4.1, "Plan Rows": 20}]}}]`,
+			want: &queryCostEstimate{rows: 20, cost: 8.29, fullScan: false},
+		},
+	}
+
+	a := assert.New(t)
+	for _, test := range tests {
+		got, err := parseExplainPlan(test.plan)
+		a.NoError(err)
+		a.Equal(test.want, got)
+	}
+	_, err := parseExplainPlan("not json")
+	a.Error(err)
+}
+
+func TestExplainQueryCost(t *testing.T) {
+	const (
+		plan1 = `{"query_block": {"cost_info": {"query_cost": "1.50"}, "table": {"access_type": "ref", "rows_examined_per_scan": 10}}}`
+		plan2 = `{"query_block": {"cost_info": {"query_cost": "2.50"}, "table": {"access_type": "ALL", "rows_examined_per_scan": 5}}}`
+	)
+	ctx := context.Background()
+	a := assert.New(t)
+	list, err := base.SplitMultiSQL(storepb.Engine_MYSQL, "SELECT * FROM t1 WHERE id = 1;\nSELECT * FROM t2;")
+	a.NoError(err)
+
+	explain := func(explainErr error) (*queryCostEstimate, error) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		a.NoError(err)
+		defer db.Close()
+		conn, err := db.Conn(ctx)
+		a.NoError(err)
+		defer conn.Close()
+		mock.ExpectQuery("EXPLAIN FORMAT=JSON SELECT * FROM t1 WHERE id = 1").WillReturnRows(sqlmock.NewRows([]string{"EXPLAIN"}).AddRow(plan1))
+		if explainErr != nil {
+			mock.ExpectQuery("EXPLAIN FORMAT=JSON SELECT * FROM t2").WillReturnError(explainErr)
+		} else {
+			mock.ExpectQuery("EXPLAIN FORMAT=JSON SELECT * FROM t2").WillReturnRows(sqlmock.NewRows([]string{"EXPLAIN"}).AddRow(plan2))
+		}
+		estimate, err := explainQueryCost(ctx, conn, storepb.Engine_MYSQL, list)
+		a.NoError(mock.ExpectationsWereMet())
+		return estimate, err
+	}
+
+	got, err := explain(nil)
+	a.NoError(err)
+	a.Equal(&queryCostEstimate{rows: 10, cost: 4, fullScan: true}, got)
+
+	// The query is guarded with the worst estimation if any statement cannot be explained.
+	_, err = explain(errors.New("Error 1064 (42000): You have an error in your SQL syntax"))
+	a.ErrorContains(err, "failed to explain statement \"SELECT * FROM t2\"")
+}
+
+func TestGetTransactionAction(t *testing.T) {
+	tests := []struct {
+		engine    storepb.Engine
+		statement string
+		want      transactionAction
+	}{
+		{engine: storepb.Engine_POSTGRES, statement: "BEGIN;", want: transactionActionBegin},
+		{engine: storepb.Engine_MYSQL, statement: "start transaction read only", want: transactionActionBegin},
+		{engine: storepb.Engine_POSTGRES, statement: "COMMIT", want: transactionActionEnd},
+		{engine: storepb.Engine_POSTGRES, statement: "rollback;", want: transactionActionEnd},
+		{engine: storepb.Engine_POSTGRES, statement: "ROLLBACK TO SAVEPOINT sp1", want: transactionActionNone},
+		{engine: storepb.Engine_MYSQL, statement: "COMMIT AND CHAIN", want: transactionActionNone},
+		{engine: storepb.Engine_MYSQL, statement: "COMMIT AND NO CHAIN", want: transactionActionEnd},
+		{engine: storepb.Engine_MYSQL, statement: "ALTER TABLE t ADD COLUMN c INT", want: transactionActionEnd},
+		{engine: storepb.Engine_POSTGRES, statement: "ALTER TABLE t ADD COLUMN c INT", want: transactionActionNone},
+		{engine: storepb.Engine_POSTGRES, statement: "UPDATE t SET a = 1", want: transactionActionNone},
+	}
+
+	a := assert.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, getTransactionAction(test.engine, test.statement), test.statement)
+	}
+}
+
+func TestGetSessionVariable(t *testing.T) {
+	tests := []struct {
+		engine    storepb.Engine
+		statement string
+		name      string
+		value     string
+		ok        bool
+	}{
+		{engine: storepb.Engine_MYSQL, statement: "SET time_zone = '+00:00';", name: "time_zone", value: "'+00:00'", ok: true},
+		{engine: storepb.Engine_MYSQL, statement: "set session sql_mode='ANSI'", name: "sql_mode", value: "'ANSI'", ok: true},
+		{engine: storepb.Engine_MYSQL, statement: "SET @a = 1, @b = 2", ok: false},
+		{engine: storepb.Engine_POSTGRES, statement: "SET search_path TO public, app", name: "search_path", value: "public, app", ok: true},
+		{engine: storepb.Engine_POSTGRES, statement: "SELECT 1", ok: false},
+	}
+
+	a := assert.New(t)
+	for _, test := range tests {
+		name, value, ok := getSessionVariable(test.engine, test.statement)
+		a.Equal(test.ok, ok, test.statement)
+		a.Equal(test.name, name, test.statement)
+		a.Equal(test.value, value, test.statement)
+	}
+}
+
+func TestGetQueryFingerprint(t *testing.T) {
+	tests := []struct {
+		engine    storepb.Engine
+		statement string
+		want      string
+	}{
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "SELECT * FROM orders WHERE id = 10 AND status = 'paid';",
+			want:      "select * from orders where id = ? and status = ?",
+		},
+		{
+			engine:    storepb.Engine_POSTGRES,
+			statement: "SELECT *\nFROM orders WHERE id = 10 AND status = 'paid'",
+			want:      "SELECT * FROM orders WHERE id = $1 AND status = $2",
+		},
+		{
+			engine:    storepb.Engine_SNOWFLAKE,
+			statement: "SELECT * FROM orders WHERE id = 10 AND status = 'it''s'",
+			want:      "SELECT * FROM orders WHERE id = ? AND status = ?",
+		},
+	}
+
+	a := assert.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, getQueryFingerprint(test.engine, test.statement), test.statement)
+	}
+
+	long := getQueryFingerprint(storepb.Engine_POSTGRES, "SELECT "+strings.Repeat("a, ", 1000)+"b FROM t")
+	a.True(strings.HasSuffix(long, " */"))
+	a.Less(len(long), maxQueryFingerprintLength+32)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"path"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -19,6 +20,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
 	"github.com/bytebase/bytebase/backend/store"
@@ -112,6 +114,27 @@ func (s *VCSConnectorService) CreateVCSConnector(ctx context.Context, request *v
 		return nil, status.Errorf(codes.InvalidArgument, `base directory should not end with "/"`)
 	}
 
+	if err := validateFilePathTemplate(request.GetVcsConnector().FilePathTemplate, baseDirectory, request.GetVcsConnector().Declarative); err != nil {
+		return nil, err
+	}
+	if err := validateMigrationFormat(request.GetVcsConnector().MigrationFormat, request.GetVcsConnector().FilePathTemplate, request.GetVcsConnector().Declarative); err != nil {
+		return nil, err
+	}
+	if err := validateTagPattern(request.GetVcsConnector().TagPattern); err != nil {
+		return nil, err
+	}
+	if err := validateSchemaWriteBack(request.GetVcsConnector().SchemaWriteBack, request.GetVcsConnector().Branch, baseDirectory, request.GetVcsConnector().FilePathTemplate); err != nil {
+		return nil, err
+	}
+	pushTrigger := request.GetVcsConnector().PushTrigger
+	if vcsProvider.Type == storepb.VCSType_GIT {
+		if err := validateGitSchemaWriteBack(request.GetVcsConnector().SchemaWriteBack); err != nil {
+			return nil, err
+		}
+		// There is no pull request in the generic Git repositories, and the changes are rolled out by the pushes to the branch.
+		pushTrigger = true
+	}
+
 	workspaceID, err := s.store.GetWorkspaceID(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to find workspace id with error: %v", err.Error())
@@ -135,6 +158,12 @@ func (s *VCSConnectorService) CreateVCSConnector(ctx context.Context, request *v
 			ExternalId:         request.GetVcsConnector().ExternalId,
 			WebhookSecretToken: secretToken,
 			DatabaseGroup:      request.GetVcsConnector().DatabaseGroup,
+			FilePathTemplate:   request.GetVcsConnector().FilePathTemplate,
+			Declarative:        request.GetVcsConnector().Declarative,
+			PushTrigger:        pushTrigger,
+			TagPattern:         request.GetVcsConnector().TagPattern,
+			SchemaWriteBack:    convertToStoreSchemaWriteBack(request.GetVcsConnector().SchemaWriteBack),
+			MigrationFormat:    storepb.VCSConnector_MigrationFormat(request.GetVcsConnector().MigrationFormat),
 		},
 	}
 
@@ -144,7 +173,7 @@ func (s *VCSConnectorService) CreateVCSConnector(ctx context.Context, request *v
 		bytebaseEndpointURL = setting.ExternalUrl
 	}
 	webhookEndpointID := fmt.Sprintf("workspaces/%s/projects/%s/vcsConnectors/%s", workspaceID, project.ResourceID, request.VcsConnectorId)
-	webhookID, err := createVCSWebhook(
+	webhookID, pushWebhookID, err := createVCSWebhook(
 		ctx,
 		vcsProvider,
 		webhookEndpointID,
@@ -156,6 +185,7 @@ func (s *VCSConnectorService) CreateVCSConnector(ctx context.Context, request *v
 		return nil, status.Errorf(codes.Internal, "failed to create webhook for project %s with error: %v", vcsConnectorCreate.ProjectID, err.Error())
 	}
 	vcsConnectorCreate.Payload.ExternalWebhookId = webhookID
+	vcsConnectorCreate.Payload.ExternalPushWebhookId = pushWebhookID
 
 	vcsConnector, err = s.store.CreateVCSConnector(ctx, vcsConnectorCreate)
 	if err != nil {
@@ -291,6 +321,75 @@ func (s *VCSConnectorService) UpdateVCSConnector(ctx context.Context, request *v
 			update.BaseDirectory = &baseDir
 		case "database_group":
 			update.DatabaseGroup = &request.GetVcsConnector().DatabaseGroup
+		case "file_path_template":
+			update.FilePathTemplate = &request.GetVcsConnector().FilePathTemplate
+		case "declarative":
+			update.Declarative = &request.GetVcsConnector().Declarative
+		case "migration_format":
+			migrationFormat := storepb.VCSConnector_MigrationFormat(request.GetVcsConnector().MigrationFormat)
+			update.MigrationFormat = &migrationFormat
+		case "push_trigger":
+			if vcsProvider.Type == storepb.VCSType_GIT && !request.GetVcsConnector().PushTrigger {
+				return nil, status.Errorf(codes.InvalidArgument, "push trigger cannot be disabled for the generic Git VCS")
+			}
+			update.PushTrigger = &request.GetVcsConnector().PushTrigger
+		case "tag_pattern":
+			if err := validateTagPattern(request.GetVcsConnector().TagPattern); err != nil {
+				return nil, err
+			}
+			update.TagPattern = &request.GetVcsConnector().TagPattern
+		case "schema_write_back":
+			if vcsProvider.Type == storepb.VCSType_GIT {
+				if err := validateGitSchemaWriteBack(request.GetVcsConnector().SchemaWriteBack); err != nil {
+					return nil, err
+				}
+			}
+			update.SchemaWriteBack = convertToStoreSchemaWriteBack(request.GetVcsConnector().SchemaWriteBack)
+			if update.SchemaWriteBack == nil {
+				update.SchemaWriteBack = &storepb.VCSConnector_SchemaWriteBack{}
+			}
+		}
+	}
+
+	if update.SchemaWriteBack != nil || update.Branch != nil || update.BaseDirectory != nil || update.FilePathTemplate != nil {
+		schemaWriteBack, branch := vcsConnector.Payload.SchemaWriteBack, vcsConnector.Payload.Branch
+		baseDirectory, template := vcsConnector.Payload.BaseDirectory, vcsConnector.Payload.FilePathTemplate
+		if v := update.SchemaWriteBack; v != nil {
+			schemaWriteBack = v
+		}
+		if v := update.Branch; v != nil {
+			branch = *v
+		}
+		if v := update.BaseDirectory; v != nil {
+			baseDirectory = *v
+		}
+		if v := update.FilePathTemplate; v != nil {
+			template = *v
+		}
+		if err := validateSchemaWriteBack(convertToV1SchemaWriteBack(schemaWriteBack), branch, baseDirectory, template); err != nil {
+			return nil, err
+		}
+	}
+
+	if update.BaseDirectory != nil || update.FilePathTemplate != nil || update.Declarative != nil || update.MigrationFormat != nil {
+		baseDirectory, template, declarative, migrationFormat := vcsConnector.Payload.BaseDirectory, vcsConnector.Payload.FilePathTemplate, vcsConnector.Payload.Declarative, vcsConnector.Payload.MigrationFormat
+		if v := update.BaseDirectory; v != nil {
+			baseDirectory = *v
+		}
+		if v := update.FilePathTemplate; v != nil {
+			template = *v
+		}
+		if v := update.Declarative; v != nil {
+			declarative = *v
+		}
+		if v := update.MigrationFormat; v != nil {
+			migrationFormat = *v
+		}
+		if err := validateFilePathTemplate(template, baseDirectory, declarative); err != nil {
+			return nil, err
+		}
+		if err := validateMigrationFormat(v1pb.VCSConnector_MigrationFormat(migrationFormat), template, declarative); err != nil {
+			return nil, err
 		}
 	}
 
@@ -304,6 +403,11 @@ func (s *VCSConnectorService) UpdateVCSConnector(ctx context.Context, request *v
 		); err != nil {
 			return nil, err
 		}
+		// The polling of the generic Git VCS starts over from the head of the new branch.
+		if vcsProvider.Type == storepb.VCSType_GIT && *v != vcsConnector.Payload.Branch {
+			lastPolledCommitID := ""
+			update.LastPolledCommitID = &lastPolledCommitID
+		}
 	}
 
 	if err := s.store.UpdateVCSConnector(ctx, update); err != nil {
@@ -368,6 +472,18 @@ func (s *VCSConnectorService) DeleteVCSConnector(ctx context.Context, request *v
 	); err != nil {
 		slog.Error("failed to delete webhook for VCS connector", slog.String("project", projectID), slog.String("VCS connector", vcsConnector.ResourceID), log.BBError(err))
 	}
+	if pushWebhookID := vcsConnector.Payload.ExternalPushWebhookId; pushWebhookID != "" {
+		if err = vcs.Get(
+			vcsProvider.Type,
+			vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken},
+		).DeleteWebhook(
+			ctx,
+			vcsConnector.Payload.ExternalId,
+			pushWebhookID,
+		); err != nil {
+			slog.Error("failed to delete push webhook for VCS connector", slog.String("project", projectID), slog.String("VCS connector", vcsConnector.ResourceID), log.BBError(err))
+		}
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -389,23 +505,138 @@ func convertStoreVCSConnector(ctx context.Context, stores *store.Store, vcsConne
 	}
 
 	v1VCSConnector := &v1pb.VCSConnector{
-		Name:          fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, vcsConnector.ProjectID, common.VCSConnectorPrefix, vcsConnector.ResourceID),
-		CreateTime:    timestamppb.New(vcsConnector.CreatedTime),
-		UpdateTime:    timestamppb.New(vcsConnector.UpdatedTime),
-		Creator:       fmt.Sprintf("users/%s", creator.Email),
-		Updater:       fmt.Sprintf("users/%s", updater.Email),
-		Title:         vcsConnector.Payload.Title,
-		VcsProvider:   fmt.Sprintf("%s%s", common.VCSProviderPrefix, vcsConnector.VCSResourceID),
-		ExternalId:    vcsConnector.Payload.ExternalId,
-		BaseDirectory: vcsConnector.Payload.BaseDirectory,
-		Branch:        vcsConnector.Payload.Branch,
-		FullPath:      vcsConnector.Payload.FullPath,
-		WebUrl:        vcsConnector.Payload.WebUrl,
-		DatabaseGroup: vcsConnector.Payload.DatabaseGroup,
+		Name:             fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, vcsConnector.ProjectID, common.VCSConnectorPrefix, vcsConnector.ResourceID),
+		CreateTime:       timestamppb.New(vcsConnector.CreatedTime),
+		UpdateTime:       timestamppb.New(vcsConnector.UpdatedTime),
+		Creator:          fmt.Sprintf("users/%s", creator.Email),
+		Updater:          fmt.Sprintf("users/%s", updater.Email),
+		Title:            vcsConnector.Payload.Title,
+		VcsProvider:      fmt.Sprintf("%s%s", common.VCSProviderPrefix, vcsConnector.VCSResourceID),
+		ExternalId:       vcsConnector.Payload.ExternalId,
+		BaseDirectory:    vcsConnector.Payload.BaseDirectory,
+		Branch:           vcsConnector.Payload.Branch,
+		FullPath:         vcsConnector.Payload.FullPath,
+		WebUrl:           vcsConnector.Payload.WebUrl,
+		DatabaseGroup:    vcsConnector.Payload.DatabaseGroup,
+		FilePathTemplate: vcsConnector.Payload.FilePathTemplate,
+		Declarative:      vcsConnector.Payload.Declarative,
+		PushTrigger:      vcsConnector.Payload.PushTrigger,
+		TagPattern:       vcsConnector.Payload.TagPattern,
+		SchemaWriteBack:  convertToV1SchemaWriteBack(vcsConnector.Payload.SchemaWriteBack),
+		MigrationFormat:  v1pb.VCSConnector_MigrationFormat(vcsConnector.Payload.MigrationFormat),
 	}
 	return v1VCSConnector, nil
 }
 
+func convertToV1SchemaWriteBack(schemaWriteBack *storepb.VCSConnector_SchemaWriteBack) *v1pb.VCSConnector_SchemaWriteBack {
+	if schemaWriteBack.GetFilePathTemplate() == "" {
+		return nil
+	}
+	return &v1pb.VCSConnector_SchemaWriteBack{
+		FilePathTemplate: schemaWriteBack.FilePathTemplate,
+		Branch:           schemaWriteBack.Branch,
+		PullRequest:      schemaWriteBack.PullRequest,
+	}
+}
+
+func convertToStoreSchemaWriteBack(schemaWriteBack *v1pb.VCSConnector_SchemaWriteBack) *storepb.VCSConnector_SchemaWriteBack {
+	if schemaWriteBack.GetFilePathTemplate() == "" {
+		return nil
+	}
+	return &storepb.VCSConnector_SchemaWriteBack{
+		FilePathTemplate: schemaWriteBack.FilePathTemplate,
+		Branch:           schemaWriteBack.Branch,
+		PullRequest:      schemaWriteBack.PullRequest,
+	}
+}
+
+// validateFilePathTemplate validates the file path template of the VCS connector.
+// The versioned migration files must contain the version, while the declarative schema files must not.
+func validateFilePathTemplate(template, baseDirectory string, declarative bool) error {
+	if template == "" {
+		return nil
+	}
+	if _, err := vcs.ParseFilePathTemplate(template, baseDirectory); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid file path template: %v", err)
+	}
+	hasVersion := strings.Contains(template, vcs.FilePathTemplateVersion)
+	if !declarative && !hasVersion {
+		return status.Errorf(codes.InvalidArgument, "file path template %q must contain %s", template, vcs.FilePathTemplateVersion)
+	}
+	if declarative && (hasVersion || strings.Contains(template, vcs.FilePathTemplateType)) {
+		return status.Errorf(codes.InvalidArgument, "file path template %q of declarative schema files cannot contain %s or %s", template, vcs.FilePathTemplateVersion, vcs.FilePathTemplateType)
+	}
+	return nil
+}
+
+// validateMigrationFormat validates the migration format of the VCS connector.
+// The migration files of the ORM and migration tools follow their own layouts, so they cannot be routed by the file path template.
+func validateMigrationFormat(migrationFormat v1pb.VCSConnector_MigrationFormat, template string, declarative bool) error {
+	if migrationFormat == v1pb.VCSConnector_MIGRATION_FORMAT_UNSPECIFIED {
+		return nil
+	}
+	if declarative {
+		return status.Errorf(codes.InvalidArgument, "migration format %q cannot be used with declarative schema files", migrationFormat.String())
+	}
+	if template != "" {
+		return status.Errorf(codes.InvalidArgument, "migration format %q cannot be used with the file path template", migrationFormat.String())
+	}
+	return nil
+}
+
+// validateTagPattern validates the glob pattern of the tags.
+func validateTagPattern(pattern string) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid tag pattern %q: %v", pattern, err)
+	}
+	return nil
+}
+
+// validateSchemaWriteBack validates the schema write-back of the VCS connector listening to the branch.
+// The schema files written back to the branch must not be picked up as changes by the VCS connector,
+// otherwise every write-back triggers another rollout.
+func validateSchemaWriteBack(schemaWriteBack *v1pb.VCSConnector_SchemaWriteBack, branch, baseDirectory, filePathTemplate string) error {
+	if schemaWriteBack.GetFilePathTemplate() == "" {
+		return nil
+	}
+	filePath, err := vcs.RenderFilePathTemplate(schemaWriteBack.FilePathTemplate, baseDirectory, "environment", "database")
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid schema write-back file path template: %v", err)
+	}
+	if schemaWriteBack.PullRequest && (schemaWriteBack.Branch == "" || schemaWriteBack.Branch == branch) {
+		return status.Errorf(codes.InvalidArgument, "schema write-back with pull request requires a branch other than %q", branch)
+	}
+	if schemaWriteBack.Branch != "" && schemaWriteBack.Branch != branch {
+		return nil
+	}
+	if isChangeFilePath(filePath, baseDirectory, filePathTemplate) {
+		return status.Errorf(codes.InvalidArgument, "schema write-back file %q on branch %q would be picked up as a change by the VCS connector", filePath, branch)
+	}
+	return nil
+}
+
+// isChangeFilePath returns true if the file at the path relative to the repository root is a change file of the VCS connector.
+// The files matching the file path template are the change files if the template is set,
+// otherwise the files under the base directory are.
+func isChangeFilePath(filePath, baseDirectory, filePathTemplate string) bool {
+	if filePathTemplate != "" {
+		template, err := vcs.ParseFilePathTemplate(filePathTemplate, baseDirectory)
+		if err != nil {
+			return false
+		}
+		return template.Match(filePath) != nil
+	}
+	baseDirectory = strings.Trim(baseDirectory, "/")
+	return baseDirectory == "" || strings.HasPrefix(filePath, baseDirectory+"/")
+}
+
+func validateGitSchemaWriteBack(schemaWriteBack *v1pb.VCSConnector_SchemaWriteBack) error {
+	if schemaWriteBack.GetPullRequest() {
+		return status.Errorf(codes.InvalidArgument, "schema write-back with pull request is not supported for the generic Git VCS")
+	}
+	return nil
+}
+
 func checkBranchExistence(ctx context.Context, vcsProvider *store.VCSProviderMessage, externalID, branch string) error {
 	if branch == "" {
 		return status.Errorf(codes.InvalidArgument, "branch name is required")
@@ -419,9 +650,15 @@ func checkBranchExistence(ctx context.Context, vcsProvider *store.VCSProviderMes
 	return nil
 }
 
-func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage, webhookEndpointID, webhookSecretToken, externalRepoID, bytebaseEndpointURL string) (string, error) {
+// createVCSWebhook creates the webhook for the pull request and push events, and returns the webhook ID.
+// For Azure DevOps, a webhook only subscribes one type of event, so the push webhook ID is also returned.
+func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage, webhookEndpointID, webhookSecretToken, externalRepoID, bytebaseEndpointURL string) (string, string, error) {
+	// The generic Git repositories are polled without webhooks.
+	if vcsProvider.Type == storepb.VCSType_GIT {
+		return "", "", nil
+	}
 	// Create a new webhook and retrieve the created webhook ID
-	var webhookCreatePayload []byte
+	var webhookCreatePayload, pushWebhookCreatePayload []byte
 	var err error
 	switch vcsProvider.Type {
 	case storepb.VCSType_GITLAB:
@@ -429,12 +666,14 @@ func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage
 			URL:                   fmt.Sprintf("%s/hook/%s", bytebaseEndpointURL, webhookEndpointID),
 			SecretToken:           webhookSecretToken,
 			MergeRequestsEvents:   true,
+			PushEvents:            true,
+			TagPushEvents:         true,
 			NoteEvents:            true,
 			EnableSSLVerification: false,
 		}
 		webhookCreatePayload, err = json.Marshal(webhookCreate)
 		if err != nil {
-			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+			return "", "", errors.Wrap(err, "failed to marshal request body for creating webhook")
 		}
 	case storepb.VCSType_GITHUB:
 		webhookPost := github.WebhookCreateOrUpdate{
@@ -444,27 +683,42 @@ func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage
 				Secret:      webhookSecretToken,
 				InsecureSSL: 1,
 			},
-			Events: []string{"pull_request", "pull_request_review_comment"},
+			Events: []string{"pull_request", "pull_request_review_comment", "push"},
+		}
+		webhookCreatePayload, err = json.Marshal(webhookPost)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+		}
+	case storepb.VCSType_GITEA:
+		webhookPost := gitea.WebhookCreate{
+			Type: "gitea",
+			Config: gitea.WebhookConfig{
+				URL:         fmt.Sprintf("%s/hook/%s", bytebaseEndpointURL, webhookEndpointID),
+				ContentType: "json",
+				Secret:      webhookSecretToken,
+			},
+			Events: []string{"pull_request", "push"},
+			Active: true,
 		}
 		webhookCreatePayload, err = json.Marshal(webhookPost)
 		if err != nil {
-			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+			return "", "", errors.Wrap(err, "failed to marshal request body for creating webhook")
 		}
 	case storepb.VCSType_BITBUCKET:
 		webhookPost := bitbucket.WebhookCreateOrUpdate{
 			Description: "Bytebase GitOps",
 			URL:         fmt.Sprintf("%s/hook/%s", bytebaseEndpointURL, webhookEndpointID),
 			Active:      true,
-			Events:      []string{"pullrequest:created", "pullrequest:updated", "pullrequest:fulfilled", "pullrequest:comment_created"},
+			Events:      []string{"pullrequest:created", "pullrequest:updated", "pullrequest:fulfilled", "pullrequest:rejected", "pullrequest:comment_created", "repo:push"},
 		}
 		webhookCreatePayload, err = json.Marshal(webhookPost)
 		if err != nil {
-			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+			return "", "", errors.Wrap(err, "failed to marshal request body for creating webhook")
 		}
 	case storepb.VCSType_AZURE_DEVOPS:
 		part := strings.Split(externalRepoID, "/")
 		if len(part) != 3 {
-			return "", errors.Errorf("invalid external repo id %q", externalRepoID)
+			return "", "", errors.Errorf("invalid external repo id %q", externalRepoID)
 		}
 		projectID, repositoryID := part[1], part[2]
 
@@ -487,16 +741,31 @@ func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage
 		}
 		webhookCreatePayload, err = json.Marshal(webhookPost)
 		if err != nil {
-			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+			return "", "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+		}
+
+		webhookPost.EventType = "git.push"
+		webhookPost.PublisherInputs.MergeResult = ""
+		pushWebhookCreatePayload, err = json.Marshal(webhookPost)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to marshal request body for creating push webhook")
 		}
 	}
-	webhookID, err := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken}).CreateWebhook(
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	webhookID, err := provider.CreateWebhook(
 		ctx,
 		externalRepoID,
 		webhookCreatePayload,
 	)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to create webhook")
+		return "", "", errors.Wrap(err, "failed to create webhook")
+	}
+	var pushWebhookID string
+	if pushWebhookCreatePayload != nil {
+		pushWebhookID, err = provider.CreateWebhook(ctx, externalRepoID, pushWebhookCreatePayload)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to create push webhook")
+		}
 	}
-	return webhookID, nil
+	return webhookID, pushWebhookID, nil
 }
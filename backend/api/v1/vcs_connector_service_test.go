@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateFilePathTemplate(t *testing.T) {
+	a := require.New(t)
+
+	testCases := []struct {
+		template    string
+		declarative bool
+		wantErr     bool
+	}{
+		{
+			template:    "",
+			declarative: false,
+			wantErr:     false,
+		},
+		{
+			template:    "{{ROOT}}/{{ENV_ID}}/{{DB_NAME}}/{{VERSION}}_{{TYPE}}_{{DESC}}.sql",
+			declarative: false,
+			wantErr:     false,
+		},
+		{
+			// The versioned migration files must contain the version.
+			template:    "{{ROOT}}/{{DB_NAME}}/{{DESC}}.sql",
+			declarative: false,
+			wantErr:     true,
+		},
+		{
+			template:    "{{ROOT}}/{{DB_NAME}}/{{DB_GROUP}}/{{VERSION}}.sql",
+			declarative: false,
+			wantErr:     true,
+		},
+		{
+			template:    "{{ROOT}}/{{ENV_ID}}/{{DB_NAME}}/**/{{DESC}}.sql",
+			declarative: true,
+			wantErr:     false,
+		},
+		{
+			// The declarative schema files cannot contain the version or the type.
+			template:    "{{ROOT}}/{{DB_NAME}}/{{VERSION}}.sql",
+			declarative: true,
+			wantErr:     true,
+		},
+		{
+			template:    "{{ROOT}}/{{DB_NAME}}/{{TYPE}}.sql",
+			declarative: true,
+			wantErr:     true,
+		},
+		{
+			template:    "{{ROOT}}/{{UNKNOWN}}.sql",
+			declarative: true,
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := validateFilePathTemplate(tc.template, "/", tc.declarative)
+		if tc.wantErr {
+			a.Error(err, tc.template)
+		} else {
+			a.NoError(err, tc.template)
+		}
+	}
+}
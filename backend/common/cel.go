@@ -74,6 +74,29 @@ var MaskingExceptionPolicyCELAttributes = []cel.EnvOption{
 	cel.ParserExpressionSizeLimit(celLimit),
 }
 
+// RowFilterPolicyCELAttributes are the variables when evaluating row filter.
+var RowFilterPolicyCELAttributes = []cel.EnvOption{
+	cel.Variable("resource.instance_id", cel.StringType),
+	cel.Variable("resource.database_name", cel.StringType),
+	cel.Variable("resource.schema_name", cel.StringType),
+	cel.Variable("resource.table_name", cel.StringType),
+	cel.Variable("request.time", cel.TimestampType),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
+// QueryCostGuardCELAttributes are the variables when evaluating query cost guard rules.
+var QueryCostGuardCELAttributes = []cel.EnvOption{
+	cel.Variable("environment_id", cel.StringType),
+	cel.Variable("project_id", cel.StringType),
+	cel.Variable("instance_id", cel.StringType),
+	cel.Variable("database_name", cel.StringType),
+	cel.Variable("db_engine", cel.StringType),
+	cel.Variable("estimated_rows", cel.IntType),
+	cel.Variable("estimated_cost", cel.DoubleType),
+	cel.Variable("full_scan", cel.BoolType),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
 // ConvertUnparsedRisk converts unparsed risk to parsed format.
 func ConvertUnparsedRisk(expression *expr.Expr) (*exprproto.ParsedExpr, error) {
 	if expression == nil || expression.Expression == "" {
@@ -173,6 +196,44 @@ func ValidateMaskingExceptionCELExpr(expr string) (cel.Program, error) {
 	return prog, nil
 }
 
+// ValidateRowFilterCELExpr validates row filter expr.
+func ValidateRowFilterCELExpr(expr string) (cel.Program, error) {
+	e, err := cel.NewEnv(
+		RowFilterPolicyCELAttributes...,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	ast, issues := e.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, status.Errorf(codes.InvalidArgument, issues.Err().Error())
+	}
+	prog, err := e.Program(ast)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return prog, nil
+}
+
+// ValidateQueryCostGuardCELExpr validates query cost guard rule expr.
+func ValidateQueryCostGuardCELExpr(expr string) (cel.Program, error) {
+	e, err := cel.NewEnv(
+		QueryCostGuardCELAttributes...,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	ast, issues := e.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, status.Errorf(codes.InvalidArgument, issues.Err().Error())
+	}
+	prog, err := e.Program(ast)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return prog, nil
+}
+
 func ValidateProjectMemberCELExpr(expression *expr.Expr) (cel.Program, error) {
 	if expression == nil || expression.Expression == "" {
 		return nil, nil
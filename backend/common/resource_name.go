@@ -49,11 +49,14 @@ const (
 	AuditLogPrefix             = "auditLogs/"
 	UserGroupPrefix            = "groups/"
 	ReviewConfigPrefix         = "reviewConfigs/"
-
-	SchemaSuffix          = "/schema"
-	MetadataSuffix        = "/metadata"
-	GitOpsInfoSuffix      = "/gitOpsInfo"
-	ProtectionRulesSuffix = "/protectionRules"
+	QueryResultSharePrefix     = "queryResultShares/"
+	EditorSessionPrefix        = "editorSessions/"
+
+	SchemaSuffix              = "/schema"
+	MetadataSuffix            = "/metadata"
+	GitOpsInfoSuffix          = "/gitOpsInfo"
+	ProtectionRulesSuffix     = "/protectionRules"
+	SensitiveDataReportSuffix = "/sensitiveDataReport"
 )
 
 // GetProjectID returns the project ID from a resource name.
@@ -191,6 +194,26 @@ func GetInstanceDatabaseIDChangeHistory(name string) (string, string, string, er
 	return tokens[0], tokens[1], tokens[2], nil
 }
 
+// GetInstanceDatabaseIDQueryResultShare returns the instance ID, database ID, and query result share ID from a resource name.
+func GetInstanceDatabaseIDQueryResultShare(name string) (string, string, string, error) {
+	// the name should be instances/{instance-id}/databases/{database-id}/queryResultShares/{share-id}
+	tokens, err := GetNameParentTokens(name, InstanceNamePrefix, DatabaseIDPrefix, QueryResultSharePrefix)
+	if err != nil {
+		return "", "", "", err
+	}
+	return tokens[0], tokens[1], tokens[2], nil
+}
+
+// GetInstanceDatabaseIDEditorSession returns the instance ID, database ID, and editor session ID from a resource name.
+func GetInstanceDatabaseIDEditorSession(name string) (string, string, string, error) {
+	// the name should be instances/{instance-id}/databases/{database-id}/editorSessions/{session-id}
+	tokens, err := GetNameParentTokens(name, InstanceNamePrefix, DatabaseIDPrefix, EditorSessionPrefix)
+	if err != nil {
+		return "", "", "", err
+	}
+	return tokens[0], tokens[1], tokens[2], nil
+}
+
 // GetInstanceDatabaseIDSecretName returns the instance ID, database ID, and secret name from a resource name.
 func GetInstanceDatabaseIDSecretName(name string) (string, string, string, error) {
 	// the instance request should be instances/{instance-id}/databases/{database-id}/secrets/{secret-name}
@@ -85,6 +85,7 @@ const (
 	PermissionDatabasesList         Permission = "bb.databases.list"
 	PermissionDatabasesQuery        Permission = "bb.databases.query"
 	PermissionDatabasesSync         Permission = "bb.databases.sync"
+	PermissionDatabasesUnmask       Permission = "bb.databases.unmask"
 	PermissionDatabasesUpdate       Permission = "bb.databases.update"
 	PermissionIssueCommentsCreate   Permission = "bb.issueComments.create"
 	PermissionIssueCommentsUpdate   Permission = "bb.issueComments.update"
@@ -145,6 +146,7 @@ func PermissionExist(p Permission) bool {
 		PermissionDatabasesList,
 		PermissionDatabasesQuery,
 		PermissionDatabasesSync,
+		PermissionDatabasesUnmask,
 		PermissionDatabasesUpdate,
 		PermissionEnvironmentsCreate,
 		PermissionEnvironmentsDelete,
@@ -328,6 +330,7 @@ func GetPermissionLevel(permission Permission) PermissionLevel {
 		PermissionDatabasesList,
 		PermissionDatabasesQuery,
 		PermissionDatabasesSync,
+		PermissionDatabasesUnmask,
 		PermissionDatabasesUpdate,
 		PermissionIssueCommentsCreate,
 		PermissionIssueCommentsUpdate,
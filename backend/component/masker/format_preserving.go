@@ -0,0 +1,278 @@
+package masker
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	digitAlphabet        = "0123456789"
+	alphanumericAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	// tokenLen is the length of the token in bytes before the hex encoding.
+	tokenLen = 16
+)
+
+// FormatPreservingMasker is the masker that encrypts the data with the format-preserving encryption,
+// the masked data keeps the length and the charset of the data, and can be unmasked with the same key.
+type FormatPreservingMasker struct {
+	format storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_Format
+	mode   storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_Mode
+	key    []byte
+	tweak  string
+
+	digitCipher        fpeCipher
+	alphanumericCipher fpeCipher
+}
+
+// NewFormatPreservingMasker returns a new FormatPreservingMasker, the key must be 16, 24 or 32 bytes.
+func NewFormatPreservingMasker(format storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_Format, mode storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_Mode, key []byte, tweak string) (*FormatPreservingMasker, error) {
+	if format == storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_FORMAT_UNSPECIFIED {
+		return nil, errors.New("format is required for format-preserving masking")
+	}
+	m := &FormatPreservingMasker{
+		format: format,
+		mode:   mode,
+		key:    key,
+		tweak:  tweak,
+	}
+	newCipher := func(radix int) (fpeCipher, error) {
+		if mode == storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_FF3_1 {
+			// The tweak of FF3-1 is fixed to 56 bits.
+			h := sha256.Sum256([]byte(tweak))
+			return newFF3Cipher(key, radix, h[:ff3TweakLen])
+		}
+		return newFF1Cipher(key, radix, []byte(tweak))
+	}
+	var err error
+	if m.digitCipher, err = newCipher(len(digitAlphabet)); err != nil {
+		return nil, err
+	}
+	if m.alphanumericCipher, err = newCipher(len(alphanumericAlphabet)); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Mask implements Masker.Mask.
+func (m *FormatPreservingMasker) Mask(data *MaskData) *v1pb.RowValue {
+	s, ok := getMaskDataString(data)
+	if !ok {
+		return maskUnsupportedData(m, data)
+	}
+	masked, err := m.cipher(s, true)
+	if err != nil {
+		// The data cannot be encrypted in format, for example, the domain is too small to be secure.
+		return NewDefaultFullMasker().Mask(data)
+	}
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: masked,
+		},
+	}
+}
+
+// Unmask decrypts the data masked by the masker.
+func (m *FormatPreservingMasker) Unmask(s string) (string, error) {
+	return m.cipher(s, false)
+}
+
+// Equal implements Masker.Equal.
+func (m *FormatPreservingMasker) Equal(other Masker) bool {
+	if otherMasker, ok := other.(*FormatPreservingMasker); ok {
+		return m.format == otherMasker.format && m.mode == otherMasker.mode && hmac.Equal(m.key, otherMasker.key) && m.tweak == otherMasker.tweak
+	}
+	return false
+}
+
+func (m *FormatPreservingMasker) cipher(s string, encrypt bool) (string, error) {
+	runes := []rune(s)
+	switch m.format {
+	case storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_NUMBER,
+		storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_PHONE_NUMBER:
+		if err := cipherRunes(m.digitCipher, digitAlphabet, runes, encrypt); err != nil {
+			return "", err
+		}
+	case storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_CREDIT_CARD:
+		var positions []int
+		for i, r := range runes {
+			if r >= '0' && r <= '9' {
+				positions = append(positions, i)
+			}
+		}
+		if len(positions) < 2 {
+			return "", errors.Errorf("%q is not a credit card number", s)
+		}
+		// The check digit is excluded from the encryption and recomputed.
+		checkDigitPosition := positions[len(positions)-1]
+		if err := cipherRunes(m.digitCipher, digitAlphabet, runes[:checkDigitPosition], encrypt); err != nil {
+			return "", err
+		}
+		runes[checkDigitPosition] = luhnCheckDigit(runes[:checkDigitPosition])
+	case storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_EMAIL:
+		at := strings.LastIndex(s, "@")
+		if at < 0 {
+			return "", errors.Errorf("%q is not an email", s)
+		}
+		// Only the local part is encrypted.
+		local := []rune(s[:at])
+		if err := cipherRunes(m.alphanumericCipher, alphanumericAlphabet, local, encrypt); err != nil {
+			return "", err
+		}
+		return string(local) + s[at:], nil
+	default:
+		return "", errors.Errorf("unsupported format %v", m.format)
+	}
+	return string(runes), nil
+}
+
+// cipherRunes encrypts or decrypts the runes in the alphabet in place, and keeps the other runes.
+func cipherRunes(c fpeCipher, alphabet string, runes []rune, encrypt bool) error {
+	var positions, numerals []int
+	for i, r := range runes {
+		if r >= 0x80 {
+			continue
+		}
+		if numeral := strings.IndexRune(alphabet, r); numeral >= 0 {
+			positions = append(positions, i)
+			numerals = append(numerals, numeral)
+		}
+	}
+	var err error
+	if encrypt {
+		numerals, err = c.encrypt(numerals)
+	} else {
+		numerals, err = c.decrypt(numerals)
+	}
+	if err != nil {
+		return err
+	}
+	for i, position := range positions {
+		runes[position] = rune(alphabet[numerals[i]])
+	}
+	return nil
+}
+
+// luhnCheckDigit returns the Luhn check digit of the digits in the runes.
+func luhnCheckDigit(runes []rune) rune {
+	sum := 0
+	double := true
+	for i := len(runes) - 1; i >= 0; i-- {
+		if runes[i] < '0' || runes[i] > '9' {
+			continue
+		}
+		d := int(runes[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return rune('0' + (10-sum%10)%10)
+}
+
+var _ Masker = (*FormatPreservingMasker)(nil)
+
+// TokenizationMasker is the masker that replaces the data with a deterministic token keyed by the key,
+// the same data is always masked to the same token so that the masked data is joinable.
+type TokenizationMasker struct {
+	key    []byte
+	prefix string
+}
+
+// NewTokenizationMasker returns a new TokenizationMasker.
+func NewTokenizationMasker(key []byte, prefix string) *TokenizationMasker {
+	return &TokenizationMasker{
+		key:    key,
+		prefix: prefix,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *TokenizationMasker) Mask(data *MaskData) *v1pb.RowValue {
+	s, ok := getMaskDataString(data)
+	if !ok {
+		return maskUnsupportedData(m, data)
+	}
+	h := hmac.New(sha256.New, m.key)
+	// Writing to the hash never returns an error.
+	_, _ = h.Write([]byte(s))
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: m.prefix + hex.EncodeToString(h.Sum(nil)[:tokenLen]),
+		},
+	}
+}
+
+// Equal implements Masker.Equal.
+func (m *TokenizationMasker) Equal(other Masker) bool {
+	if otherMasker, ok := other.(*TokenizationMasker); ok {
+		return hmac.Equal(m.key, otherMasker.key) && m.prefix == otherMasker.prefix
+	}
+	return false
+}
+
+var _ Masker = (*TokenizationMasker)(nil)
+
+// getMaskDataString returns the string of the data, false if the data is null, bool or a proto value.
+func getMaskDataString(data *MaskData) (string, bool) {
+	if data.Data != nil {
+		switch raw := data.Data.(type) {
+		case *sql.NullString:
+			return raw.String, raw.Valid
+		case *sql.NullInt32:
+			return strconv.FormatInt(int64(raw.Int32), 10), raw.Valid
+		case *sql.NullInt64:
+			return strconv.FormatInt(raw.Int64, 10), raw.Valid
+		case *sql.NullFloat64:
+			return strconv.FormatFloat(raw.Float64, 'f', -1, 64), raw.Valid
+		}
+		return "", false
+	}
+
+	switch kind := data.DataV2.Kind.(type) {
+	case *v1pb.RowValue_BytesValue:
+		return string(kind.BytesValue), true
+	case *v1pb.RowValue_DoubleValue:
+		return strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64), true
+	case *v1pb.RowValue_FloatValue:
+		return strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 64), true
+	case *v1pb.RowValue_Int32Value:
+		return strconv.FormatInt(int64(kind.Int32Value), 10), true
+	case *v1pb.RowValue_Int64Value:
+		return strconv.FormatInt(kind.Int64Value, 10), true
+	case *v1pb.RowValue_StringValue:
+		return kind.StringValue, true
+	case *v1pb.RowValue_Uint32Value:
+		return strconv.FormatUint(uint64(kind.Uint32Value), 10), true
+	case *v1pb.RowValue_Uint64Value:
+		return strconv.FormatUint(kind.Uint64Value, 10), true
+	}
+	return "", false
+}
+
+// maskUnsupportedData masks the data that getMaskDataString doesn't support.
+// The proto value is masked recursively, and the null and bool values are fully masked.
+func maskUnsupportedData(m Masker, data *MaskData) *v1pb.RowValue {
+	if data.Data == nil {
+		if kind, ok := data.DataV2.Kind.(*v1pb.RowValue_ValueValue); ok {
+			return &v1pb.RowValue{
+				Kind: &v1pb.RowValue_ValueValue{
+					ValueValue: maskProtoValue(m, kind.ValueValue),
+				},
+			}
+		}
+	}
+	return NewDefaultFullMasker().Mask(data)
+}
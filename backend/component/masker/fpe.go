@@ -0,0 +1,262 @@
+package masker
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"math"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ff1Rounds is the number of Feistel rounds of FF1.
+	ff1Rounds = 10
+	// ff3Rounds is the number of Feistel rounds of FF3-1.
+	ff3Rounds = 8
+	// ff3TweakLen is the length of the FF3-1 tweak in bytes.
+	ff3TweakLen = 7
+	// fpeMinDomainSize is the minimum domain size radix^len required by NIST SP 800-38G Rev. 1.
+	fpeMinDomainSize = 1000000
+)
+
+// fpeCipher is the format-preserving encryption over the numerals of a radix,
+// specified by NIST SP 800-38G Rev. 1.
+type fpeCipher interface {
+	encrypt(numerals []int) ([]int, error)
+	decrypt(numerals []int) ([]int, error)
+}
+
+// ff1Cipher is the FF1 mode of format-preserving encryption.
+type ff1Cipher struct {
+	block cipher.Block
+	radix int
+	tweak []byte
+}
+
+func newFF1Cipher(key []byte, radix int, tweak []byte) (*ff1Cipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AES cipher")
+	}
+	return &ff1Cipher{block: block, radix: radix, tweak: tweak}, nil
+}
+
+func (c *ff1Cipher) encrypt(numerals []int) ([]int, error) {
+	return c.cipher(numerals, true)
+}
+
+func (c *ff1Cipher) decrypt(numerals []int) ([]int, error) {
+	return c.cipher(numerals, false)
+}
+
+func (c *ff1Cipher) cipher(numerals []int, encrypt bool) ([]int, error) {
+	n := len(numerals)
+	if err := checkFPEDomain(c.radix, n, math.MaxInt32); err != nil {
+		return nil, err
+	}
+	u, v := n/2, n-n/2
+	a, b := numerals[:u], numerals[u:]
+	byteLen := (int(math.Ceil(float64(v)*math.Log2(float64(c.radix)))) + 7) / 8
+	d := 4*((byteLen+3)/4) + 4
+	t := len(c.tweak)
+
+	p := []byte{1, 2, 1, byte(c.radix >> 16), byte(c.radix >> 8), byte(c.radix), 10, byte(u), byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n), byte(t >> 24), byte(t >> 16), byte(t >> 8), byte(t)}
+	padLen := (16 - (t+byteLen+1)%16) % 16
+	q := make([]byte, t+padLen+1+byteLen)
+	copy(q, c.tweak)
+
+	round := func(i int, x []int) *big.Int {
+		q[t+padLen] = byte(i)
+		num(x, c.radix).FillBytes(q[t+padLen+1:])
+		r := c.prf(append(p[:16:16], q...))
+		s := make([]byte, 0, (d+15)/16*16)
+		s = append(s, r...)
+		for j := 1; len(s) < d; j++ {
+			block := make([]byte, 16)
+			copy(block, r)
+			block[12] ^= byte(j >> 24)
+			block[13] ^= byte(j >> 16)
+			block[14] ^= byte(j >> 8)
+			block[15] ^= byte(j)
+			c.block.Encrypt(block, block)
+			s = append(s, block...)
+		}
+		return new(big.Int).SetBytes(s[:d])
+	}
+	modulus := func(i int) *big.Int {
+		return new(big.Int).Exp(big.NewInt(int64(c.radix)), big.NewInt(int64(numeralLen(i, u, v))), nil)
+	}
+
+	if encrypt {
+		for i := 0; i < ff1Rounds; i++ {
+			y := round(i, b)
+			mod := modulus(i)
+			cNum := new(big.Int).Add(num(a, c.radix), y)
+			cNum.Mod(cNum, mod)
+			a, b = b, str(cNum, c.radix, numeralLen(i, u, v))
+		}
+	} else {
+		for i := ff1Rounds - 1; i >= 0; i-- {
+			y := round(i, a)
+			mod := modulus(i)
+			cNum := new(big.Int).Sub(num(b, c.radix), y)
+			cNum.Mod(cNum, mod)
+			a, b = str(cNum, c.radix, numeralLen(i, u, v)), a
+		}
+	}
+	return append(append([]int{}, a...), b...), nil
+}
+
+// prf is the CBC-MAC of the data with the zero IV.
+func (c *ff1Cipher) prf(data []byte) []byte {
+	y := make([]byte, 16)
+	for i := 0; i < len(data); i += 16 {
+		for j := 0; j < 16; j++ {
+			y[j] ^= data[i+j]
+		}
+		c.block.Encrypt(y, y)
+	}
+	return y
+}
+
+// ff3Cipher is the FF3-1 mode of format-preserving encryption.
+type ff3Cipher struct {
+	block  cipher.Block
+	radix  int
+	tweakL []byte
+	tweakR []byte
+}
+
+func newFF3Cipher(key []byte, radix int, tweak []byte) (*ff3Cipher, error) {
+	if len(tweak) != ff3TweakLen {
+		return nil, errors.Errorf("the tweak of FF3-1 must be %d bytes", ff3TweakLen)
+	}
+	return newFF3CipherWithTweak(key, radix, []byte{tweak[0], tweak[1], tweak[2], tweak[3] & 0xF0}, []byte{tweak[4], tweak[5], tweak[6], tweak[3] << 4})
+}
+
+// newFF3CipherWithTweak creates the cipher with the left and right halves of the 64-bit tweak.
+func newFF3CipherWithTweak(key []byte, radix int, tweakL, tweakR []byte) (*ff3Cipher, error) {
+	// The key is byte-reversed in FF3-1.
+	reversedKey := make([]byte, len(key))
+	for i := range key {
+		reversedKey[i] = key[len(key)-1-i]
+	}
+	block, err := aes.NewCipher(reversedKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AES cipher")
+	}
+	return &ff3Cipher{block: block, radix: radix, tweakL: tweakL, tweakR: tweakR}, nil
+}
+
+func (c *ff3Cipher) encrypt(numerals []int) ([]int, error) {
+	return c.cipher(numerals, true)
+}
+
+func (c *ff3Cipher) decrypt(numerals []int) ([]int, error) {
+	return c.cipher(numerals, false)
+}
+
+func (c *ff3Cipher) cipher(numerals []int, encrypt bool) ([]int, error) {
+	n := len(numerals)
+	maxLen := 2 * int(math.Floor(96/math.Log2(float64(c.radix))))
+	if err := checkFPEDomain(c.radix, n, maxLen); err != nil {
+		return nil, err
+	}
+	u, v := (n+1)/2, n/2
+	a, b := numerals[:u], numerals[u:]
+
+	round := func(i int, x []int) *big.Int {
+		w := c.tweakL
+		if i%2 == 0 {
+			w = c.tweakR
+		}
+		p := make([]byte, 16)
+		copy(p, w)
+		p[3] ^= byte(i)
+		num(reverse(x), c.radix).FillBytes(p[4:])
+		reverseBytes(p)
+		c.block.Encrypt(p, p)
+		reverseBytes(p)
+		return new(big.Int).SetBytes(p)
+	}
+	modulus := func(i int) (*big.Int, int) {
+		m := numeralLen(i, u, v)
+		return new(big.Int).Exp(big.NewInt(int64(c.radix)), big.NewInt(int64(m)), nil), m
+	}
+
+	if encrypt {
+		for i := 0; i < ff3Rounds; i++ {
+			y := round(i, b)
+			mod, m := modulus(i)
+			cNum := new(big.Int).Add(num(reverse(a), c.radix), y)
+			cNum.Mod(cNum, mod)
+			a, b = b, reverse(str(cNum, c.radix, m))
+		}
+	} else {
+		for i := ff3Rounds - 1; i >= 0; i-- {
+			y := round(i, a)
+			mod, m := modulus(i)
+			cNum := new(big.Int).Sub(num(reverse(b), c.radix), y)
+			cNum.Mod(cNum, mod)
+			a, b = reverse(str(cNum, c.radix, m)), a
+		}
+	}
+	return append(append([]int{}, a...), b...), nil
+}
+
+// checkFPEDomain checks the length of the numerals is in the domain of the format-preserving encryption.
+func checkFPEDomain(radix, n, maxLen int) error {
+	if n < 2 || n > maxLen {
+		return errors.Errorf("the length %d is out of the range [2, %d]", n, maxLen)
+	}
+	if math.Pow(float64(radix), float64(n)) < fpeMinDomainSize {
+		return errors.Errorf("the domain size %d^%d is less than %d", radix, n, fpeMinDomainSize)
+	}
+	return nil
+}
+
+func numeralLen(i, u, v int) int {
+	if i%2 == 0 {
+		return u
+	}
+	return v
+}
+
+// num returns the number of the numerals in the radix, the first numeral is the most significant.
+func num(numerals []int, radix int) *big.Int {
+	r := big.NewInt(int64(radix))
+	x := new(big.Int)
+	for _, numeral := range numerals {
+		x.Mul(x, r)
+		x.Add(x, big.NewInt(int64(numeral)))
+	}
+	return x
+}
+
+// str returns the m numerals of the number in the radix, the first numeral is the most significant.
+func str(x *big.Int, radix, m int) []int {
+	r := big.NewInt(int64(radix))
+	x = new(big.Int).Set(x)
+	numerals := make([]int, m)
+	mod := new(big.Int)
+	for i := m - 1; i >= 0; i-- {
+		x.DivMod(x, r, mod)
+		numerals[i] = int(mod.Int64())
+	}
+	return numerals
+}
+
+func reverse(numerals []int) []int {
+	reversed := make([]int, len(numerals))
+	for i, numeral := range numerals {
+		reversed[len(numerals)-1-i] = numeral
+	}
+	return reversed
+}
+
+func reverseBytes(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
@@ -2,10 +2,13 @@ package masker
 
 import (
 	"database/sql"
+	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -321,3 +324,139 @@ func TestInnerOuterMask(t *testing.T) {
 		a.Equal(tc.want, got)
 	}
 }
+
+func TestFPECipher(t *testing.T) {
+	// The samples are from NIST SP 800-38G.
+	testCases := []struct {
+		mode      storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_Mode
+		key       string
+		tweak     string
+		plaintext string
+		want      string
+	}{
+		{
+			mode:      storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_FF1,
+			key:       "2B7E151628AED2A6ABF7158809CF4F3C",
+			plaintext: "0123456789",
+			want:      "2433477484",
+		},
+		{
+			mode:      storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_FF1,
+			key:       "2B7E151628AED2A6ABF7158809CF4F3C",
+			tweak:     "39383736353433323130",
+			plaintext: "0123456789",
+			want:      "6124200773",
+		},
+		{
+			mode:      storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_FF3_1,
+			key:       "EF4359D8D580AA4F7F036D6F04FC6A94",
+			tweak:     "D8E7920AFA330A73",
+			plaintext: "890121234567890000",
+			want:      "750918814058654607",
+		},
+	}
+
+	a := require.New(t)
+	for _, tc := range testCases {
+		key, err := hex.DecodeString(tc.key)
+		a.NoError(err)
+		tweak, err := hex.DecodeString(tc.tweak)
+		a.NoError(err)
+		var c fpeCipher
+		if tc.mode == storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_FF3_1 {
+			c, err = newFF3CipherWithTweak(key, 10, tweak[:4], tweak[4:])
+		} else {
+			c, err = newFF1Cipher(key, 10, tweak)
+		}
+		a.NoError(err)
+
+		var numerals []int
+		for _, r := range tc.plaintext {
+			numerals = append(numerals, int(r-'0'))
+		}
+		got, err := c.encrypt(numerals)
+		a.NoError(err)
+		var sb strings.Builder
+		for _, numeral := range got {
+			sb.WriteByte(digitAlphabet[numeral])
+		}
+		a.Equal(tc.want, sb.String())
+
+		decrypted, err := c.decrypt(got)
+		a.NoError(err)
+		a.Equal(numerals, decrypted)
+	}
+}
+
+func TestFormatPreservingMask(t *testing.T) {
+	testCases := []struct {
+		format storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_Format
+		input  string
+		// pattern is the input with the encrypted characters replaced by "#".
+		pattern string
+	}{
+		{
+			format:  storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_NUMBER,
+			input:   "-1234567.89",
+			pattern: "-#######.##",
+		},
+		{
+			format:  storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_PHONE_NUMBER,
+			input:   "+1 (415) 555-0132",
+			pattern: "+# (###) ###-####",
+		},
+		{
+			format:  storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_CREDIT_CARD,
+			input:   "4111-1111-1111-1111",
+			pattern: "####-####-####-####",
+		},
+		{
+			format:  storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_EMAIL,
+			input:   "john.doe@example.com",
+			pattern: "####.###@example.com",
+		},
+	}
+
+	a := require.New(t)
+	key := []byte("0123456789abcdef0123456789abcdef")
+	for _, mode := range []storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_Mode{
+		storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_FF1,
+		storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_FF3_1,
+	} {
+		for _, tc := range testCases {
+			m, err := NewFormatPreservingMasker(tc.format, mode, key, "tweak")
+			a.NoError(err)
+			masked := m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: tc.input}}}).GetStringValue()
+			a.NotEqual(tc.input, masked)
+			a.Len(masked, len(tc.pattern))
+			for i := range tc.pattern {
+				if tc.pattern[i] != '#' {
+					a.Equal(tc.pattern[i], masked[i], masked)
+				}
+			}
+			if tc.format == storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_CREDIT_CARD {
+				digits := []rune(strings.ReplaceAll(masked, "-", ""))
+				a.Equal(digits[len(digits)-1], luhnCheckDigit(digits[:len(digits)-1]), masked)
+			}
+
+			unmasked, err := m.Unmask(masked)
+			a.NoError(err)
+			a.Equal(tc.input, unmasked)
+		}
+	}
+
+	// The domain is too small to be encrypted securely.
+	m, err := NewFormatPreservingMasker(storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_NUMBER, storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_FF1, key, "")
+	a.NoError(err)
+	a.Equal("******", m.Mask(&MaskData{Data: &sql.NullInt64{Int64: 42, Valid: true}}).GetStringValue())
+}
+
+func TestTokenizationMask(t *testing.T) {
+	a := require.New(t)
+	m := NewTokenizationMasker([]byte("key"), "tok_")
+	token := m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 42}}}).GetStringValue()
+	a.Equal(token, m.Mask(&MaskData{Data: &sql.NullString{String: "42", Valid: true}}).GetStringValue())
+	a.True(strings.HasPrefix(token, "tok_"))
+	a.Len(token, len("tok_")+2*tokenLen)
+	a.NotEqual(token, NewTokenizationMasker([]byte("another key"), "tok_").Mask(&MaskData{Data: &sql.NullString{String: "42", Valid: true}}).GetStringValue())
+}
@@ -42,6 +42,8 @@ type State struct {
 	RunningPlanChecks sync.Map
 	// InstanceOutstandingConnections is the maximum number of connections per instance.
 	InstanceOutstandingConnections *connectionLimiter
+	// TransactionGroups is the set of task groups whose changes are committed together.
+	TransactionGroups *TransactionGroups
 
 	// IssueExternalApprovalRelayCancelChan cancels the external approval from relay for issue issueUID.
 	IssueExternalApprovalRelayCancelChan chan int
@@ -65,6 +67,7 @@ func New() (*State, error) {
 	return &State{
 		InstanceSlowQuerySyncChan:            make(chan *InstanceSlowQuerySyncMessage, 100),
 		InstanceOutstandingConnections:       &connectionLimiter{connections: map[int]int{}},
+		TransactionGroups:                    &TransactionGroups{groups: map[string]*TransactionGroup{}},
 		IssueExternalApprovalRelayCancelChan: make(chan int, 1),
 		TaskSkippedOrDoneChan:                make(chan int, 1000),
 		PlanCheckTickleChan:                  make(chan int, 1000),
@@ -0,0 +1,88 @@
+package state
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// TransactionGroups coordinates the tasks whose changes are committed together.
+// Each member prepares its change in an open transaction and waits for the decision of the group.
+// The group decides to commit once every member has prepared, and to roll back once any member fails.
+type TransactionGroups struct {
+	sync.Mutex
+	groups map[string]*TransactionGroup
+}
+
+// TransactionGroup is a group of tasks whose changes are committed together.
+type TransactionGroup struct {
+	// ID is the random id of the attempt of the group, the members re-run later join a group with a new ID.
+	ID     string
+	parent *TransactionGroups
+	key    string
+
+	size     int
+	prepared int
+	commit   bool
+	done     chan struct{}
+}
+
+// Join joins the group of the key with the given number of members.
+// A member joining after the group is decided joins a new group.
+func (g *TransactionGroups) Join(key string, size int) *TransactionGroup {
+	g.Lock()
+	defer g.Unlock()
+	group, ok := g.groups[key]
+	if !ok {
+		group = &TransactionGroup{ID: uuid.NewString(), parent: g, key: key, size: size, done: make(chan struct{})}
+		g.groups[key] = group
+	}
+	return group
+}
+
+// Prepare marks the member as prepared, and waits for the decision of the group.
+// It returns true if all members have prepared within the timeout, and false if any member fails or times out.
+func (t *TransactionGroup) Prepare(ctx context.Context, timeout time.Duration) (bool, error) {
+	t.parent.Lock()
+	t.prepared++
+	if t.prepared >= t.size {
+		t.decideLocked(true)
+	}
+	t.parent.Unlock()
+
+	select {
+	case <-t.done:
+		return t.commit, nil
+	case <-time.After(timeout):
+		t.Fail()
+		<-t.done
+		return t.commit, errors.Errorf("timed out waiting for the other tasks in the transaction group")
+	case <-ctx.Done():
+		t.Fail()
+		<-t.done
+		return t.commit, ctx.Err()
+	}
+}
+
+// Fail rolls back the group if it has not been decided.
+func (t *TransactionGroup) Fail() {
+	t.parent.Lock()
+	defer t.parent.Unlock()
+	t.decideLocked(false)
+}
+
+func (t *TransactionGroup) decideLocked(commit bool) {
+	select {
+	case <-t.done:
+		return
+	default:
+	}
+	t.commit = commit
+	close(t.done)
+	if t.parent.groups[t.key] == t {
+		delete(t.parent.groups, t.key)
+	}
+}
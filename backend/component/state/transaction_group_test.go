@@ -0,0 +1,51 @@
+package state
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionGroupCommit(t *testing.T) {
+	a := require.New(t)
+	groups := &TransactionGroups{groups: map[string]*TransactionGroup{}}
+
+	var wg sync.WaitGroup
+	results := make([]bool, 3)
+	errs := make([]error, 3)
+	for i := range results {
+		group := groups.Join("1/spec", len(results))
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = group.Prepare(context.Background(), time.Minute)
+		}(i)
+	}
+	wg.Wait()
+	a.Equal([]error{nil, nil, nil}, errs)
+	a.Equal([]bool{true, true, true}, results)
+	a.Empty(groups.groups)
+}
+
+func TestTransactionGroupRollback(t *testing.T) {
+	a := require.New(t)
+	groups := &TransactionGroups{groups: map[string]*TransactionGroup{}}
+
+	prepared := groups.Join("1/spec", 2)
+	failed := groups.Join("1/spec", 2)
+	a.Equal(prepared.ID, failed.ID)
+	failed.Fail()
+	commit, err := prepared.Prepare(context.Background(), time.Minute)
+	a.NoError(err)
+	a.False(commit)
+
+	// A member joining after the decision joins a new group.
+	group := groups.Join("1/spec", 2)
+	a.NotEqual(prepared.ID, group.ID)
+	commit, err = group.Prepare(context.Background(), time.Millisecond)
+	a.Error(err)
+	a.False(commit)
+}
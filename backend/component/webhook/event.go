@@ -14,6 +14,7 @@ const (
 	EventTypeIssueCommentCreate  = "bb.webhook.event.issue.comment.create"
 	EventTypeIssueApprovalCreate = "bb.webhook.event.issue.approval.create"
 	EventTypeIssueApprovalPass   = "bb.webhook.event.issue.approval.pass"
+	EventTypeIssueApprovalReject = "bb.webhook.event.issue.approval.reject"
 	EventTypeIssueRolloutReady   = "bb.webhook.event.issue.rollout.ready"
 
 	EventTypeStageStatusUpdate   = "bb.webhook.event.stage.status.update"
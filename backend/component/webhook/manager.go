@@ -40,6 +40,9 @@ func NewManager(store *store.Store) *Manager {
 }
 
 func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
+	// Sync the issue status to the pull request or the commit that the issue is created from.
+	go m.syncToVCS(context.WithoutCancel(ctx), e)
+
 	var activityType api.ActivityType
 	//exhaustive:enforce
 	switch e.Type {
@@ -55,6 +58,9 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 		activityType = api.ActivityIssueApprovalNotify
 	case EventTypeIssueApprovalPass:
 		activityType = api.ActivityNotifyIssueApproved
+	case EventTypeIssueApprovalReject:
+		// There is no project webhook activity for the rejection.
+		return
 	case EventTypeIssueRolloutReady:
 		activityType = api.ActivityNotifyPipelineRollout
 	case EventTypeStageStatusUpdate:
@@ -0,0 +1,167 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// vcsSync is the status of the issue synced to the pull request or the commit that the issue is created from.
+type vcsSync struct {
+	state       vcs.CommitState
+	description string
+	// comment is posted on the pull request if not empty.
+	comment string
+}
+
+// getVCSSync gets the status to sync to VCS for the event, and returns nil if the event is not synced.
+func getVCSSync(e *Event) *vcsSync {
+	actor := "Bytebase"
+	if e.Actor != nil {
+		actor = e.Actor.Name
+	}
+	switch e.Type {
+	case EventTypeIssueApprovalPass:
+		return &vcsSync{
+			state:       vcs.CommitStatePending,
+			description: "Approved, waiting for rollout",
+		}
+	case EventTypeIssueApprovalReject:
+		comment := fmt.Sprintf("❌ The Bytebase issue is rejected by %s.", actor)
+		if e.Comment != "" {
+			comment += fmt.Sprintf("\n\n> %s", e.Comment)
+		}
+		return &vcsSync{
+			state:       vcs.CommitStateFailure,
+			description: "Rejected",
+			comment:     comment,
+		}
+	case EventTypeTaskRunStatusUpdate:
+		if u := e.TaskRunStatusUpdate; u != nil && u.Status == api.TaskRunFailed.String() {
+			return &vcsSync{
+				state:       vcs.CommitStateFailure,
+				description: fmt.Sprintf("Task %q failed", u.Title),
+				comment:     fmt.Sprintf("❌ The Bytebase task %q failed.\n\n```\n%s\n```", u.Title, strings.TrimSpace(u.Detail)),
+			}
+		}
+	case EventTypeIssueStatusUpdate:
+		switch e.Issue.Status {
+		case api.IssueDone.String():
+			return &vcsSync{
+				state:       vcs.CommitStateSuccess,
+				description: "Rolled out",
+				comment:     "✅ The Bytebase issue is rolled out.",
+			}
+		case api.IssueCanceled.String():
+			return &vcsSync{
+				state:       vcs.CommitStateFailure,
+				description: "Canceled",
+				comment:     fmt.Sprintf("The Bytebase issue is canceled by %s.", actor),
+			}
+		case api.IssueOpen.String():
+			return &vcsSync{
+				state:       vcs.CommitStatePending,
+				description: "Reopened",
+			}
+		}
+	}
+	return nil
+}
+
+// syncToVCS sets the commit status and posts the comment on the pull request for the issues created by GitOps.
+func (m *Manager) syncToVCS(ctx context.Context, e *Event) {
+	if e.Issue == nil {
+		return
+	}
+	sync := getVCSSync(e)
+	if sync == nil {
+		return
+	}
+	if err := m.syncToVCSImpl(ctx, e, sync); err != nil {
+		slog.Warn("failed to sync issue status to VCS", slog.Int("issue_uid", e.Issue.UID), log.BBError(err))
+	}
+}
+
+func (m *Manager) syncToVCSImpl(ctx context.Context, e *Event, sync *vcsSync) error {
+	issue, err := m.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &e.Issue.UID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue")
+	}
+	if issue == nil || issue.PlanUID == nil {
+		return nil
+	}
+	plan, err := m.store.GetPlan(ctx, &store.FindPlanMessage{UID: issue.PlanUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get plan")
+	}
+	if plan == nil {
+		return nil
+	}
+	vcsSource := plan.Config.GetVcsSource()
+	if vcsSource.GetVcsConnector() == "" || vcsSource.GetCommitId() == "" {
+		return nil
+	}
+
+	projectID, vcsConnectorID, err := common.GetProjectVCSConnectorID(vcsSource.GetVcsConnector())
+	if err != nil {
+		return err
+	}
+	vcsConnector, err := m.store.GetVCSConnector(ctx, &store.FindVCSConnectorMessage{ProjectID: &projectID, ResourceID: &vcsConnectorID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get VCS connector %q", vcsSource.GetVcsConnector())
+	}
+	if vcsConnector == nil {
+		return nil
+	}
+	vcsProvider, err := m.store.GetVCSProvider(ctx, &store.FindVCSProviderMessage{ResourceID: &vcsConnector.VCSResourceID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get VCS provider %q", vcsConnector.VCSResourceID)
+	}
+	if vcsProvider == nil {
+		return nil
+	}
+	setting, err := m.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get workspace general setting")
+	}
+	targetURL := ""
+	if setting.ExternalUrl != "" {
+		targetURL = fmt.Sprintf("%s/%s", setting.ExternalUrl, common.FormatIssue(issue.Project.ResourceID, issue.UID))
+	}
+
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	repositoryID := vcsConnector.Payload.ExternalId
+	if err := provider.SetCommitStatus(ctx, repositoryID, vcsSource.GetCommitId(), &vcs.CommitStatus{
+		State:       sync.state,
+		Context:     vcs.RolloutCommitStatusContext,
+		Description: sync.description,
+		TargetURL:   targetURL,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to set commit status")
+	}
+	if sync.comment == "" || vcsSource.GetPullRequestUrl() == "" {
+		return nil
+	}
+	comment := sync.comment
+	if targetURL != "" {
+		comment += fmt.Sprintf("\n\nCheck out the issue at %s.", targetURL)
+	}
+	if err := provider.CreatePullRequestComment(ctx, repositoryID, getPullRequestID(vcsSource.GetPullRequestUrl()), comment); err != nil {
+		return errors.Wrapf(err, "failed to create pull request comment")
+	}
+	return nil
+}
+
+func getPullRequestID(url string) string {
+	fields := strings.Split(strings.TrimSuffix(url, "/"), "/")
+	return fields[len(fields)-1]
+}
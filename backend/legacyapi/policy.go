@@ -48,6 +48,12 @@ const (
 	PolicyTypeProjectIAM PolicyType = "bb.policy.project-iam"
 	// PolicyTypeTag is the policy type for resource tags.
 	PolicyTypeTag PolicyType = "bb.policy.tag"
+	// PolicyTypeRowFilter is the row filter policy type.
+	PolicyTypeRowFilter PolicyType = "bb.policy.row-filter"
+	// PolicyTypeQueryCostGuard is the query cost guard policy type.
+	PolicyTypeQueryCostGuard PolicyType = "bb.policy.query-cost-guard"
+	// PolicyTypeQueryHistoryRetention is the query history retention policy type.
+	PolicyTypeQueryHistoryRetention PolicyType = "bb.policy.query-history-retention"
 
 	// PipelineApprovalValueManualNever means the pipeline will automatically be approved without user intervention.
 	PipelineApprovalValueManualNever PipelineApprovalValue = "MANUAL_APPROVAL_NEVER"
@@ -93,6 +99,9 @@ var (
 		PolicyTypeMaskingRule:                       {PolicyResourceTypeWorkspace},
 		PolicyTypeMaskingException:                  {PolicyResourceTypeProject},
 		PolicyTypeRestrictIssueCreationForSQLReview: {PolicyResourceTypeWorkspace, PolicyResourceTypeProject},
+		PolicyTypeRowFilter:                         {PolicyResourceTypeProject},
+		PolicyTypeQueryCostGuard:                    {PolicyResourceTypeWorkspace},
+		PolicyTypeQueryHistoryRetention:             {PolicyResourceTypeWorkspace, PolicyResourceTypeProject},
 	}
 )
 
@@ -44,6 +44,8 @@ const (
 	SettingSemanticTypes SettingName = "bb.workspace.semantic-types"
 	// SettingMaskingAlgorithms is the setting name for masking algorithms.
 	SettingMaskingAlgorithm SettingName = "bb.workspace.masking-algorithm"
+	// SettingMaskingKey is the setting name for the workspace key of the format-preserving and tokenization masking algorithms.
+	SettingMaskingKey SettingName = "bb.workspace.masking-key"
 )
 
 // SettingWorkspaceMailDeliveryValue is the setting value of SettingMailDelivery type setting.
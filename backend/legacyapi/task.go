@@ -93,6 +93,8 @@ type TaskDatabaseSchemaUpdatePayload struct {
 
 	SheetID       int    `json:"sheetId,omitempty"`
 	SchemaVersion string `json:"schemaVersion,omitempty"`
+	// Transactional is true if the task is committed together with the other tasks of the same spec in the stage.
+	Transactional bool `json:"transactional,omitempty"`
 }
 
 // TaskDatabaseSchemaUpdateSDLPayload is the task payload for database schema update (SDL).
@@ -142,6 +144,9 @@ type TaskDatabaseDataUpdatePayload struct {
 	SchemaVersion string `json:"schemaVersion,omitempty"`
 
 	PreUpdateBackupDetail PreUpdateBackupDetail `json:"preUpdateBackupDetail,omitempty"`
+	BatchDetail           *BatchDetail          `json:"batchDetail,omitempty"`
+	// Transactional is true if the task is committed together with the other tasks of the same spec in the stage.
+	Transactional bool `json:"transactional,omitempty"`
 }
 
 type PreUpdateBackupDetail struct {
@@ -150,6 +155,13 @@ type PreUpdateBackupDetail struct {
 	Database string `json:"database,omitempty"`
 }
 
+// BatchDetail is the detail for executing the data update in batches ranged by the primary key.
+type BatchDetail struct {
+	BatchSize            int `json:"batchSize,omitempty"`
+	SleepIntervalMs      int `json:"sleepIntervalMs,omitempty"`
+	MaxReplicaLagSeconds int `json:"maxReplicaLagSeconds,omitempty"`
+}
+
 // TaskDatabaseDataExportPayload is the task payload for database data export.
 type TaskDatabaseDataExportPayload struct {
 	// Common fields
@@ -224,6 +236,8 @@ type TaskPatch struct {
 	ExportFormat          *storepb.ExportFormat
 	ExportPassword        *string
 	PreUpdateBackupDetail *PreUpdateBackupDetail
+	// BatchDetail with zero batch size unsets the batch detail.
+	BatchDetail *BatchDetail
 
 	// Flags for gh-ost.
 	Flags *map[string]string
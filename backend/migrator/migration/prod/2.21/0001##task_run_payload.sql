@@ -0,0 +1 @@
+ALTER TABLE task_run ADD COLUMN payload JSONB NOT NULL DEFAULT '{}';
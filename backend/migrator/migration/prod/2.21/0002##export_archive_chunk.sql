@@ -0,0 +1,6 @@
+CREATE TABLE export_archive_chunk (
+  archive_id INTEGER NOT NULL REFERENCES export_archive (id) ON DELETE CASCADE,
+  chunk INTEGER NOT NULL,
+  bytes BYTEA NOT NULL,
+  PRIMARY KEY (archive_id, chunk)
+);
@@ -0,0 +1,11 @@
+CREATE TABLE query_result_share (
+  id TEXT PRIMARY KEY,
+  creator_id INTEGER NOT NULL REFERENCES principal (id),
+  created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+  expire_ts BIGINT NOT NULL,
+  database TEXT NOT NULL,
+  statement TEXT NOT NULL,
+  results BYTEA NOT NULL
+);
+
+CREATE INDEX idx_query_result_share_expire_ts ON query_result_share(expire_ts);
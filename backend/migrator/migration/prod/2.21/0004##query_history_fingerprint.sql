@@ -0,0 +1,20 @@
+ALTER TABLE query_history ADD COLUMN fingerprint TEXT NOT NULL DEFAULT '';
+
+-- The existing histories are fingerprinted by replacing the string and numeric literals, the same as the engines without fingerprinting parsers.
+WITH normalized AS (
+  SELECT
+    id,
+    rtrim(regexp_replace(btrim(regexp_replace(regexp_replace(statement, '''(?:[^''\\]|''''|\\.)*''', '?', 'g'), '\y\d+(?:\.\d+)?\y', '?', 'g'), E' \t\r\n'), '\s+', ' ', 'g'), '; ') AS fingerprint
+  FROM query_history
+)
+UPDATE query_history
+SET fingerprint = CASE
+  WHEN char_length(normalized.fingerprint) > 512 THEN left(normalized.fingerprint, 512) || ' /* ' || left(encode(sha256(convert_to(normalized.fingerprint, 'UTF8')), 'hex'), 16) || ' */'
+  ELSE normalized.fingerprint
+END
+FROM normalized
+WHERE query_history.id = normalized.id;
+
+CREATE INDEX idx_query_history_project_id_fingerprint ON query_history(project_id, fingerprint);
+
+CREATE INDEX idx_query_history_statement_tsvector ON query_history USING GIN (to_tsvector('simple', left(statement, 100000)));
@@ -0,0 +1 @@
+ALTER TABLE query_result_share ADD COLUMN row_filter_statement TEXT NOT NULL DEFAULT '';
@@ -477,7 +477,8 @@ CREATE TABLE task_run (
     started_ts BIGINT NOT NULL DEFAULT 0,
     code INTEGER NOT NULL DEFAULT 0,
     -- result saves the task run result in json format
-    result  JSONB NOT NULL DEFAULT '{}'
+    result  JSONB NOT NULL DEFAULT '{}',
+    payload JSONB NOT NULL DEFAULT '{}'
 );
 
 CREATE INDEX idx_task_run_task_id ON task_run(task_id);
@@ -705,13 +706,32 @@ CREATE TABLE query_history (
     database TEXT NOT NULL, -- the database resource name, for example, instances/{instance}/databases/{database}
     statement TEXT NOT NULL,
     type TEXT NOT NULL, -- the history type, support QUERY and EXPORT.
-    payload JSONB NOT NULL DEFAULT '{}' -- saved for details, like error, duration, etc.
+    payload JSONB NOT NULL DEFAULT '{}', -- saved for details, like error, duration, etc.
+    fingerprint TEXT NOT NULL DEFAULT '' -- the normalized template of the statement.
 );
 
 CREATE INDEX idx_query_history_creator_id_created_ts_project_id ON query_history(creator_id, created_ts, project_id DESC);
 
+CREATE INDEX idx_query_history_project_id_fingerprint ON query_history(project_id, fingerprint);
+
+CREATE INDEX idx_query_history_statement_tsvector ON query_history USING GIN (to_tsvector('simple', left(statement, 100000)));
+
 ALTER SEQUENCE query_history_id_seq RESTART WITH 101;
 
+-- query_result_share table stores the snapshots of the query results shared by links.
+CREATE TABLE query_result_share (
+    id TEXT PRIMARY KEY, -- the random id in the link
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    expire_ts BIGINT NOT NULL,
+    database TEXT NOT NULL, -- the database resource name, for example, instances/{instance}/databases/{database}
+    statement TEXT NOT NULL,
+    results BYTEA NOT NULL, -- the serialized masked query results
+    row_filter_statement TEXT NOT NULL DEFAULT '' -- the statement rewritten with the row filters of the creator, empty if no row filter is applied
+);
+
+CREATE INDEX idx_query_result_share_expire_ts ON query_result_share(expire_ts);
+
 -- vcs table stores the version control provider config
 CREATE TABLE vcs (
     id SERIAL PRIMARY KEY,
@@ -950,6 +970,13 @@ CREATE TABLE export_archive (
   payload JSONB NOT NULL DEFAULT '{}'
 );
 
+CREATE TABLE export_archive_chunk (
+  archive_id INTEGER NOT NULL REFERENCES export_archive (id) ON DELETE CASCADE,
+  chunk INTEGER NOT NULL,
+  bytes BYTEA NOT NULL,
+  PRIMARY KEY (archive_id, chunk)
+);
+
 CREATE TABLE sql_lint_config (
   id TEXT NOT NULL PRIMARY KEY,
   creator_id INTEGER NOT NULL REFERENCES principal (id),
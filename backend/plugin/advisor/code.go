@@ -191,6 +191,9 @@ const (
 
 	// 1901 ~ 1999 schema error code.
 	SchemaNotExists Code = 1901
+
+	// 2001 ~ 2099 query error code.
+	ExpensiveQuery Code = 2001
 )
 
 // Int returns the int type of code.
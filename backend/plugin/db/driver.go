@@ -352,6 +352,33 @@ type ExecuteOptions struct {
 	CreateDatabase        bool
 	UpdateExecutionStatus func(*v1pb.TaskRun_ExecutionDetail)
 	CreateTaskRunLog      func(time.Time, *storepb.TaskRunLog) error
+
+	// CommittedCommandIndexes are the indexes of the commands committed by a previous task run.
+	// Drivers supporting resumable execution skip these commands.
+	CommittedCommandIndexes map[int32]bool
+	// CommitCommands is called with the indexes of the commands once they are committed.
+	// The execution stops if the committed commands cannot be persisted, otherwise a resume re-runs them.
+	CommitCommands func(commandIndexes []int32) error
+}
+
+// IsCommandCommitted returns true if all the commands have been committed by a previous task run.
+func (o *ExecuteOptions) IsCommandCommitted(commandIndexes []int32) bool {
+	if o == nil || len(o.CommittedCommandIndexes) == 0 || len(commandIndexes) == 0 {
+		return false
+	}
+	for _, index := range commandIndexes {
+		if !o.CommittedCommandIndexes[index] {
+			return false
+		}
+	}
+	return true
+}
+
+func (o *ExecuteOptions) LogCommandCommit(commandIndexes []int32) error {
+	if o == nil || o.CommitCommands == nil || len(commandIndexes) == 0 {
+		return nil
+	}
+	return o.CommitCommands(commandIndexes)
 }
 
 func (o *ExecuteOptions) LogSchemaDumpStart() {
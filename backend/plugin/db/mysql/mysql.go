@@ -45,6 +45,9 @@ var (
 
 	variableSetStmtRegexp  = regexp.MustCompile(`(?i)^SET\s+?`)
 	variableShowStmtRegexp = regexp.MustCompile(`(?i)^SHOW\s+?`)
+	// DDL statements cause an implicit commit of the current transaction.
+	// https://dev.mysql.com/doc/refman/8.0/en/implicit-commit.html
+	implicitCommitStmtRegexp = regexp.MustCompile(`(?i)^(CREATE|ALTER|DROP|RENAME|TRUNCATE)\s+`)
 )
 
 func init() {
@@ -338,6 +341,8 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 	}
 
 	var totalRowsAffected int64
+	// uncommittedIndexes are the indexes of the executed commands which are not committed yet.
+	var uncommittedIndexes []int32
 
 	if err := conn.Raw(func(driverConn any) error {
 		//nolint
@@ -351,6 +356,12 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 		defer tx.Rollback()
 
 		for i, command := range commands {
+			indexes := []int32{originalIndex[i]}
+			// Skip the commands committed by a previous task run if we resume from the failed command.
+			// The SET statements are always replayed because the session variables are not kept across task runs.
+			if opts.IsCommandCommitted(indexes) && !variableSetStmtRegexp.MatchString(strings.TrimSpace(command.Text)) {
+				continue
+			}
 			// Set the progress information for the current chunk.
 			if opts.UpdateExecutionStatus != nil {
 				opts.UpdateExecutionStatus(&v1pb.TaskRun_ExecutionDetail{
@@ -367,7 +378,19 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 				})
 			}
 
-			indexes := []int32{originalIndex[i]}
+			implicitCommit := implicitCommitStmtRegexp.MatchString(strings.TrimSpace(command.Text))
+			if implicitCommit && len(uncommittedIndexes) > 0 {
+				// MySQL commits the pending commands when the statement starts, even if the statement fails then.
+				// They are committed explicitly and recorded before, so that they are not run again on resume.
+				if _, err := exer.ExecContext(ctx, "COMMIT", nil); err != nil {
+					return errors.Wrapf(err, "failed to commit the commands before the implicit commit")
+				}
+				if err := opts.LogCommandCommit(uncommittedIndexes); err != nil {
+					return errors.Wrapf(err, "failed to record committed commands")
+				}
+				uncommittedIndexes = nil
+			}
+
 			opts.LogCommandExecute(indexes)
 
 			sqlResult, err := exer.ExecContext(ctx, command.Text, nil)
@@ -404,11 +427,22 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 			totalRowsAffected += rowsAffected
 
 			opts.LogCommandResponse(indexes, int32(rowsAffected), allRowsAffectedInt32, "")
+
+			uncommittedIndexes = append(uncommittedIndexes, indexes...)
+			if implicitCommit {
+				if err := opts.LogCommandCommit(uncommittedIndexes); err != nil {
+					return errors.Wrapf(err, "failed to record committed commands")
+				}
+				uncommittedIndexes = nil
+			}
 		}
 
 		if err := tx.Commit(); err != nil {
 			return errors.Wrapf(err, "failed to commit execute transaction")
 		}
+		if err := opts.LogCommandCommit(uncommittedIndexes); err != nil {
+			return errors.Wrapf(err, "failed to record committed commands")
+		}
 		return nil
 	}); err != nil {
 		return 0, err
@@ -412,12 +412,18 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 		if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET SESSION ROLE '%s'", owner)); err != nil {
 			return 0, errors.Wrapf(err, "failed to set role to database owner %q", owner)
 		}
+		if opts.IsCommandCommitted([]int32{0}) {
+			return 0, nil
+		}
 		opts.LogCommandExecute([]int32{0})
 		if _, err := conn.ExecContext(ctx, statement); err != nil {
 			opts.LogCommandResponse([]int32{0}, 0, []int32{0}, err.Error())
 			return 0, err
 		}
 		opts.LogCommandResponse([]int32{0}, 0, []int32{0}, "")
+		if err := opts.LogCommandCommit([]int32{0}); err != nil {
+			return 0, errors.Wrapf(err, "failed to record committed commands")
+		}
 
 		return 0, nil
 	}
@@ -427,6 +433,12 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 
 	var nonTransactionAndSetRoleStmts []string
 	for i, singleSQL := range commands {
+		// Skip the commands committed by a previous task run if we resume from the failed command.
+		// The session-level statements such as SET ROLE are always replayed because the session is not kept across task runs,
+		// and the remaining statements must run with the same role and settings.
+		if opts.IsCommandCommitted([]int32{originalIndex[i]}) && !isSessionStatement(singleSQL.Text) {
+			continue
+		}
 		if IsNonTransactionStatement(singleSQL.Text) {
 			nonTransactionAndSetRoleStmts = append(nonTransactionAndSetRoleStmts, singleSQL.Text)
 			nonTransactionAndSetRoleStmtsIndex = append(nonTransactionAndSetRoleStmtsIndex, i)
@@ -543,6 +555,13 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 			if err := tx.Commit(ctx); err != nil {
 				return errors.Wrapf(err, "failed to commit transaction")
 			}
+			var committedIndexes []int32
+			for _, index := range remainingSQLsIndex {
+				committedIndexes = append(committedIndexes, originalIndex[index])
+			}
+			if err := opts.LogCommandCommit(committedIndexes); err != nil {
+				return errors.Wrapf(err, "failed to record committed commands")
+			}
 
 			return nil
 		})
@@ -564,6 +583,10 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 			return 0, err
 		}
 		opts.LogCommandResponse(indexes, 0, []int32{0}, "")
+		// Non-transaction statements are committed once they are executed.
+		if err := opts.LogCommandCommit(indexes); err != nil {
+			return 0, errors.Wrapf(err, "failed to record committed commands")
+		}
 	}
 	return totalRowsAffected, nil
 }
@@ -616,12 +639,18 @@ var (
 	vacuumReg = regexp.MustCompile(`(?i)VACUUM`)
 	// SET ROLE is a special statement that should be run before any other statements containing inside a transaction block or not.
 	setRoleReg = regexp.MustCompile(`(?i)SET\s+((SESSION|LOCAL)\s+)?ROLE`)
+	// SET and RESET statements change the settings of the current session.
+	sessionStmtReg = regexp.MustCompile(`(?i)^\s*(SET|RESET)\s+`)
 )
 
 func isSetRoleStatement(stmt string) bool {
 	return len(setRoleReg.FindString(stmt)) > 0
 }
 
+func isSessionStatement(stmt string) bool {
+	return isSetRoleStatement(stmt) || sessionStmtReg.MatchString(stmt)
+}
+
 func IsNonTransactionStatement(stmt string) bool {
 	if len(dropDatabaseReg.FindString(stmt)) > 0 {
 		return true
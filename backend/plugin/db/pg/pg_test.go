@@ -49,3 +49,21 @@ func TestGetDatabaseInCreateDatabaseStatement(t *testing.T) {
 		require.Equal(t, test.want, got)
 	}
 }
+
+func TestIsSessionStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      bool
+	}{
+		{`SET ROLE "owner";`, true},
+		{`SET search_path TO public;`, true},
+		{"\n  set lock_timeout = '1s';", true},
+		{`RESET ALL;`, true},
+		{`CREATE INDEX CONCURRENTLY idx ON t(a);`, false},
+		{`UPDATE t SET a = 1;`, false},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.want, isSessionStatement(test.statement), test.statement)
+	}
+}
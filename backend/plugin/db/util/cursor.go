@@ -0,0 +1,128 @@
+package util
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// QueryCursor reads the rows of a readonly query in pages from the transaction held by the cursor.
+// The cursor is not safe for concurrent use.
+type QueryCursor struct {
+	dbType          storepb.Engine
+	statement       string
+	tx              *sql.Tx
+	rows            *sql.Rows
+	columnNames     []string
+	columnTypeNames []string
+	// pending is true if the rows are advanced to a row which is not scanned yet.
+	pending bool
+	done    bool
+}
+
+// OpenQueryCursor executes the query in a transaction of the connection and returns the cursor of the rows.
+// The transaction is read-only if readOnly is true, and it must be false for the drivers rejecting read-only transactions.
+// The ctx must outlive the cursor because canceling it closes the rows and rolls back the transaction.
+func OpenQueryCursor(ctx context.Context, dbType storepb.Engine, conn *sql.Conn, statement string, readOnly bool) (*QueryCursor, error) {
+	tx, err := conn.BeginTx(ctx, &sql.TxOptions{ReadOnly: readOnly})
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.QueryContext(ctx, statement)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, FormatErrorWithQuery(err, statement)
+	}
+	c := &QueryCursor{
+		dbType:    dbType,
+		statement: strings.TrimLeft(strings.TrimRight(statement, " \n\t;"), " \n\t"),
+		tx:        tx,
+		rows:      rows,
+	}
+	if c.columnNames, err = rows.Columns(); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	for _, v := range columnTypes {
+		c.columnTypeNames = append(c.columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
+	}
+	return c, nil
+}
+
+// Next reads at most pageSize rows, and fewer rows if the size of the page exceeds the maximum result size.
+func (c *QueryCursor) Next(pageSize int) (*v1pb.QueryResult, error) {
+	if pageSize <= 0 {
+		return nil, errors.Errorf("page size must be positive")
+	}
+	startTime := time.Now()
+	result := &v1pb.QueryResult{
+		ColumnNames:     c.columnNames,
+		ColumnTypeNames: c.columnTypeNames,
+		Statement:       c.statement,
+	}
+	// The oracle driver will panic if there is no rows such as EXPLAIN PLAN FOR statement.
+	if len(c.columnTypeNames) == 0 {
+		c.done = true
+	}
+	for !c.done && len(result.Rows) < pageSize {
+		if !c.pending && !c.rows.Next() {
+			c.done = true
+			if err := c.rows.Err(); err != nil {
+				return nil, err
+			}
+			break
+		}
+		c.pending = false
+		row, err := scanRow(c.dbType, c.rows, c.columnTypeNames)
+		if err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, row)
+		n := len(result.Rows)
+		if (n&(n-1) == 0) && proto.Size(result) > common.MaximumSQLResultSize {
+			break
+		}
+	}
+	// Look ahead so that the caller knows whether there are more rows.
+	if !c.done && !c.pending {
+		if c.rows.Next() {
+			c.pending = true
+		} else {
+			c.done = true
+			if err := c.rows.Err(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	result.Latency = durationpb.New(time.Since(startTime))
+	return result, nil
+}
+
+// Done returns true if all the rows are read.
+func (c *QueryCursor) Done() bool {
+	return c.done
+}
+
+// Close closes the rows and rolls back the transaction.
+func (c *QueryCursor) Close() error {
+	c.done = true
+	if err := c.rows.Close(); err != nil {
+		_ = c.tx.Rollback()
+		return err
+	}
+	return c.tx.Rollback()
+}
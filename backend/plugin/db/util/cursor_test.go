@@ -0,0 +1,122 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// openMockQueryCursor opens the cursor of a query returning the ids from 1 to n.
+func openMockQueryCursor(t *testing.T, n int) (*QueryCursor, sqlmock.Sqlmock) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	rows := sqlmock.NewRowsWithColumnDefinition(
+		sqlmock.NewColumn("id").OfType("INT", int64(0)),
+		sqlmock.NewColumn("name").OfType("VARCHAR", ""),
+	)
+	for i := 1; i <= n; i++ {
+		rows.AddRow(int64(i), "name")
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id, name FROM t").WillReturnRows(rows)
+
+	cursor, err := OpenQueryCursor(ctx, storepb.Engine_POSTGRES, conn, "SELECT id, name FROM t;\n", true)
+	require.NoError(t, err)
+	return cursor, mock
+}
+
+func TestQueryCursorPaging(t *testing.T) {
+	tests := []struct {
+		rows      int
+		pageSize  int
+		wantPages []int
+	}{
+		// The look-ahead finds the last row for the second page, so the third page has the rest.
+		{rows: 5, pageSize: 2, wantPages: []int{2, 2, 1}},
+		// The look-ahead finds no more rows after the second page, so the cursor is done without an empty page.
+		{rows: 4, pageSize: 2, wantPages: []int{2, 2}},
+		{rows: 0, pageSize: 2, wantPages: []int{0}},
+		{rows: 3, pageSize: 10, wantPages: []int{3}},
+	}
+
+	for _, test := range tests {
+		cursor, mock := openMockQueryCursor(t, test.rows)
+		id := int64(0)
+		for i, want := range test.wantPages {
+			require.False(t, cursor.Done())
+			result, err := cursor.Next(test.pageSize)
+			require.NoError(t, err)
+			require.Equal(t, []string{"id", "name"}, result.ColumnNames)
+			require.Equal(t, []string{"INT", "VARCHAR"}, result.ColumnTypeNames)
+			require.Equal(t, "SELECT id, name FROM t", result.Statement)
+			require.Len(t, result.Rows, want)
+			for _, row := range result.Rows {
+				id++
+				require.Equal(t, id, row.Values[0].GetInt64Value())
+			}
+			require.Equal(t, i == len(test.wantPages)-1, cursor.Done(), "page %d of %d rows", i, test.rows)
+		}
+
+		mock.ExpectRollback()
+		require.NoError(t, cursor.Close())
+		require.NoError(t, mock.ExpectationsWereMet())
+	}
+}
+
+func TestQueryCursorError(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	rows := sqlmock.NewRowsWithColumnDefinition(sqlmock.NewColumn("id").OfType("INT", int64(0))).
+		AddRow(int64(1)).
+		AddRow(int64(2)).
+		RowError(1, context.DeadlineExceeded)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM t").WillReturnRows(rows)
+	cursor, err := OpenQueryCursor(ctx, storepb.Engine_MYSQL, conn, "SELECT id FROM t", true)
+	require.NoError(t, err)
+
+	_, err = cursor.Next(0)
+	require.Error(t, err)
+	// The error of the row read by the look-ahead is returned.
+	_, err = cursor.Next(1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.True(t, cursor.Done())
+
+	mock.ExpectRollback()
+	require.NoError(t, cursor.Close())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOpenQueryCursorError(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// The transaction is rolled back if the query fails.
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM t").WillReturnError(context.Canceled)
+	mock.ExpectRollback()
+	_, err = OpenQueryCursor(ctx, storepb.Engine_MYSQL, conn, "SELECT id FROM t", true)
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
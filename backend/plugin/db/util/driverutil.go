@@ -181,55 +181,12 @@ func readRows(result *v1pb.QueryResult, dbType storepb.Engine, rows *sql.Rows, c
 		return nil
 	}
 	for rows.Next() {
-		// wantBytesValue want to convert StringValue to BytesValue when columnTypeName is BIT or VARBIT
-		wantBytesValue := make([]bool, len(columnTypeNames))
-		scanArgs := make([]any, len(columnTypeNames))
-		for i, v := range columnTypeNames {
-			// TODO(steven need help): Consult a common list of data types from database driver documentation. e.g. MySQL,PostgreSQL.
-			if dbType == storepb.Engine_MSSQL {
-				switch v {
-				case "UNIQUEIDENTIFIER":
-					scanArgs[i] = new(mssqldb.UniqueIdentifier)
-					continue
-				case "NULLUNIQUEIDENTIFIER":
-					scanArgs[i] = new(mssqldb.NullUniqueIdentifier)
-					continue
-				case "GEOMETRY":
-					scanArgs[i] = new(sql.NullString)
-					wantBytesValue[i] = true
-					continue
-				}
-			}
-			switch v {
-			case "VARCHAR", "TEXT", "UUID", "TIMESTAMP":
-				scanArgs[i] = new(sql.NullString)
-			case "BOOL":
-				scanArgs[i] = new(sql.NullBool)
-			case "INT", "INTEGER":
-				scanArgs[i] = new(sql.NullInt64)
-			case "FLOAT", "DOUBLE":
-				scanArgs[i] = new(sql.NullFloat64)
-			case "BIT", "VARBIT":
-				wantBytesValue[i] = true
-				scanArgs[i] = new(sql.NullString)
-			default:
-				scanArgs[i] = new(sql.NullString)
-			}
-		}
-
-		if err := rows.Scan(scanArgs...); err != nil {
+		row, err := scanRow(dbType, rows, columnTypeNames)
+		if err != nil {
 			return err
 		}
 
-		var rowData v1pb.QueryRow
-		for i := range columnTypeNames {
-			rowData.Values = append(rowData.Values, noneMasker.Mask(&masker.MaskData{
-				Data:      scanArgs[i],
-				WantBytes: wantBytesValue[i],
-			}))
-		}
-
-		result.Rows = append(result.Rows, &rowData)
+		result.Rows = append(result.Rows, row)
 		n := len(result.Rows)
 		if (n&(n-1) == 0) && proto.Size(result) > common.MaximumSQLResultSize {
 			result.Error = common.MaximumSQLResultSizeExceeded
@@ -240,6 +197,58 @@ func readRows(result *v1pb.QueryResult, dbType storepb.Engine, rows *sql.Rows, c
 	return nil
 }
 
+// scanRow scans the current row of the rows.
+func scanRow(dbType storepb.Engine, rows *sql.Rows, columnTypeNames []string) (*v1pb.QueryRow, error) {
+	// wantBytesValue want to convert StringValue to BytesValue when columnTypeName is BIT or VARBIT
+	wantBytesValue := make([]bool, len(columnTypeNames))
+	scanArgs := make([]any, len(columnTypeNames))
+	for i, v := range columnTypeNames {
+		// TODO(steven need help): Consult a common list of data types from database driver documentation. e.g. MySQL,PostgreSQL.
+		if dbType == storepb.Engine_MSSQL {
+			switch v {
+			case "UNIQUEIDENTIFIER":
+				scanArgs[i] = new(mssqldb.UniqueIdentifier)
+				continue
+			case "NULLUNIQUEIDENTIFIER":
+				scanArgs[i] = new(mssqldb.NullUniqueIdentifier)
+				continue
+			case "GEOMETRY":
+				scanArgs[i] = new(sql.NullString)
+				wantBytesValue[i] = true
+				continue
+			}
+		}
+		switch v {
+		case "VARCHAR", "TEXT", "UUID", "TIMESTAMP":
+			scanArgs[i] = new(sql.NullString)
+		case "BOOL":
+			scanArgs[i] = new(sql.NullBool)
+		case "INT", "INTEGER":
+			scanArgs[i] = new(sql.NullInt64)
+		case "FLOAT", "DOUBLE":
+			scanArgs[i] = new(sql.NullFloat64)
+		case "BIT", "VARBIT":
+			wantBytesValue[i] = true
+			scanArgs[i] = new(sql.NullString)
+		default:
+			scanArgs[i] = new(sql.NullString)
+		}
+	}
+
+	if err := rows.Scan(scanArgs...); err != nil {
+		return nil, err
+	}
+
+	var rowData v1pb.QueryRow
+	for i := range columnTypeNames {
+		rowData.Values = append(rowData.Values, noneMasker.Mask(&masker.MaskData{
+			Data:      scanArgs[i],
+			WantBytes: wantBytesValue[i],
+		}))
+	}
+	return &rowData, nil
+}
+
 func getStatementWithResultLimit(stmt string, limit int) string {
 	return fmt.Sprintf("WITH result AS (%s) SELECT * FROM result LIMIT %d;", stmt, limit)
 }
@@ -0,0 +1,25 @@
+package base
+
+import "fmt"
+
+// BatchDML is a single-table UPDATE or DELETE statement which can be executed in batches
+// ranged by the primary key of the table.
+type BatchDML struct {
+	// Schema is the schema of the changed table, empty if it's not specified in the statement.
+	Schema string
+	// Table is the name of the changed table.
+	Table string
+
+	// Prefix is the statement text before the WHERE clause, or the whole statement if there is no WHERE clause.
+	Prefix string
+	// Condition is the search condition of the WHERE clause, empty if there is no WHERE clause.
+	Condition string
+}
+
+// Rewrite returns the statement whose WHERE clause is restricted by the predicate.
+func (d *BatchDML) Rewrite(predicate string) string {
+	if d.Condition == "" {
+		return fmt.Sprintf("%s WHERE %s", d.Prefix, predicate)
+	}
+	return fmt.Sprintf("%s WHERE (%s) AND (%s)", d.Prefix, d.Condition, predicate)
+}
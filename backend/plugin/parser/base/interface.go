@@ -23,6 +23,8 @@ var (
 	affectedRows            = make(map[storepb.Engine]GetAffectedRowsFunc)
 	transformDMLToSelect    = make(map[storepb.Engine]TransformDMLToSelectFunc)
 	generateRestoreSQL      = make(map[storepb.Engine]GenerateRestoreSQLFunc)
+	batchDMLExtractors      = make(map[storepb.Engine]ExtractBatchDMLFunc)
+	rowFilterRewriters      = make(map[storepb.Engine]RewriteRowFilterFunc)
 )
 
 type ValidateSQLForEditorFunc func(string) (bool, error)
@@ -41,6 +43,12 @@ type GetAffectedRowsFunc func(ctx context.Context, stmt any, getAffectedRowsByQu
 // TransformDMLToSelectFunc is the interface of transforming DML statements to SELECT statements.
 type TransformDMLToSelectFunc func(ctx TransformContext, statement string, sourceDatabase string, targetDatabase string, tablePrefix string) ([]BackupStatement, error)
 
+// ExtractBatchDMLFunc is the interface of extracting the single-table DML statement to execute in batches.
+type ExtractBatchDMLFunc func(statement string) (*BatchDML, error)
+
+// RewriteRowFilterFunc is the interface of rewriting the query to read only the rows matching the row filters of the tables.
+type RewriteRowFilterFunc func(statement, database, schema string, getRowFilter GetRowFilterFunc, checkFunction CheckRowFilterFunctionFunc) (string, error)
+
 type GenerateRestoreSQLFunc func(statement string, backupDatabase string, backupTable string, originalDatabase string, originalTable string) (string, error)
 
 func RegisterQueryValidator(engine storepb.Engine, f ValidateSQLForEditorFunc) {
@@ -250,3 +258,50 @@ func GenerateRestoreSQL(engine storepb.Engine, statement string, backupDatabase
 	}
 	return f(statement, backupDatabase, backupTable, originalDatabase, originalTable)
 }
+
+// RegisterExtractBatchDML registers the batch DML extractor for the engine.
+func RegisterExtractBatchDML(engine storepb.Engine, f ExtractBatchDMLFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := batchDMLExtractors[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	batchDMLExtractors[engine] = f
+}
+
+// ExtractBatchDML extracts the single-table UPDATE or DELETE statement to execute in batches.
+func ExtractBatchDML(engine storepb.Engine, statement string) (*BatchDML, error) {
+	f, ok := batchDMLExtractors[engine]
+	if !ok {
+		return nil, errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement)
+}
+
+// RegisterRewriteRowFilter registers the row filter rewriter for the engine.
+func RegisterRewriteRowFilter(engine storepb.Engine, f RewriteRowFilterFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := rowFilterRewriters[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	rowFilterRewriters[engine] = f
+}
+
+// RewriteRowFilter rewrites the query so that every reference to the tables with row filters reads only the rows matching the predicates.
+// The database and schema are the defaults of the unqualified table references.
+// It returns an error if a table with row filters is referenced where the query cannot be safely rewritten,
+// or if checkFunction returns an error for a function called by the query.
+func RewriteRowFilter(engine storepb.Engine, statement, database, schema string, getRowFilter GetRowFilterFunc, checkFunction CheckRowFilterFunctionFunc) (string, error) {
+	f, ok := rowFilterRewriters[engine]
+	if !ok {
+		return "", errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement, database, schema, getRowFilter, checkFunction)
+}
+
+// IsRowFilterSupported returns true if the row filters can be rewritten for the engine.
+func IsRowFilterSupported(engine storepb.Engine) bool {
+	_, ok := rowFilterRewriters[engine]
+	return ok
+}
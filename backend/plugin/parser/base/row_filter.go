@@ -0,0 +1,15 @@
+package base
+
+import "fmt"
+
+// GetRowFilterFunc is the function to get the row filter predicate of the table, empty if the table has no row filter.
+type GetRowFilterFunc func(database, schema, table string) (string, error)
+
+// CheckRowFilterFunctionFunc is the function to check the function called by the query,
+// and it returns an error if the function may read the tables with row filters which cannot be rewritten.
+type CheckRowFilterFunctionFunc func(database, schema, function string) error
+
+// RowFilterSubquery returns the subquery reading the rows of the table matching the predicate.
+func RowFilterSubquery(table, predicate string) string {
+	return fmt.Sprintf("(SELECT * FROM %s WHERE %s)", table, predicate)
+}
@@ -0,0 +1,342 @@
+// Package django defines the sql extractor for django migration files.
+package django
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var operationsRE = regexp.MustCompile(`\boperations\s*=\s*[\[(]`)
+
+// Migration is the django migration parsed.
+type Migration struct {
+	// Statements is the forward statements of the RunSQL operations.
+	Statements []string
+	// SkippedOperations is the other operations skipped with their lines, e.g. "CreateModel at line 11".
+	SkippedOperations []string
+}
+
+// Parse parses the django migration file and returns the forward statements of the RunSQL operations.
+// The other operations are skipped because their statements depend on the models and the database backend,
+// use `python manage.py sqlmigrate` to generate the statements of them instead.
+func Parse(s string) (*Migration, error) {
+	loc := operationsRE.FindStringIndex(s)
+	if loc == nil {
+		return nil, errors.Errorf("unable to find the operations of the migration")
+	}
+	p := &parser{s: s, pos: loc[1]}
+	migration := &Migration{}
+	for {
+		p.skipSpaces()
+		if p.eof() {
+			return nil, errors.Errorf("unexpected end of the operations")
+		}
+		if c := p.s[p.pos]; c == ']' || c == ')' {
+			return migration, nil
+		}
+		if p.s[p.pos] == ',' {
+			p.pos++
+			continue
+		}
+		name, err := p.parseName()
+		if err != nil {
+			return nil, err
+		}
+		operation := strings.TrimPrefix(name, "migrations.")
+		line := p.line()
+		args, err := p.parseArguments()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s at line %d", operation, line)
+		}
+		if operation != "RunSQL" {
+			migration.SkippedOperations = append(migration.SkippedOperations, fmt.Sprintf("%s at line %d", operation, line))
+			continue
+		}
+		sql, ok := args["sql"]
+		if !ok {
+			sql, ok = args["0"]
+		}
+		if !ok {
+			return nil, errors.Errorf("missing sql of RunSQL at line %d", p.line())
+		}
+		sqls, err := getStatements(sql)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid sql of RunSQL at line %d", p.line())
+		}
+		migration.Statements = append(migration.Statements, sqls...)
+	}
+}
+
+// getStatements gets the statements from the sql argument of RunSQL, which is a string or a list of strings.
+func getStatements(v any) ([]string, error) {
+	switch v := v.(type) {
+	case string:
+		if stmt := strings.TrimSpace(v); stmt != "" {
+			return []string{stmt}, nil
+		}
+		return nil, nil
+	case []any:
+		var stmts []string
+		for _, item := range v {
+			if _, ok := item.([]any); ok {
+				return nil, errors.Errorf("statements with parameters are not supported")
+			}
+			itemStmts, err := getStatements(item)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, itemStmts...)
+		}
+		return stmts, nil
+	case expression:
+		// migrations.RunSQL.noop.
+		if v == "migrations.RunSQL.noop" {
+			return nil, nil
+		}
+		return nil, errors.Errorf("unsupported expression %s", string(v))
+	default:
+		return nil, errors.Errorf("unsupported value")
+	}
+}
+
+// expression is a python expression which is not a literal, e.g. a name or a call.
+type expression string
+
+type parser struct {
+	s   string
+	pos int
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *parser) line() int {
+	return strings.Count(p.s[:p.pos], "\n") + 1
+}
+
+// skipSpaces skips the spaces, the comments and the line continuations.
+func (p *parser) skipSpaces() {
+	for !p.eof() {
+		switch c := p.s[p.pos]; {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\\':
+			p.pos++
+		case c == '#':
+			for !p.eof() && p.s[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func isNameChar(c byte) bool {
+	return c == '_' || c == '.' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+func (p *parser) parseName() (string, error) {
+	start := p.pos
+	for !p.eof() && isNameChar(p.s[p.pos]) {
+		p.pos++
+	}
+	if start == p.pos {
+		return "", errors.Errorf("unexpected %q at line %d", p.s[p.pos], p.line())
+	}
+	return p.s[start:p.pos], nil
+}
+
+// parseArguments parses the arguments of a call after the name.
+// The positional arguments are keyed by their indexes, and the keyword arguments are keyed by their names.
+func (p *parser) parseArguments() (map[string]any, error) {
+	p.skipSpaces()
+	if p.eof() || p.s[p.pos] != '(' {
+		return nil, errors.Errorf("expect ( at line %d", p.line())
+	}
+	p.pos++
+	args := map[string]any{}
+	index := 0
+	for {
+		p.skipSpaces()
+		if p.eof() {
+			return nil, errors.Errorf("unexpected end of the arguments")
+		}
+		switch p.s[p.pos] {
+		case ')':
+			p.pos++
+			return args, nil
+		case ',':
+			p.pos++
+			continue
+		}
+		key := ""
+		if start := p.pos; isNameChar(p.s[p.pos]) {
+			name, err := p.parseName()
+			if err != nil {
+				return nil, err
+			}
+			p.skipSpaces()
+			if !p.eof() && p.s[p.pos] == '=' && !strings.HasPrefix(p.s[p.pos:], "==") {
+				p.pos++
+				key = name
+			} else {
+				p.pos = start
+			}
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		if key == "" {
+			key = strconv.Itoa(index)
+			index++
+		}
+		args[key] = value
+	}
+}
+
+// parseValue parses a string, a list or a tuple, and skips the other expressions.
+func (p *parser) parseValue() (any, error) {
+	p.skipSpaces()
+	if p.eof() {
+		return nil, errors.Errorf("unexpected end of the value")
+	}
+	switch c := p.s[p.pos]; {
+	case c == '[' || c == '(':
+		return p.parseList()
+	case c == '"' || c == '\'' || isStringPrefix(p.s[p.pos:]):
+		var buf strings.Builder
+		// The adjacent string literals are concatenated.
+		for {
+			str, err := p.parseString()
+			if err != nil {
+				return nil, err
+			}
+			_, _ = buf.WriteString(str)
+			p.skipSpaces()
+			if p.eof() || !(p.s[p.pos] == '"' || p.s[p.pos] == '\'' || isStringPrefix(p.s[p.pos:])) {
+				return buf.String(), nil
+			}
+		}
+	default:
+		start := p.pos
+		for !p.eof() {
+			switch p.s[p.pos] {
+			case ',', ')', ']':
+				return expression(strings.TrimSpace(p.s[start:p.pos])), nil
+			case '(', '[':
+				if _, err := p.parseList(); err != nil {
+					return nil, err
+				}
+			case '"', '\'':
+				if _, err := p.parseString(); err != nil {
+					return nil, err
+				}
+			default:
+				p.pos++
+			}
+		}
+		return nil, errors.Errorf("unexpected end of the value")
+	}
+}
+
+func (p *parser) parseList() ([]any, error) {
+	closing := byte(']')
+	if p.s[p.pos] == '(' {
+		closing = ')'
+	}
+	p.pos++
+	var list []any
+	for {
+		p.skipSpaces()
+		if p.eof() {
+			return nil, errors.Errorf("unexpected end of the list")
+		}
+		switch p.s[p.pos] {
+		case closing:
+			p.pos++
+			return list, nil
+		case ',':
+			p.pos++
+			continue
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, value)
+	}
+}
+
+// isStringPrefix returns true if s starts with a string literal with prefix, e.g. r"..." or b'...'.
+func isStringPrefix(s string) bool {
+	i := 0
+	for i < len(s) && i < 2 && strings.ContainsRune("rRbBuUfF", rune(s[i])) {
+		i++
+	}
+	return i > 0 && i < len(s) && (s[i] == '"' || s[i] == '\'')
+}
+
+func (p *parser) parseString() (string, error) {
+	raw := false
+	for !p.eof() && p.s[p.pos] != '"' && p.s[p.pos] != '\'' {
+		if p.s[p.pos] == 'r' || p.s[p.pos] == 'R' {
+			raw = true
+		}
+		p.pos++
+	}
+	if p.eof() {
+		return "", errors.Errorf("unexpected end of the string")
+	}
+	quote := p.s[p.pos : p.pos+1]
+	if strings.HasPrefix(p.s[p.pos:], strings.Repeat(quote, 3)) {
+		quote = strings.Repeat(quote, 3)
+	}
+	start := p.line()
+	p.pos += len(quote)
+	var buf strings.Builder
+	for {
+		if p.eof() {
+			return "", errors.Errorf("unterminated string starting at line %d", start)
+		}
+		if strings.HasPrefix(p.s[p.pos:], quote) {
+			p.pos += len(quote)
+			return buf.String(), nil
+		}
+		c := p.s[p.pos]
+		if c == '\n' && len(quote) == 1 {
+			return "", errors.Errorf("unterminated string starting at line %d", start)
+		}
+		if c != '\\' || p.pos+1 >= len(p.s) {
+			_ = buf.WriteByte(c)
+			p.pos++
+			continue
+		}
+		next := p.s[p.pos+1]
+		p.pos += 2
+		if raw {
+			_ = buf.WriteByte(c)
+			_ = buf.WriteByte(next)
+			continue
+		}
+		switch next {
+		case 'n':
+			_ = buf.WriteByte('\n')
+		case 't':
+			_ = buf.WriteByte('\t')
+		case 'r':
+			_ = buf.WriteByte('\r')
+		case '\\', '\'', '"':
+			_ = buf.WriteByte(next)
+		case '\n':
+			// Line continuation.
+		default:
+			_ = buf.WriteByte(c)
+			_ = buf.WriteByte(next)
+		}
+	}
+}
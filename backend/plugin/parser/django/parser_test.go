@@ -0,0 +1,46 @@
+package django
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, tst := range []struct {
+		filename string
+		want     []string
+		skipped  []string
+		err      string
+	}{
+		{
+			filename: "0002_add_email.py",
+			want: []string{
+				"ALTER TABLE accounts_user ADD COLUMN email varchar(254) NOT NULL DEFAULT ''",
+				"CREATE INDEX accounts_user_email_idx ON accounts_user (email)",
+				"UPDATE accounts_user\n                SET email = username || '@example.com'\n                WHERE email = ''",
+				`COMMENT ON COLUMN accounts_user.email IS 'It\'s the email'`,
+			},
+		},
+		{
+			filename: "0003_create_profile.py",
+			want: []string{
+				"CREATE INDEX accounts_profile_id_idx ON accounts_profile (id)",
+			},
+			skipped: []string{"CreateModel at line 11"},
+		},
+	} {
+		content, err := os.ReadFile(path.Join("test-data", tst.filename))
+		require.NoError(t, err)
+		got, err := Parse(string(content))
+		if tst.err != "" {
+			require.EqualError(t, err, tst.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tst.want, got.Statements)
+		require.Equal(t, tst.skipped, got.SkippedOperations)
+	}
+}
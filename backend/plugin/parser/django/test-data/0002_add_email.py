@@ -0,0 +1,30 @@
+from django.db import migrations
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ("accounts", "0001_initial"),
+        migrations.swappable_dependency("auth.User"),
+    ]
+
+    operations = [
+        # Add the email column.
+        migrations.RunSQL(
+            "ALTER TABLE accounts_user ADD COLUMN email varchar(254) NOT NULL DEFAULT ''",
+            reverse_sql="ALTER TABLE accounts_user DROP COLUMN email",
+        ),
+        migrations.RunSQL(
+            sql=[
+                "CREATE INDEX accounts_user_email_idx "
+                'ON accounts_user (email)',
+                """
+                UPDATE accounts_user
+                SET email = username || '@example.com'
+                WHERE email = ''
+                """,
+            ],
+            reverse_sql=migrations.RunSQL.noop,
+        ),
+        migrations.RunSQL(r"COMMENT ON COLUMN accounts_user.email IS 'It\'s the email'", elidable=True),
+    ]
@@ -0,0 +1,21 @@
+from django.db import migrations, models
+
+
+class Migration(migrations.Migration):
+
+    dependencies = [
+        ("accounts", "0002_add_email"),
+    ]
+
+    operations = [
+        migrations.CreateModel(
+            name="Profile",
+            fields=[
+                ("id", models.BigAutoField(primary_key=True)),
+            ],
+        ),
+        migrations.RunSQL(
+            "CREATE INDEX accounts_profile_id_idx ON accounts_profile (id)",
+            reverse_sql="DROP INDEX accounts_profile_id_idx",
+        ),
+    ]
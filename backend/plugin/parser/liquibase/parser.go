@@ -0,0 +1,366 @@
+// Package liquibase defines the sql extractor for liquibase changelogs.
+package liquibase
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// ChangeSet is a changeSet of the changelog.
+type ChangeSet struct {
+	ID      string
+	Author  string
+	Comment string
+	// DBMS is the dbms that the statement is generated for, or empty if the statement is the same for all the dbms.
+	DBMS string
+	// Statement is the statements of all the changes in the changeSet.
+	Statement string
+}
+
+// ReadFileFunc reads the content of the file referenced by the changelog, e.g. the path of the sqlFile change.
+// If relativeToChangelogFile is true, the path is joined with the directory of the changelog already,
+// otherwise the path is relative to the search path of the changelogs.
+type ReadFileFunc func(path string, relativeToChangelogFile bool) (string, error)
+
+// changeSet is the changeSet parsed from the XML, YAML or formatted SQL changelogs before generating the statements.
+type changeSet struct {
+	id      string
+	author  string
+	dbms    string
+	comment string
+	changes []*change
+}
+
+type change struct {
+	name       string
+	attributes map[string]string
+	// text is the body of the sql change.
+	text    string
+	columns []*column
+}
+
+type column struct {
+	attributes  map[string]string
+	constraints map[string]string
+}
+
+// ParseChangeLog parses the XML, YAML, JSON or formatted SQL changelog and returns the changeSets for the dbms in order,
+// the dbms is the database type name used by liquibase, e.g. mysql, postgresql, mssql and oracle.
+// The SQL files are not changelogs unless they start with "--liquibase formatted sql", and nil is returned for them.
+// The included changelogs are not followed, and the preconditions and rollbacks are ignored.
+func ParseChangeLog(filename, content, dbms string, readFile ReadFileFunc) ([]*ChangeSet, error) {
+	changeSets, err := ParseChangeLogForDBMSList(filename, content, []string{dbms}, readFile)
+	if err != nil {
+		return nil, err
+	}
+	for _, cs := range changeSets {
+		cs.DBMS = ""
+	}
+	return changeSets, nil
+}
+
+// ParseChangeLogForDBMSList parses the changelog the same as ParseChangeLog for each of the dbms list.
+// A changeSet is returned once with an empty DBMS if it has the same statements for all the dbms,
+// otherwise it is returned for each dbms it applies to, e.g. the changeSets with the dbms attributes.
+func ParseChangeLogForDBMSList(filename, content string, dbmsList []string, readFile ReadFileFunc) ([]*ChangeSet, error) {
+	var changeSets []*changeSet
+	var err error
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".xml":
+		changeSets, err = parseXML(content)
+	case ".yaml", ".yml", ".json":
+		changeSets, err = parseYAML(content)
+	case ".sql":
+		changeSets, err = parseFormattedSQL(content)
+	default:
+		return nil, errors.Errorf("unsupported changelog format %q", path.Ext(filename))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*ChangeSet
+	ids := map[string]bool{}
+	for _, cs := range changeSets {
+		if cs.id == "" || cs.author == "" {
+			return nil, errors.Errorf("changeSet must have id and author")
+		}
+		key := fmt.Sprintf("%s::%s", cs.id, cs.author)
+		if ids[key] {
+			return nil, errors.Errorf("duplicate changeSet %s", key)
+		}
+		ids[key] = true
+		var dbmsChangeSets []*ChangeSet
+		for _, dbms := range dbmsList {
+			if !matchDBMS(cs.dbms, dbms) {
+				continue
+			}
+			g := &generator{dbms: dbms, filename: filename, readFile: readFile}
+			var stmts []string
+			for _, c := range cs.changes {
+				changeStmts, err := g.generate(c)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to generate statements for changeSet %s", key)
+				}
+				stmts = append(stmts, changeStmts...)
+			}
+			if len(stmts) == 0 {
+				continue
+			}
+			dbmsChangeSets = append(dbmsChangeSets, &ChangeSet{
+				ID:        cs.id,
+				Author:    cs.author,
+				Comment:   cs.comment,
+				DBMS:      dbms,
+				Statement: strings.Join(stmts, "\n"),
+			})
+		}
+		if len(dbmsChangeSets) == len(dbmsList) && len(dbmsChangeSets) > 0 && isSameStatement(dbmsChangeSets) {
+			dbmsChangeSets[0].DBMS = ""
+			dbmsChangeSets = dbmsChangeSets[:1]
+		}
+		result = append(result, dbmsChangeSets...)
+	}
+	return result, nil
+}
+
+func isSameStatement(changeSets []*ChangeSet) bool {
+	for _, cs := range changeSets[1:] {
+		if cs.Statement != changeSets[0].Statement {
+			return false
+		}
+	}
+	return true
+}
+
+// matchDBMS returns true if the dbms matches the dbms attribute, e.g. "mysql, mariadb", "!h2", "all" and "none".
+func matchDBMS(attribute, dbms string) bool {
+	attribute = strings.TrimSpace(attribute)
+	if attribute == "" {
+		return true
+	}
+	hasPositive, matchPositive := false, false
+	for _, v := range strings.Split(attribute, ",") {
+		v = strings.ToLower(strings.TrimSpace(v))
+		switch {
+		case v == "all":
+			return true
+		case v == "none":
+			return false
+		case strings.HasPrefix(v, "!"):
+			if strings.TrimPrefix(v, "!") == dbms {
+				return false
+			}
+		default:
+			hasPositive = true
+			if v == dbms {
+				matchPositive = true
+			}
+		}
+	}
+	return !hasPositive || matchPositive
+}
+
+type xmlAttributes struct {
+	Attrs []xml.Attr `xml:",any,attr"`
+}
+
+func (a xmlAttributes) toMap() map[string]string {
+	m := map[string]string{}
+	for _, attr := range a.Attrs {
+		m[attr.Name.Local] = attr.Value
+	}
+	return m
+}
+
+type xmlChangeSet struct {
+	ID      string      `xml:"id,attr"`
+	Author  string      `xml:"author,attr"`
+	DBMS    string      `xml:"dbms,attr"`
+	Comment string      `xml:"comment"`
+	Changes []xmlChange `xml:",any"`
+}
+
+type xmlChange struct {
+	XMLName xml.Name
+	xmlAttributes
+	Text    string      `xml:",chardata"`
+	Columns []xmlColumn `xml:"column"`
+}
+
+type xmlColumn struct {
+	xmlAttributes
+	Constraints *xmlAttributes `xml:"constraints"`
+}
+
+// ignoredChanges are the elements of the changeSet which are not changes.
+var ignoredChanges = map[string]bool{
+	"comment":       true,
+	"preConditions": true,
+	"rollback":      true,
+	"validCheckSum": true,
+}
+
+func parseXML(content string) ([]*changeSet, error) {
+	d := xml.NewDecoder(strings.NewReader(content))
+	var changeSets []*changeSet
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return changeSets, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse XML changelog")
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "changeSet" {
+			continue
+		}
+		var v xmlChangeSet
+		if err := d.DecodeElement(&v, &start); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse changeSet")
+		}
+		cs := &changeSet{id: v.ID, author: v.Author, dbms: v.DBMS, comment: strings.TrimSpace(v.Comment)}
+		for _, c := range v.Changes {
+			if ignoredChanges[c.XMLName.Local] {
+				continue
+			}
+			ch := &change{name: c.XMLName.Local, attributes: c.toMap(), text: c.Text}
+			for _, col := range c.Columns {
+				newColumn := &column{attributes: col.toMap(), constraints: map[string]string{}}
+				if col.Constraints != nil {
+					newColumn.constraints = col.Constraints.toMap()
+				}
+				ch.columns = append(ch.columns, newColumn)
+			}
+			cs.changes = append(cs.changes, ch)
+		}
+		changeSets = append(changeSets, cs)
+	}
+}
+
+func parseYAML(content string) ([]*changeSet, error) {
+	var changeLog struct {
+		DatabaseChangeLog []map[string]any `yaml:"databaseChangeLog"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &changeLog); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse YAML changelog")
+	}
+	var changeSets []*changeSet
+	for _, item := range changeLog.DatabaseChangeLog {
+		v, ok := item["changeSet"]
+		if !ok {
+			continue
+		}
+		attributes, ok := v.(map[string]any)
+		if !ok {
+			return nil, errors.Errorf("invalid changeSet")
+		}
+		cs := &changeSet{
+			id:      toString(attributes["id"]),
+			author:  toString(attributes["author"]),
+			dbms:    toString(attributes["dbms"]),
+			comment: strings.TrimSpace(toString(attributes["comment"])),
+		}
+		changes, _ := attributes["changes"].([]any)
+		for _, c := range changes {
+			m, ok := c.(map[string]any)
+			if !ok || len(m) != 1 {
+				return nil, errors.Errorf("invalid change in changeSet %s", cs.id)
+			}
+			for name, v := range m {
+				ch := &change{name: name, attributes: map[string]string{}}
+				changeAttributes, _ := v.(map[string]any)
+				for key, value := range changeAttributes {
+					if key != "columns" {
+						ch.attributes[key] = toString(value)
+					}
+				}
+				ch.text = ch.attributes["sql"]
+				columns, _ := changeAttributes["columns"].([]any)
+				for _, col := range columns {
+					colMap, _ := col.(map[string]any)
+					colAttributes, _ := colMap["column"].(map[string]any)
+					newColumn := &column{attributes: map[string]string{}, constraints: map[string]string{}}
+					for key, value := range colAttributes {
+						if key == "constraints" {
+							constraints, _ := value.(map[string]any)
+							for k, v := range constraints {
+								newColumn.constraints[k] = toString(v)
+							}
+							continue
+						}
+						newColumn.attributes[key] = toString(value)
+					}
+					ch.columns = append(ch.columns, newColumn)
+				}
+				cs.changes = append(cs.changes, ch)
+			}
+		}
+		changeSets = append(changeSets, cs)
+	}
+	return changeSets, nil
+}
+
+func toString(v any) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+var (
+	formattedSQLHeaderRE = regexp.MustCompile(`(?i)^--\s*liquibase formatted sql`)
+	changeSetRE          = regexp.MustCompile(`(?i)^--\s*changeset\s+(?:"([^"]+)"|(\S+?)):(?:"([^"]+)"|(\S+))(.*)$`)
+	commentRE            = regexp.MustCompile(`(?i)^--\s*comment:\s*(.*)$`)
+	ignoredLineRE        = regexp.MustCompile(`(?i)^--\s*(rollback|precondition|validCheckSum)`)
+	dbmsRE               = regexp.MustCompile(`(?i)\bdbms:(\S+)`)
+)
+
+func parseFormattedSQL(content string) ([]*changeSet, error) {
+	lines := strings.Split(content, "\n")
+	if len(lines) == 0 || !formattedSQLHeaderRE.MatchString(strings.TrimSpace(lines[0])) {
+		return nil, nil
+	}
+	var changeSets []*changeSet
+	var buf []string
+	flush := func() {
+		if len(changeSets) > 0 {
+			last := changeSets[len(changeSets)-1]
+			last.changes = append(last.changes, &change{name: "sql", attributes: map[string]string{}, text: strings.Join(buf, "\n")})
+		}
+		buf = nil
+	}
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimSpace(line)
+		if m := changeSetRE.FindStringSubmatch(trimmed); m != nil {
+			flush()
+			cs := &changeSet{author: m[1] + m[2], id: m[3] + m[4]}
+			if dbms := dbmsRE.FindStringSubmatch(m[5]); dbms != nil {
+				cs.dbms = dbms[1]
+			}
+			changeSets = append(changeSets, cs)
+			continue
+		}
+		if len(changeSets) == 0 {
+			continue
+		}
+		if m := commentRE.FindStringSubmatch(trimmed); m != nil {
+			changeSets[len(changeSets)-1].comment = m[1]
+			continue
+		}
+		if ignoredLineRE.MatchString(trimmed) {
+			continue
+		}
+		buf = append(buf, line)
+	}
+	flush()
+	return changeSets, nil
+}
@@ -0,0 +1,89 @@
+package liquibase
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChangeLog(t *testing.T) {
+	readFile := func(filePath string, _ bool) (string, error) {
+		content, err := os.ReadFile(path.Join("test-data", path.Clean(filePath)))
+		return string(content), err
+	}
+	for _, tst := range []struct {
+		filename string
+		dbms     string
+		want     []*ChangeSet
+		err      string
+	}{
+		{
+			filename: "changelog.xml",
+			dbms:     "mysql",
+			want: []*ChangeSet{
+				{ID: "1", Author: "alice", Comment: "Create the user table.", Statement: "CREATE TABLE user (\n  id BIGINT AUTO_INCREMENT NOT NULL PRIMARY KEY,\n  name VARCHAR(255) DEFAULT 'it''s' NOT NULL UNIQUE,\n  active BOOLEAN DEFAULT TRUE\n);"},
+				{ID: "2", Author: "alice", Statement: "ALTER TABLE user ADD COLUMN team_id BIGINT;\nALTER TABLE user ADD CONSTRAINT fk_user_team FOREIGN KEY (team_id) REFERENCES team(id);\nCREATE UNIQUE INDEX idx_user_name ON user (name);"},
+				{ID: "4", Author: "bob", Statement: "INSERT INTO team (id, name) VALUES (1, 'default');"},
+			},
+		},
+		{
+			filename: "changelog.xml",
+			dbms:     "postgresql",
+			want: []*ChangeSet{
+				{ID: "1", Author: "alice", Comment: "Create the user table.", Statement: "CREATE TABLE user (\n  id BIGINT GENERATED BY DEFAULT AS IDENTITY NOT NULL PRIMARY KEY,\n  name VARCHAR(255) DEFAULT 'it''s' NOT NULL UNIQUE,\n  active BOOLEAN DEFAULT TRUE\n);"},
+				{ID: "2", Author: "alice", Statement: "ALTER TABLE user ADD COLUMN team_id BIGINT;\nALTER TABLE user ADD CONSTRAINT fk_user_team FOREIGN KEY (team_id) REFERENCES team(id);\nCREATE UNIQUE INDEX idx_user_name ON user (name);"},
+				{ID: "3", Author: "bob", Statement: "CREATE EXTENSION IF NOT EXISTS pgcrypto;"},
+				{ID: "4", Author: "bob", Statement: "INSERT INTO team (id, name) VALUES (1, 'default');\nUPDATE \"user\" SET active = TRUE WHERE name <> '';"},
+			},
+		},
+		{
+			filename: "changelog.yaml",
+			dbms:     "mssql",
+			want: []*ChangeSet{
+				{ID: "1", Author: "alice", Statement: "CREATE TABLE app.team (\n  id INT,\n  org_id INT,\n  PRIMARY KEY (id, org_id)\n);"},
+				{ID: "2", Author: "alice", Statement: "EXEC sp_rename 'app.team.org_id', 'organization_id', 'COLUMN';\nDROP INDEX idx_team ON app.team;"},
+				{ID: "3", Author: "alice", Statement: "DELETE FROM app.team WHERE id = 0;"},
+			},
+		},
+		{
+			filename: "changelog.sql",
+			dbms:     "mysql",
+			want: []*ChangeSet{
+				{ID: "1", Author: "alice", Comment: "Create the team table.", Statement: "CREATE TABLE team (id INT PRIMARY KEY);"},
+			},
+		},
+		{
+			filename: "unsupported.xml",
+			dbms:     "mysql",
+			err:      "failed to generate statements for changeSet 1::alice: unsupported change type loadData, use the sql or sqlFile change instead",
+		},
+	} {
+		content, err := os.ReadFile(path.Join("test-data", tst.filename))
+		require.NoError(t, err)
+		got, err := ParseChangeLog(tst.filename, string(content), tst.dbms, readFile)
+		if tst.err != "" {
+			require.EqualError(t, err, tst.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tst.want, got, "%s on %s", tst.filename, tst.dbms)
+	}
+}
+
+func TestParseChangeLogForDBMSList(t *testing.T) {
+	readFile := func(filePath string, _ bool) (string, error) {
+		content, err := os.ReadFile(path.Join("test-data", path.Clean(filePath)))
+		return string(content), err
+	}
+	content, err := os.ReadFile(path.Join("test-data", "changelog.xml"))
+	require.NoError(t, err)
+	got, err := ParseChangeLogForDBMSList("changelog.xml", string(content), []string{"mysql", "postgresql"}, readFile)
+	require.NoError(t, err)
+	var ids []string
+	for _, cs := range got {
+		ids = append(ids, cs.ID+"@"+cs.DBMS)
+	}
+	require.Equal(t, []string{"1@mysql", "1@postgresql", "2@", "3@postgresql", "4@mysql", "4@postgresql"}, ids)
+}
@@ -0,0 +1,261 @@
+package liquibase
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// generator generates the statements of the changes for the dbms.
+// The identifiers and the column types are used as is without quoting or type mapping.
+type generator struct {
+	dbms     string
+	filename string
+	readFile ReadFileFunc
+}
+
+func (g *generator) isMySQL() bool {
+	return g.dbms == "mysql" || g.dbms == "mariadb"
+}
+
+func (g *generator) generate(c *change) ([]string, error) {
+	if !matchDBMS(c.attributes["dbms"], g.dbms) {
+		return nil, nil
+	}
+	a := c.attributes
+	switch c.name {
+	case "sql":
+		return getSQLStatements(c.text), nil
+	case "sqlFile":
+		filePath := a["path"]
+		if filePath == "" {
+			return nil, errors.Errorf("path is required for sqlFile")
+		}
+		relative := a["relativeToChangelogFile"] == "true"
+		if relative {
+			filePath = path.Join(path.Dir(g.filename), filePath)
+		}
+		if g.readFile == nil {
+			return nil, errors.Errorf("cannot read sqlFile %q", filePath)
+		}
+		content, err := g.readFile(filePath, relative)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read sqlFile %q", filePath)
+		}
+		return getSQLStatements(content), nil
+	case "createTable":
+		return g.createTable(c)
+	case "addColumn":
+		var stmts []string
+		for _, col := range c.columns {
+			keyword := "ADD COLUMN"
+			if g.dbms == "mssql" || g.dbms == "oracle" {
+				keyword = "ADD"
+			}
+			stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s %s %s;", getTableName(a), keyword, g.getColumnDefinition(col, true)))
+			if reference := getReference(col.constraints); reference != "" {
+				stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s ADD %sFOREIGN KEY (%s) REFERENCES %s;", getTableName(a), getConstraintName(col.constraints["foreignKeyName"]), col.attributes["name"], reference))
+			}
+		}
+		return stmts, nil
+	case "dropTable":
+		stmt := fmt.Sprintf("DROP TABLE %s", getTableName(a))
+		if a["cascadeConstraints"] == "true" {
+			switch {
+			case g.dbms == "oracle":
+				stmt += " CASCADE CONSTRAINTS"
+			case g.dbms != "mssql":
+				stmt += " CASCADE"
+			}
+		}
+		return []string{stmt + ";"}, nil
+	case "dropColumn":
+		names := []string{a["columnName"]}
+		if len(c.columns) > 0 {
+			names = nil
+			for _, col := range c.columns {
+				names = append(names, col.attributes["name"])
+			}
+		}
+		var stmts []string
+		for _, name := range names {
+			stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", getTableName(a), name))
+		}
+		return stmts, nil
+	case "renameTable":
+		oldName := getQualifiedName(a["schemaName"], a["oldTableName"])
+		if g.dbms == "mssql" {
+			return []string{fmt.Sprintf("EXEC sp_rename '%s', '%s';", oldName, a["newTableName"])}, nil
+		}
+		return []string{fmt.Sprintf("ALTER TABLE %s RENAME TO %s;", oldName, a["newTableName"])}, nil
+	case "renameColumn":
+		if g.dbms == "mssql" {
+			return []string{fmt.Sprintf("EXEC sp_rename '%s.%s', '%s', 'COLUMN';", getTableName(a), a["oldColumnName"], a["newColumnName"])}, nil
+		}
+		return []string{fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s;", getTableName(a), a["oldColumnName"], a["newColumnName"])}, nil
+	case "createIndex":
+		var names []string
+		for _, col := range c.columns {
+			names = append(names, col.attributes["name"])
+		}
+		unique := ""
+		if a["unique"] == "true" {
+			unique = "UNIQUE "
+		}
+		return []string{fmt.Sprintf("CREATE %sINDEX %s ON %s (%s);", unique, a["indexName"], getTableName(a), strings.Join(names, ", "))}, nil
+	case "dropIndex":
+		if g.isMySQL() || g.dbms == "mssql" {
+			return []string{fmt.Sprintf("DROP INDEX %s ON %s;", a["indexName"], getTableName(a))}, nil
+		}
+		return []string{fmt.Sprintf("DROP INDEX %s;", getQualifiedName(a["schemaName"], a["indexName"]))}, nil
+	case "addForeignKeyConstraint":
+		stmt := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)",
+			getQualifiedName(a["baseTableSchemaName"], a["baseTableName"]),
+			a["constraintName"],
+			a["baseColumnNames"],
+			getQualifiedName(a["referencedTableSchemaName"], a["referencedTableName"]),
+			a["referencedColumnNames"],
+		)
+		if v := a["onDelete"]; v != "" {
+			stmt += " ON DELETE " + v
+		}
+		if v := a["onUpdate"]; v != "" {
+			stmt += " ON UPDATE " + v
+		}
+		return []string{stmt + ";"}, nil
+	case "dropForeignKeyConstraint":
+		tableName := getQualifiedName(a["baseTableSchemaName"], a["baseTableName"])
+		if g.isMySQL() {
+			return []string{fmt.Sprintf("ALTER TABLE %s DROP FOREIGN KEY %s;", tableName, a["constraintName"])}, nil
+		}
+		return []string{fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", tableName, a["constraintName"])}, nil
+	case "addUniqueConstraint":
+		return []string{fmt.Sprintf("ALTER TABLE %s ADD %sUNIQUE (%s);", getTableName(a), getConstraintName(a["constraintName"]), a["columnNames"])}, nil
+	case "tagDatabase", "empty", "output":
+		return nil, nil
+	default:
+		return nil, errors.Errorf("unsupported change type %s, use the sql or sqlFile change instead", c.name)
+	}
+}
+
+func (g *generator) createTable(c *change) ([]string, error) {
+	if len(c.columns) == 0 {
+		return nil, errors.Errorf("columns are required for createTable")
+	}
+	var primaryKeys []string
+	for _, col := range c.columns {
+		if col.constraints["primaryKey"] == "true" {
+			primaryKeys = append(primaryKeys, col.attributes["name"])
+		}
+	}
+	var definitions []string
+	for _, col := range c.columns {
+		definitions = append(definitions, g.getColumnDefinition(col, len(primaryKeys) == 1))
+	}
+	if len(primaryKeys) > 1 {
+		definitions = append(definitions, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(primaryKeys, ", ")))
+	}
+	for _, col := range c.columns {
+		if reference := getReference(col.constraints); reference != "" {
+			definitions = append(definitions, fmt.Sprintf("%sFOREIGN KEY (%s) REFERENCES %s", getConstraintName(col.constraints["foreignKeyName"]), col.attributes["name"], reference))
+		}
+	}
+	return []string{fmt.Sprintf("CREATE TABLE %s (\n  %s\n);", getTableName(c.attributes), strings.Join(definitions, ",\n  "))}, nil
+}
+
+// getColumnDefinition gets the column definition, and the primary key is inlined if inlinePrimaryKey is true.
+func (g *generator) getColumnDefinition(col *column, inlinePrimaryKey bool) string {
+	parts := []string{col.attributes["name"], col.attributes["type"]}
+	if col.attributes["autoIncrement"] == "true" {
+		switch {
+		case g.isMySQL():
+			parts = append(parts, "AUTO_INCREMENT")
+		case g.dbms == "mssql":
+			parts = append(parts, "IDENTITY(1,1)")
+		default:
+			parts = append(parts, "GENERATED BY DEFAULT AS IDENTITY")
+		}
+	}
+	if v := g.getDefaultValue(col.attributes); v != "" {
+		parts = append(parts, "DEFAULT "+v)
+	}
+	if col.constraints["nullable"] == "false" {
+		parts = append(parts, "NOT NULL")
+	}
+	if col.constraints["unique"] == "true" {
+		parts = append(parts, "UNIQUE")
+	}
+	if inlinePrimaryKey && col.constraints["primaryKey"] == "true" {
+		parts = append(parts, "PRIMARY KEY")
+	}
+	return strings.Join(parts, " ")
+}
+
+func (g *generator) getDefaultValue(attributes map[string]string) string {
+	if v, ok := attributes["defaultValue"]; ok {
+		return quoteString(v)
+	}
+	if v, ok := attributes["defaultValueDate"]; ok {
+		return quoteString(v)
+	}
+	if v, ok := attributes["defaultValueNumeric"]; ok {
+		return v
+	}
+	if v, ok := attributes["defaultValueComputed"]; ok {
+		return v
+	}
+	if v, ok := attributes["defaultValueBoolean"]; ok {
+		if g.dbms == "mssql" || g.dbms == "oracle" {
+			if v == "true" {
+				return "1"
+			}
+			return "0"
+		}
+		return strings.ToUpper(v)
+	}
+	return ""
+}
+
+// getSQLStatements gets the statements of the sql change, and the last statement is terminated by the semicolon.
+func getSQLStatements(text string) []string {
+	text = strings.TrimRight(strings.TrimSpace(text), ";")
+	if text == "" {
+		return nil
+	}
+	return []string{text + ";"}
+}
+
+// getReference gets the referenced table and columns of the foreign key constraint of the column.
+func getReference(constraints map[string]string) string {
+	if v := constraints["references"]; v != "" {
+		return v
+	}
+	if v := constraints["referencedTableName"]; v != "" {
+		return fmt.Sprintf("%s (%s)", getQualifiedName(constraints["referencedTableSchemaName"], v), constraints["referencedColumnNames"])
+	}
+	return ""
+}
+
+func getConstraintName(name string) string {
+	if name == "" {
+		return ""
+	}
+	return fmt.Sprintf("CONSTRAINT %s ", name)
+}
+
+func getTableName(attributes map[string]string) string {
+	return getQualifiedName(attributes["schemaName"], attributes["tableName"])
+}
+
+func getQualifiedName(schema, name string) string {
+	if schema == "" {
+		return name
+	}
+	return fmt.Sprintf("%s.%s", schema, name)
+}
+
+func quoteString(s string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
+}
@@ -0,0 +1,9 @@
+--liquibase formatted sql
+
+--changeset alice:1
+--comment: Create the team table.
+CREATE TABLE team (id INT PRIMARY KEY);
+--rollback DROP TABLE team;
+
+--changeset bob:2 dbms:mssql
+CREATE INDEX idx_team ON team (id);
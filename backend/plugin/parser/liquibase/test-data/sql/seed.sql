@@ -0,0 +1 @@
+INSERT INTO team (id, name) VALUES (1, 'default');
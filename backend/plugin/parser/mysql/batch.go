@@ -0,0 +1,89 @@
+package mysql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/mysql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterExtractBatchDML(storepb.Engine_MYSQL, ExtractBatchDML)
+}
+
+// ExtractBatchDML extracts the single-table UPDATE or DELETE statement to execute in batches.
+func ExtractBatchDML(statement string) (*base.BatchDML, error) {
+	list, err := ParseMySQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("expect one statement but got %d", len(list))
+	}
+
+	extractor := &batchDMLExtractor{}
+	antlr.ParseTreeWalkerDefault.Walk(extractor, list[0].Tree)
+	if extractor.err != nil {
+		return nil, extractor.err
+	}
+	if extractor.dml == nil {
+		return nil, errors.New("only UPDATE and DELETE statements can be executed in batches")
+	}
+	return extractor.dml, nil
+}
+
+type batchDMLExtractor struct {
+	*parser.BaseMySQLParserListener
+
+	dml *base.BatchDML
+	err error
+}
+
+func (e *batchDMLExtractor) EnterUpdateStatement(ctx *parser.UpdateStatementContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+	if ctx.WithClause() != nil || ctx.OrderClause() != nil || ctx.SimpleLimitClause() != nil {
+		e.err = errors.New("UPDATE statement with WITH, ORDER BY or LIMIT clause cannot be executed in batches")
+		return
+	}
+	tableReferences := ctx.TableReferenceList().AllTableReference()
+	if len(tableReferences) != 1 || len(tableReferences[0].AllJoinedTable()) != 0 || tableReferences[0].TableFactor() == nil || tableReferences[0].TableFactor().SingleTable() == nil {
+		e.err = errors.New("multi-table UPDATE statement cannot be executed in batches")
+		return
+	}
+	database, table := NormalizeMySQLTableRef(tableReferences[0].TableFactor().SingleTable().TableRef())
+	e.dml = newBatchDML(ctx.GetParser().GetTokenStream(), ctx, database, table, ctx.WhereClause())
+}
+
+func (e *batchDMLExtractor) EnterDeleteStatement(ctx *parser.DeleteStatementContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+	if ctx.TableRef() == nil {
+		e.err = errors.New("multi-table DELETE statement cannot be executed in batches")
+		return
+	}
+	if ctx.WithClause() != nil || ctx.OrderClause() != nil || ctx.SimpleLimitClause() != nil {
+		e.err = errors.New("DELETE statement with WITH, ORDER BY or LIMIT clause cannot be executed in batches")
+		return
+	}
+	database, table := NormalizeMySQLTableRef(ctx.TableRef())
+	e.dml = newBatchDML(ctx.GetParser().GetTokenStream(), ctx, database, table, ctx.WhereClause())
+}
+
+func newBatchDML(tokens antlr.TokenStream, ctx antlr.ParserRuleContext, database, table string, where parser.IWhereClauseContext) *base.BatchDML {
+	dml := &base.BatchDML{
+		Schema: database,
+		Table:  table,
+		Prefix: tokens.GetTextFromRuleContext(ctx),
+	}
+	if where != nil {
+		dml.Prefix = tokens.GetTextFromInterval(antlr.NewInterval(ctx.GetStart().GetTokenIndex(), where.GetStart().GetTokenIndex()-1))
+		dml.Condition = tokens.GetTextFromRuleContext(where.Expr())
+	}
+	return dml
+}
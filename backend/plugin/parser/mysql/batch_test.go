@@ -0,0 +1,53 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestExtractBatchDML(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      *base.BatchDML
+		err       bool
+	}{
+		{
+			statement: "UPDATE t SET a = 1 WHERE b > 10",
+			want:      &base.BatchDML{Table: "t", Prefix: "UPDATE t SET a = 1 ", Condition: "b > 10"},
+		},
+		{
+			statement: "DELETE FROM db.t",
+			want:      &base.BatchDML{Schema: "db", Table: "t", Prefix: "DELETE FROM db.t"},
+		},
+		{
+			statement: "DELETE FROM t WHERE a = 1 LIMIT 10",
+			err:       true,
+		},
+		{
+			statement: "UPDATE t1 JOIN t2 ON t1.id = t2.id SET t1.a = 1",
+			err:       true,
+		},
+		{
+			statement: "INSERT INTO t VALUES (1)",
+			err:       true,
+		},
+		{
+			statement: "DELETE FROM t; DELETE FROM t2",
+			err:       true,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := ExtractBatchDML(test.statement)
+		if test.err {
+			a.Error(err, test.statement)
+			continue
+		}
+		a.NoError(err, test.statement)
+		a.Equal(test.want, got, test.statement)
+	}
+}
@@ -0,0 +1,124 @@
+package mysql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/mysql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterRewriteRowFilter(storepb.Engine_MYSQL, RewriteRowFilter)
+	base.RegisterRewriteRowFilter(storepb.Engine_MARIADB, RewriteRowFilter)
+	base.RegisterRewriteRowFilter(storepb.Engine_OCEANBASE, RewriteRowFilter)
+}
+
+// RewriteRowFilter rewrites every table with row filters in the FROM clauses to the subquery reading only the rows matching the predicate.
+// The tables with row filters referenced elsewhere cannot be rewritten, and the functions called are checked by checkFunction.
+func RewriteRowFilter(statement, database, _ string, getRowFilter base.GetRowFilterFunc, checkFunction base.CheckRowFilterFunctionFunc) (string, error) {
+	list, err := ParseMySQL(statement)
+	if err != nil {
+		return "", err
+	}
+
+	var texts []string
+	rewritten := false
+	for _, item := range list {
+		r := &rowFilterRewriter{
+			database:      database,
+			getRowFilter:  getRowFilter,
+			checkFunction: checkFunction,
+			rewriter:      antlr.NewTokenStreamRewriter(item.Tokens),
+		}
+		antlr.ParseTreeWalkerDefault.Walk(r, item.Tree)
+		if r.err != nil {
+			return "", r.err
+		}
+		rewritten = rewritten || r.rewritten
+		texts = append(texts, r.rewriter.GetTextDefault())
+	}
+	if !rewritten {
+		return statement, nil
+	}
+	return strings.Join(texts, "\n"), nil
+}
+
+type rowFilterRewriter struct {
+	*parser.BaseMySQLParserListener
+
+	database      string
+	getRowFilter  base.GetRowFilterFunc
+	checkFunction base.CheckRowFilterFunctionFunc
+	rewriter      *antlr.TokenStreamRewriter
+	rewritten     bool
+	err           error
+}
+
+// EnterFunctionCall checks the functions called in the query, because the tables read by the stored functions cannot be rewritten.
+// The built-in functions with special syntax are not function calls.
+func (r *rowFilterRewriter) EnterFunctionCall(ctx *parser.FunctionCallContext) {
+	if r.err != nil {
+		return
+	}
+	database, function := r.database, ""
+	switch {
+	case ctx.PureIdentifier() != nil:
+		function = ctx.PureIdentifier().GetText()
+		if ctx.PureIdentifier().BACK_TICK_QUOTED_ID() != nil {
+			function = function[1 : len(function)-1]
+		}
+	case ctx.QualifiedIdentifier() != nil:
+		database, function = normalizeMySQLQualifiedIdentifier(ctx.QualifiedIdentifier())
+		if database == "" {
+			database = r.database
+		}
+	default:
+		return
+	}
+	r.err = r.checkFunction(database, "", function)
+}
+
+func (r *rowFilterRewriter) EnterTableRef(ctx *parser.TableRefContext) {
+	if r.err != nil {
+		return
+	}
+	database, table := NormalizeMySQLTableRef(ctx)
+	if database == "" {
+		database = r.database
+	}
+	predicate, err := r.getRowFilter(database, "", table)
+	if err != nil {
+		r.err = err
+		return
+	}
+	if predicate == "" {
+		return
+	}
+	singleTable, ok := ctx.GetParent().(*parser.SingleTableContext)
+	if !ok {
+		r.err = errors.Errorf("table %s.%s with row filters can only be referenced in the FROM clause of SELECT statements", database, table)
+		return
+	}
+
+	// The partition and the index hints are moved into the subquery, and the subquery is aliased as the table.
+	tokens := ctx.GetParser().GetTokenStream()
+	source := tokens.GetTextFromRuleContext(ctx)
+	if singleTable.UsePartition() != nil {
+		source = fmt.Sprintf("%s %s", source, tokens.GetTextFromRuleContext(singleTable.UsePartition()))
+	}
+	if singleTable.IndexHintList() != nil {
+		source = fmt.Sprintf("%s %s", source, tokens.GetTextFromRuleContext(singleTable.IndexHintList()))
+	}
+	alias := fmt.Sprintf("`%s`", strings.ReplaceAll(table, "`", "``"))
+	if singleTable.TableAlias() != nil {
+		alias = tokens.GetTextFromRuleContext(singleTable.TableAlias().Identifier())
+	}
+	r.rewriter.ReplaceDefault(singleTable.GetStart().GetTokenIndex(), singleTable.GetStop().GetTokenIndex(), fmt.Sprintf("%s AS %s", base.RowFilterSubquery(source, predicate), alias))
+	r.rewritten = true
+}
@@ -0,0 +1,89 @@
+package mysql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteRowFilter(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      string
+		err       bool
+	}{
+		{
+			statement: "SELECT * FROM orders",
+			want:      "SELECT * FROM orders",
+		},
+		{
+			statement: "SELECT * FROM customers WHERE id > 1",
+			want:      "SELECT * FROM (SELECT * FROM customers WHERE region = 'EU') AS `customers` WHERE id > 1;",
+		},
+		{
+			statement: "SELECT c.name, o.id FROM crm.customers AS c JOIN orders o ON c.id = o.customer_id",
+			want:      "SELECT c.name, o.id FROM (SELECT * FROM crm.customers WHERE region = 'EU') AS c JOIN orders o ON c.id = o.customer_id;",
+		},
+		{
+			statement: "SELECT * FROM orders WHERE customer_id IN (SELECT id FROM customers USE INDEX (idx_name))",
+			want:      "SELECT * FROM orders WHERE customer_id IN (SELECT id FROM (SELECT * FROM customers USE INDEX (idx_name) WHERE region = 'EU') AS `customers`);",
+		},
+		{
+			statement: "SELECT * FROM other.customers",
+			want:      "SELECT * FROM other.customers",
+		},
+		{
+			statement: "TABLE customers",
+			err:       true,
+		},
+		{
+			// The view reads the customers without the source columns.
+			statement: "SELECT COUNT(*) FROM customer_view",
+			err:       true,
+		},
+		{
+			statement: "SELECT COUNT(*), NOW(), CONCAT('a', 'b') FROM orders",
+			want:      "SELECT COUNT(*), NOW(), CONCAT('a', 'b') FROM orders",
+		},
+		{
+			statement: "SELECT `count_customers`() FROM orders",
+			err:       true,
+		},
+		{
+			statement: "SELECT crm.count_customers()",
+			err:       true,
+		},
+		{
+			statement: "SELECT other.count_customers()",
+			want:      "SELECT other.count_customers()",
+		},
+	}
+
+	getRowFilter := func(database, _, table string) (string, error) {
+		if database == "crm" && table == "customers" {
+			return "region = 'EU'", nil
+		}
+		if database == "crm" && table == "customer_view" {
+			return "", errors.New("view customer_view reads the tables with row filters")
+		}
+		return "", nil
+	}
+	checkFunction := func(database, _, function string) error {
+		if database == "crm" && strings.EqualFold(function, "count_customers") {
+			return errors.New("function count_customers reads the tables with row filters")
+		}
+		return nil
+	}
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := RewriteRowFilter(test.statement, "crm", "", getRowFilter, checkFunction)
+		if test.err {
+			a.Error(err, test.statement)
+			continue
+		}
+		a.NoError(err, test.statement)
+		a.Equal(test.want, got, test.statement)
+	}
+}
@@ -0,0 +1,96 @@
+package pg
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/postgresql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterExtractBatchDML(storepb.Engine_POSTGRES, ExtractBatchDML)
+}
+
+// ExtractBatchDML extracts the single-table UPDATE or DELETE statement to execute in batches.
+func ExtractBatchDML(statement string) (*base.BatchDML, error) {
+	tree, err := ParsePostgreSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+
+	extractor := &batchDMLExtractor{}
+	antlr.ParseTreeWalkerDefault.Walk(extractor, tree.Tree)
+	if extractor.err != nil {
+		return nil, extractor.err
+	}
+	if extractor.count != 1 {
+		return nil, errors.Errorf("expect one statement but got %d", extractor.count)
+	}
+	if extractor.dml == nil {
+		return nil, errors.New("only UPDATE and DELETE statements can be executed in batches")
+	}
+	return extractor.dml, nil
+}
+
+type batchDMLExtractor struct {
+	*parser.BasePostgreSQLParserListener
+
+	count int
+	dml   *base.BatchDML
+	err   error
+}
+
+func (e *batchDMLExtractor) EnterStmt(ctx *parser.StmtContext) {
+	if isTopLevel(ctx) && ctx.GetText() != "" {
+		e.count++
+	}
+}
+
+func (e *batchDMLExtractor) EnterUpdatestmt(ctx *parser.UpdatestmtContext) {
+	if e.err != nil || !isTopLevel(ctx.GetParent()) {
+		return
+	}
+	if hasClause(ctx.Opt_with_clause()) || hasClause(ctx.From_clause()) || hasClause(ctx.Returning_clause()) {
+		e.err = errors.New("UPDATE statement with WITH, FROM or RETURNING clause cannot be executed in batches")
+		return
+	}
+	e.dml, e.err = newBatchDML(ctx.GetParser().GetTokenStream(), ctx, ctx.Relation_expr_opt_alias(), ctx.Where_or_current_clause())
+}
+
+func (e *batchDMLExtractor) EnterDeletestmt(ctx *parser.DeletestmtContext) {
+	if e.err != nil || !isTopLevel(ctx.GetParent()) {
+		return
+	}
+	if hasClause(ctx.Opt_with_clause()) || hasClause(ctx.Using_clause()) || hasClause(ctx.Returning_clause()) {
+		e.err = errors.New("DELETE statement with WITH, USING or RETURNING clause cannot be executed in batches")
+		return
+	}
+	e.dml, e.err = newBatchDML(ctx.GetParser().GetTokenStream(), ctx, ctx.Relation_expr_opt_alias(), ctx.Where_or_current_clause())
+}
+
+func hasClause(ctx antlr.ParserRuleContext) bool {
+	return ctx != nil && ctx.GetText() != ""
+}
+
+func newBatchDML(tokens antlr.TokenStream, ctx antlr.ParserRuleContext, relation parser.IRelation_expr_opt_aliasContext, where parser.IWhere_or_current_clauseContext) (*base.BatchDML, error) {
+	table := extractTableReference(relation)
+	if table == nil {
+		return nil, errors.New("failed to extract the changed table")
+	}
+	dml := &base.BatchDML{
+		Schema: table.Schema,
+		Table:  table.Table,
+		Prefix: tokens.GetTextFromRuleContext(ctx),
+	}
+	if hasClause(where) {
+		if where.CURRENT_P() != nil {
+			return nil, errors.New("WHERE CURRENT OF clause cannot be executed in batches")
+		}
+		dml.Prefix = tokens.GetTextFromInterval(antlr.NewInterval(ctx.GetStart().GetTokenIndex(), where.GetStart().GetTokenIndex()-1))
+		dml.Condition = tokens.GetTextFromRuleContext(where.A_expr())
+	}
+	return dml, nil
+}
@@ -0,0 +1,136 @@
+package pg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/postgresql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterRewriteRowFilter(storepb.Engine_POSTGRES, RewriteRowFilter)
+}
+
+// RewriteRowFilter rewrites every table with row filters in the FROM clauses to the subquery reading only the rows matching the predicate.
+// The tables with row filters referenced elsewhere cannot be rewritten, and the functions called are checked by checkFunction.
+// The schema of the unqualified tables and functions is left empty for the callbacks to resolve by the search path if the schema is empty.
+func RewriteRowFilter(statement, database, schema string, getRowFilter base.GetRowFilterFunc, checkFunction base.CheckRowFilterFunctionFunc) (string, error) {
+	result, err := ParsePostgreSQL(statement)
+	if err != nil {
+		return "", err
+	}
+
+	r := &rowFilterRewriter{
+		database:     database,
+		schema:       schema,
+		getRowFilter:  getRowFilter,
+		checkFunction: checkFunction,
+		rewriter:      antlr.NewTokenStreamRewriter(result.Tokens),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(r, result.Tree)
+	if r.err != nil {
+		return "", r.err
+	}
+	if !r.rewritten {
+		return statement, nil
+	}
+	return r.rewriter.GetTextDefault(), nil
+}
+
+type rowFilterRewriter struct {
+	*parser.BasePostgreSQLParserListener
+
+	database      string
+	schema        string
+	getRowFilter  base.GetRowFilterFunc
+	checkFunction base.CheckRowFilterFunctionFunc
+	rewriter      *antlr.TokenStreamRewriter
+	rewritten     bool
+	err           error
+}
+
+// EnterFunc_application checks the functions called in the query, because the tables read by the functions cannot be rewritten.
+func (r *rowFilterRewriter) EnterFunc_application(ctx *parser.Func_applicationContext) {
+	if r.err != nil {
+		return
+	}
+	database, schema := r.database, r.schema
+	var function string
+	list := normalizePostgreSQLFuncName(ctx.Func_name())
+	switch len(list) {
+	case 3:
+		database, schema, function = list[0], list[1], list[2]
+	case 2:
+		schema, function = list[0], list[1]
+	case 1:
+		function = list[0]
+	default:
+		r.err = errors.Errorf("invalid function name %v", list)
+		return
+	}
+	r.err = r.checkFunction(database, schema, function)
+}
+
+func (r *rowFilterRewriter) EnterRelation_expr(ctx *parser.Relation_exprContext) {
+	if r.err != nil {
+		return
+	}
+	database, schema := r.database, r.schema
+	var table string
+	list := NormalizePostgreSQLQualifiedName(ctx.Qualified_name())
+	switch len(list) {
+	case 3:
+		database, schema, table = list[0], list[1], list[2]
+	case 2:
+		schema, table = list[0], list[1]
+	case 1:
+		table = list[0]
+	default:
+		r.err = errors.Errorf("invalid table name %v", list)
+		return
+	}
+	predicate, err := r.getRowFilter(database, schema, table)
+	if err != nil {
+		r.err = err
+		return
+	}
+	if predicate == "" {
+		return
+	}
+	tableRef, ok := ctx.GetParent().(*parser.Table_refContext)
+	if !ok {
+		r.err = errors.Errorf("table %s.%s with row filters can only be referenced in the FROM clause of SELECT statements", schema, table)
+		return
+	}
+
+	// The table sample is moved into the subquery, and the subquery is aliased as the table if it has no alias.
+	tokens := ctx.GetParser().GetTokenStream()
+	source := tokens.GetTextFromRuleContext(ctx)
+	if hasClause(tableRef.Tablesample_clause()) {
+		source = fmt.Sprintf("%s %s", source, tokens.GetTextFromRuleContext(tableRef.Tablesample_clause()))
+		r.rewriter.DeleteDefault(tableRef.Tablesample_clause().GetStart().GetTokenIndex(), tableRef.Tablesample_clause().GetStop().GetTokenIndex())
+	}
+	text := base.RowFilterSubquery(source, predicate)
+	if !hasClause(tableRef.Opt_alias_clause()) {
+		text = fmt.Sprintf(`%s AS "%s"`, text, strings.ReplaceAll(table, `"`, `""`))
+	}
+	r.rewriter.ReplaceDefault(ctx.GetStart().GetTokenIndex(), ctx.GetStop().GetTokenIndex(), text)
+	r.rewritten = true
+}
+
+func normalizePostgreSQLFuncName(ctx parser.IFunc_nameContext) []string {
+	if ctx.Colid() != nil {
+		return append([]string{NormalizePostgreSQLColid(ctx.Colid())}, normalizePostgreSQLIndirection(ctx.Indirection())...)
+	}
+	if ctx.Type_function_name() != nil && ctx.Type_function_name().Identifier() != nil {
+		return []string{normalizePostgreSQLIdentifier(ctx.Type_function_name().Identifier())}
+	}
+	// The keywords used as the function names are lower case.
+	return []string{strings.ToLower(ctx.GetText())}
+}
@@ -0,0 +1,93 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteRowFilter(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      string
+		err       bool
+	}{
+		{
+			statement: "SELECT * FROM orders",
+			want:      "SELECT * FROM orders",
+		},
+		{
+			statement: "SELECT * FROM customers WHERE id > 1",
+			want:      `SELECT * FROM (SELECT * FROM customers WHERE region = 'EU') AS "customers" WHERE id > 1`,
+		},
+		{
+			statement: "SELECT c.name, o.id FROM public.customers c JOIN orders o ON c.id = o.customer_id",
+			want:      "SELECT c.name, o.id FROM (SELECT * FROM public.customers WHERE region = 'EU') c JOIN orders o ON c.id = o.customer_id",
+		},
+		{
+			statement: "WITH t AS (SELECT id FROM ONLY customers) SELECT * FROM t",
+			want:      `WITH t AS (SELECT id FROM (SELECT * FROM ONLY customers WHERE region = 'EU') AS "customers") SELECT * FROM t`,
+		},
+		{
+			statement: "SELECT * FROM customers TABLESAMPLE SYSTEM (10)",
+			want:      `SELECT * FROM (SELECT * FROM customers TABLESAMPLE SYSTEM (10) WHERE region = 'EU') AS "customers" `,
+		},
+		{
+			statement: "SELECT * FROM other.customers",
+			want:      "SELECT * FROM other.customers",
+		},
+		{
+			statement: "TABLE customers",
+			err:       true,
+		},
+		{
+			// The view reads the customers without the source columns.
+			statement: "SELECT COUNT(*) FROM customer_view",
+			err:       true,
+		},
+		{
+			statement: "SELECT count(*), now(), coalesce(id, 0) FROM orders GROUP BY id",
+			want:      "SELECT count(*), now(), coalesce(id, 0) FROM orders GROUP BY id",
+		},
+		{
+			statement: "SELECT * FROM public.count_customers()",
+			err:       true,
+		},
+		{
+			statement: `SELECT id FROM orders WHERE "count_customers"() > 0`,
+			err:       true,
+		},
+		{
+			statement: "SELECT other.count_customers()",
+			want:      "SELECT other.count_customers()",
+		},
+	}
+
+	// The unqualified tables and functions are resolved to the public schema.
+	getRowFilter := func(_, schema, table string) (string, error) {
+		if (schema == "public" || schema == "") && table == "customers" {
+			return "region = 'EU'", nil
+		}
+		if (schema == "public" || schema == "") && table == "customer_view" {
+			return "", errors.New("view customer_view reads the tables with row filters")
+		}
+		return "", nil
+	}
+	checkFunction := func(_, schema, function string) error {
+		if (schema == "public" || schema == "") && function == "count_customers" {
+			return errors.New("function count_customers reads the tables with row filters")
+		}
+		return nil
+	}
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := RewriteRowFilter(test.statement, "crm", "", getRowFilter, checkFunction)
+		if test.err {
+			a.Error(err, test.statement)
+			continue
+		}
+		a.NoError(err, test.statement)
+		a.Equal(test.want, got, test.statement)
+	}
+}
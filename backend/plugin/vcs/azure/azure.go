@@ -292,16 +292,9 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	values.Set("resolveLfs", "true")
 	values.Set("includeContent", "true")
 	values.Set("path", filePath)
-	var refType string
-	switch refInfo.RefType {
-	case vcs.RefTypeBranch:
-		refType = "branch"
-	case vcs.RefTypeTag:
-		refType = "tag"
-	case vcs.RefTypeCommit:
-		refType = "commit"
-	default:
-		return "", errors.Errorf("invalid ref type %q", refInfo.RefType)
+	refType, err := getVersionType(refInfo.RefType)
+	if err != nil {
+		return "", err
 	}
 	values.Set("versionDescriptor.versionType", refType)
 	values.Set("versionDescriptor.version", refInfo.RefName)
@@ -317,6 +310,74 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	return string(body), nil
 }
 
+// ItemList is the API message for the list of Azure DevOps git items.
+type ItemList struct {
+	Value []Item `json:"value"`
+}
+
+// Item is the API message for Azure DevOps git item.
+type Item struct {
+	Path     string `json:"path"`
+	IsFolder bool   `json:"isFolder"`
+}
+
+// ListFiles lists the paths of all the files under the directory recursively at the ref.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/items/list?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) ListFiles(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	values.Set("scopePath", "/"+strings.Trim(directory, "/"))
+	values.Set("recursionLevel", "Full")
+	refType, err := getVersionType(refInfo.RefType)
+	if err != nil {
+		return nil, err
+	}
+	values.Set("versionDescriptor.versionType", refType)
+	values.Set("versionDescriptor.version", refInfo.RefName)
+	url := fmt.Sprintf("%s/items?%s", apiURL, values.Encode())
+
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list files from URL %s", url)
+	} else if code != http.StatusOK {
+		return nil, errors.Errorf("non-200 GET %s status code %d with body %q", url, code, string(body))
+	}
+
+	items := new(ItemList)
+	if err := json.Unmarshal([]byte(body), items); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal list items response body, code %v", code)
+	}
+	var paths []string
+	for _, item := range items.Value {
+		if !item.IsFolder {
+			paths = append(paths, strings.TrimPrefix(item.Path, "/"))
+		}
+	}
+	return paths, nil
+}
+
+func getVersionType(refType vcs.RefType) (string, error) {
+	switch refType {
+	case vcs.RefTypeBranch:
+		return "branch", nil
+	case vcs.RefTypeTag:
+		return "tag", nil
+	case vcs.RefTypeCommit:
+		return "commit", nil
+	default:
+		return "", errors.Errorf("invalid ref type %q", refType)
+	}
+}
+
 type BranchCommit struct {
 	CommitID string `json:"commitId"`
 }
@@ -348,6 +409,9 @@ func (p *Provider) GetBranch(ctx context.Context, repositoryID, branchName strin
 	if err != nil {
 		return nil, errors.Wrapf(err, "GET %s", url)
 	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get branch from URL %s", url)
+	}
 	if code >= 300 {
 		return nil, errors.Errorf("non-200 GET %s status code %d with body %q", url, code, string(body))
 	}
@@ -379,14 +443,82 @@ func (p *Provider) ListPullRequestFile(ctx context.Context, repositoryID, lastMe
 	return files, nil
 }
 
+type commitDiffsResponse struct {
+	Changes []*CommitChange `json:"changes"`
+}
+
+// CompareCommits lists the files changed from the base commit to the head commit.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/diffs/get?view=azure-devops-rest-7.0&tabs=HTTP
+// TODO(zp): We should GET the diffs pagenated, otherwise it may hit the Azure DevOps API limit.
+func (p *Provider) CompareCommits(ctx context.Context, repositoryID, baseCommitID, headCommitID string) ([]*vcs.PullRequestFile, error) {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	values.Set("baseVersion", baseCommitID)
+	values.Set("baseVersionType", "commit")
+	values.Set("targetVersion", headCommitID)
+	values.Set("targetVersionType", "commit")
+	url := fmt.Sprintf("%s/diffs/commits?%s", apiURL, values.Encode())
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to compare commits from URL %s", url)
+	}
+	if code != http.StatusOK {
+		return nil, errors.Errorf("non-200 GET %s status code %d with body %q", url, code, string(body))
+	}
+
+	diffs := new(commitDiffsResponse)
+	if err := json.Unmarshal([]byte(body), diffs); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal body")
+	}
+	files := []*vcs.PullRequestFile{}
+	for _, change := range diffs.Changes {
+		if change.Item.GitObjectType != "blob" {
+			continue
+		}
+		files = append(files, &vcs.PullRequestFile{
+			Path:         change.Item.Path,
+			LastCommitID: headCommitID,
+			// The change type may be a combination, e.g. "delete, sourceRename".
+			IsDeleted: strings.Contains(change.ChangeType, "delete"),
+		})
+	}
+	return files, nil
+}
+
 type Comment struct {
+	ID          int    `json:"id,omitempty"`
 	Content     string `json:"content"`
 	CommentType string `json:"commentType"`
 }
 
 type PullRequestThread struct {
-	Comments []*Comment `json:"comments"`
-	Status   string     `json:"status"`
+	ID            int            `json:"id,omitempty"`
+	Comments      []*Comment     `json:"comments"`
+	Status        string         `json:"status"`
+	ThreadContext *ThreadContext `json:"threadContext,omitempty"`
+}
+
+// ThreadContext is the file position of the pull request thread.
+type ThreadContext struct {
+	// FilePath is the path of the file starting with "/".
+	FilePath       string        `json:"filePath"`
+	RightFileStart *FilePosition `json:"rightFileStart"`
+	RightFileEnd   *FilePosition `json:"rightFileEnd"`
+}
+
+// FilePosition is the position in the file.
+type FilePosition struct {
+	Line   int `json:"line"`
+	Offset int `json:"offset"`
 }
 
 // CreatePullRequestComment creates a pull request comment.
@@ -427,6 +559,388 @@ func (p *Provider) CreatePullRequestComment(ctx context.Context, repositoryID, p
 	return nil
 }
 
+// ListPullRequestComments lists the comments in the threads of the pull request.
+// The comment ID is in the form of <thread ID>/<comment ID>.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/list?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) ListPullRequestComments(ctx context.Context, repositoryID, pullRequestID string) ([]*vcs.PullRequestComment, error) {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pullRequests/%s/threads?%s", apiURL, pullRequestID, values.Encode())
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code != http.StatusOK {
+		return nil, errors.Errorf("failed to list threads, code: %v, body: %s", code, string(body))
+	}
+	var resp struct {
+		Value []*PullRequestThread `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	var comments []*vcs.PullRequestComment
+	for _, thread := range resp.Value {
+		for _, c := range thread.Comments {
+			if c.CommentType != "text" {
+				continue
+			}
+			comments = append(comments, &vcs.PullRequestComment{ID: fmt.Sprintf("%d/%d", thread.ID, c.ID), Body: c.Content})
+		}
+	}
+	return comments, nil
+}
+
+// UpdatePullRequestComment updates the comment in the thread of the pull request.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-thread-comments/update?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) UpdatePullRequestComment(ctx context.Context, repositoryID, pullRequestID, commentID, comment string) error {
+	threadID, id, ok := strings.Cut(commentID, "/")
+	if !ok {
+		return errors.Errorf("invalid comment ID %q", commentID)
+	}
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(&Comment{Content: comment, CommentType: "text"})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for updating pull request comment")
+	}
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pullRequests/%s/threads/%s/comments/%s?%s", apiURL, pullRequestID, threadID, id, values.Encode())
+	code, body, err := internal.Patch(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "PATCH %s", url)
+	}
+	if code != http.StatusOK {
+		return errors.Errorf("failed to update comment, code: %v, body: %s", code, string(body))
+	}
+	return nil
+}
+
+// CreatePullRequestReviewComments creates a thread on the line of the file in the pull request for each comment.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/create?view=azure-devops-rest-7.1&tabs=HTTP
+func (p *Provider) CreatePullRequestReviewComments(ctx context.Context, repositoryID, pullRequestID, _ string, comments []*vcs.PullRequestReviewComment) error {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pullRequests/%s/threads?%s", apiURL, pullRequestID, values.Encode())
+
+	for _, comment := range comments {
+		filePath := comment.Path
+		if !strings.HasPrefix(filePath, "/") {
+			filePath = "/" + filePath
+		}
+		thread := &PullRequestThread{
+			Status: "active",
+			Comments: []*Comment{
+				{
+					Content:     comment.Body,
+					CommentType: "text",
+				},
+			},
+			ThreadContext: &ThreadContext{
+				FilePath:       filePath,
+				RightFileStart: &FilePosition{Line: comment.Line, Offset: 1},
+				RightFileEnd:   &FilePosition{Line: comment.Line, Offset: 1},
+			},
+		}
+		threadCreatePayload, err := json.Marshal(thread)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request body for creating pull request thread")
+		}
+		code, body, err := internal.Post(ctx, url, p.getAuthorization(), threadCreatePayload)
+		if err != nil {
+			return errors.Wrapf(err, "POST %s", url)
+		}
+		if code != http.StatusOK {
+			return errors.Errorf("failed to create thread, code: %v, body: %s", code, string(body))
+		}
+	}
+	return nil
+}
+
+// CommitStatusContext is the context of the Azure DevOps commit status.
+type CommitStatusContext struct {
+	// Name is the identifier of the status, the status with the same name and genre is overwritten.
+	Name  string `json:"name"`
+	Genre string `json:"genre"`
+}
+
+// CommitStatus is the API message for Azure DevOps commit status.
+type CommitStatus struct {
+	// State is one of "pending", "succeeded", "failed", "error" and "notApplicable".
+	State       string              `json:"state"`
+	Description string              `json:"description,omitempty"`
+	TargetURL   string              `json:"targetUrl,omitempty"`
+	Context     CommitStatusContext `json:"context"`
+}
+
+// SetCommitStatus creates a commit status.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/statuses/create?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) SetCommitStatus(ctx context.Context, repositoryID, commitID string, status *vcs.CommitStatus) error {
+	var state string
+	switch status.State {
+	case vcs.CommitStatePending:
+		state = "pending"
+	case vcs.CommitStateSuccess:
+		state = "succeeded"
+	case vcs.CommitStateFailure:
+		state = "failed"
+	default:
+		return errors.Errorf("unsupported commit state %q", status.State)
+	}
+	statusCreatePayload, err := json.Marshal(CommitStatus{
+		State:       state,
+		Description: status.Description,
+		TargetURL:   status.TargetURL,
+		Context: CommitStatusContext{
+			Name:  status.Context,
+			Genre: "bytebase",
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/commits/%s/statuses?%s", apiURL, commitID, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), statusCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to create commit status, code: %v, body: %s", code, string(body))
+	}
+	return nil
+}
+
+// RefUpdate is the API message for the update of Azure DevOps git ref.
+type RefUpdate struct {
+	Name        string `json:"name"`
+	OldObjectID string `json:"oldObjectId"`
+	NewObjectID string `json:"newObjectId,omitempty"`
+}
+
+// ChangeContent is the API message for the new content of the changed item.
+type ChangeContent struct {
+	Content     string `json:"content"`
+	ContentType string `json:"contentType"`
+}
+
+// PushChange is the API message for the change of the item in the push.
+type PushChange struct {
+	ChangeType string         `json:"changeType"`
+	Item       CommitItem     `json:"item"`
+	NewContent *ChangeContent `json:"newContent"`
+}
+
+// CommitItem is the API message for the item of the change.
+type CommitItem struct {
+	Path string `json:"path"`
+}
+
+// PushCommitCreate is the API message for the commit in the push.
+type PushCommitCreate struct {
+	Comment string        `json:"comment"`
+	Changes []*PushChange `json:"changes"`
+}
+
+// PushCreate is the API message for creating a push.
+type PushCreate struct {
+	RefUpdates []*RefUpdate        `json:"refUpdates"`
+	Commits    []*PushCommitCreate `json:"commits"`
+}
+
+// CommitFile creates or updates the file with the content on the branch by pushing a new commit.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pushes/create?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CommitFile(ctx context.Context, repositoryID, branch, filePath, content, message string) error {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	branchInfo, err := p.GetBranch(ctx, repositoryID, branch)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get branch %q", branch)
+	}
+
+	// The change type is "edit" for the existing file and "add" for the new file.
+	filePath = "/" + strings.TrimPrefix(filePath, "/")
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	values.Set("path", filePath)
+	values.Set("versionDescriptor.versionType", "branch")
+	values.Set("versionDescriptor.version", branch)
+	itemURL := fmt.Sprintf("%s/items?%s", apiURL, values.Encode())
+	code, body, err := internal.Get(ctx, itemURL, p.getAuthorization())
+	if err != nil {
+		return errors.Wrapf(err, "GET %s", itemURL)
+	}
+	changeType := "edit"
+	if code == http.StatusNotFound {
+		changeType = "add"
+	} else if code != http.StatusOK {
+		return errors.Errorf("non-200 GET %s status code %d with body %q", itemURL, code, string(body))
+	}
+
+	payload, err := json.Marshal(&PushCreate{
+		RefUpdates: []*RefUpdate{
+			{
+				Name:        fmt.Sprintf("refs/heads/%s", branch),
+				OldObjectID: branchInfo.LastCommitID,
+			},
+		},
+		Commits: []*PushCommitCreate{
+			{
+				Comment: message,
+				Changes: []*PushChange{
+					{
+						ChangeType: changeType,
+						Item:       CommitItem{Path: filePath},
+						NewContent: &ChangeContent{Content: content, ContentType: "rawtext"},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating push")
+	}
+	values = &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pushes?%s", apiURL, values.Encode())
+	code, body, err = internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to create push, code: %v, body: %s", code, string(body))
+	}
+	return nil
+}
+
+// refUpdateResponse is the API message for the results of the ref updates.
+type refUpdateResponse struct {
+	Value []struct {
+		Name          string `json:"name"`
+		Success       bool   `json:"success"`
+		CustomMessage string `json:"customMessage"`
+	} `json:"value"`
+}
+
+// CreateBranch creates the branch from the commit.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/refs/update-refs?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal([]*RefUpdate{
+		{
+			Name: fmt.Sprintf("refs/heads/%s", branchName),
+			// The zero object ID creates the ref.
+			OldObjectID: "0000000000000000000000000000000000000000",
+			NewObjectID: commitID,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/refs?%s", apiURL, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to create branch, code: %v, body: %s", code, string(body))
+	}
+
+	r := new(refUpdateResponse)
+	if err := json.Unmarshal([]byte(body), r); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal create branch response body, body: %s", string(body))
+	}
+	for _, v := range r.Value {
+		if !v.Success {
+			return errors.Errorf("failed to create branch %q, message: %s", v.Name, v.CustomMessage)
+		}
+	}
+	return nil
+}
+
+// PullRequestCreate is the API message for creating an Azure DevOps pull request.
+type PullRequestCreate struct {
+	SourceRefName string `json:"sourceRefName"`
+	TargetRefName string `json:"targetRefName"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+}
+
+// CreatePullRequest creates the pull request and returns its URL.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/create?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (string, error) {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(&PullRequestCreate{
+		SourceRefName: fmt.Sprintf("refs/heads/%s", create.Head),
+		TargetRefName: fmt.Sprintf("refs/heads/%s", create.Base),
+		Title:         create.Title,
+		Description:   create.Body,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating pull request")
+	}
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pullrequests?%s", apiURL, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+	// Azure DevOps returns 409 if an active pull request already exists for the source and target branches.
+	if code == http.StatusConflict {
+		return "", common.Errorf(common.Conflict, "pull request from %q to %q already exists", create.Head, create.Base)
+	}
+	if code >= 300 {
+		return "", errors.Errorf("failed to create pull request, code: %v, body: %s", code, string(body))
+	}
+
+	type createPullRequestResponse struct {
+		PullRequestID int `json:"pullRequestId"`
+		Repository    struct {
+			WebURL string `json:"webUrl"`
+		} `json:"repository"`
+	}
+	r := new(createPullRequestResponse)
+	if err := json.Unmarshal([]byte(body), r); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal create pull request response body, code %v", code)
+	}
+	return fmt.Sprintf("%s/pullrequest/%d", r.Repository.WebURL, r.PullRequestID), nil
+}
+
 // CreateWebhook creates a webhook in the organization, and returns the webhook ID which can be used in PatchWebhook.
 // API Version 7.0 do not specify the OAuth scope for creating webhook explicitly, but it works.
 //
@@ -66,7 +66,7 @@ type WebhookCreatePublisherInputs struct {
 	// The target branch for PR without "refs/heads/" prefix.
 	Branch string `json:"branch"`
 	// The merge result for PR, we only need the "Succeeded".
-	MergeResult WebhookMergeResult `json:"mergeResult"`
+	MergeResult WebhookMergeResult `json:"mergeResult,omitempty"`
 	ProjectID   string             `json:"projectId"`
 }
 
@@ -79,3 +79,36 @@ type WebhookCreateOrUpdate struct {
 	PublisherID      string                       `json:"publisherId"`
 	PublisherInputs  WebhookCreatePublisherInputs `json:"publisherInputs"`
 }
+
+// PushEvent is the API message for push webhook event, including the pushes of branches and tags.
+//
+// Docs: https://learn.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#code-pushed
+type PushEvent struct {
+	// EventType should be "git.push".
+	EventType string        `json:"eventType"`
+	Resource  *PushResource `json:"resource"`
+}
+
+// PushResource is the API message for push.
+type PushResource struct {
+	Commits    []*PushCommit         `json:"commits"`
+	RefUpdates []*PushRefUpdate      `json:"refUpdates"`
+	Repository *Repository           `json:"repository"`
+	PushedBy   *PullRequestCreatedBy `json:"pushedBy"`
+}
+
+// PushCommit is the API message for the pushed commit.
+type PushCommit struct {
+	CommitID string `json:"commitId"`
+	Comment  string `json:"comment"`
+	URL      string `json:"url"`
+}
+
+// PushRefUpdate is the API message for the ref updated by the push.
+type PushRefUpdate struct {
+	// The full git ref, e.g. refs/heads/main, refs/tags/v1.0.0.
+	Name string `json:"name"`
+	// The object IDs are all zeros if the ref is created or deleted.
+	OldObjectID string `json:"oldObjectId"`
+	NewObjectID string `json:"newObjectId"`
+}
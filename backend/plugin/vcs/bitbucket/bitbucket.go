@@ -89,6 +89,8 @@ type CommitDiffStat struct {
 	// "modified", "renamed".
 	Status string     `json:"status"`
 	New    CommitFile `json:"new"`
+	// Old is the file before the change, which is the only file for the removed status.
+	Old CommitFile `json:"old"`
 }
 
 type PullRequestResponse struct {
@@ -233,6 +235,68 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	return body, nil
 }
 
+// SourceEntries is the API message for the entries of Bitbucket Cloud directory.
+type SourceEntries struct {
+	Values []SourceEntry `json:"values"`
+	Next   string        `json:"next"`
+}
+
+// SourceEntry is the API message for the entry of Bitbucket Cloud directory.
+type SourceEntry struct {
+	Path string `json:"path"`
+	// Type is "commit_file" for files and "commit_directory" for directories.
+	Type string `json:"type"`
+}
+
+// ListFiles lists the paths of all the files under the directory recursively at the ref.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-commit-path-get
+func (p *Provider) ListFiles(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	ref := url.PathEscape(refInfo.RefName)
+	var paths []string
+	directories := []string{strings.Trim(directory, "/")}
+	for len(directories) > 0 {
+		dir := directories[0]
+		directories = directories[1:]
+		if dir != "" {
+			dir += "/"
+		}
+
+		url := fmt.Sprintf("%s/repositories/%s/src/%s/%s?pagelen=100", p.APIURL(p.instanceURL), repositoryID, ref, dir)
+		for url != "" {
+			code, body, err := internal.Get(ctx, url, p.getAuthorization())
+			if err != nil {
+				return nil, errors.Wrapf(err, "GET %s", url)
+			}
+
+			if code == http.StatusNotFound {
+				return nil, common.Errorf(common.NotFound, "failed to list files from URL %s", url)
+			} else if code >= 300 {
+				return nil, errors.Errorf("failed to list files from URL %s, status code: %d, body: %s",
+					url,
+					code,
+					body,
+				)
+			}
+
+			entries := new(SourceEntries)
+			if err := json.Unmarshal([]byte(body), entries); err != nil {
+				return nil, errors.Wrap(err, "unmarshal")
+			}
+			for _, entry := range entries.Values {
+				switch entry.Type {
+				case "commit_file":
+					paths = append(paths, entry.Path)
+				case "commit_directory":
+					directories = append(directories, entry.Path)
+				}
+			}
+			url = entries.Next
+		}
+	}
+	return paths, nil
+}
+
 // Target is the API message for Bitbucket Cloud target.
 type Target struct {
 	Hash string `json:"hash"`
@@ -315,6 +379,35 @@ func (p *Provider) ListPullRequestFile(ctx context.Context, repositoryID, pullRe
 	return files, nil
 }
 
+// CompareCommits lists the files changed from the base commit to the head commit.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commits/#api-repositories-workspace-repo-slug-diffstat-spec-get
+func (p *Provider) CompareCommits(ctx context.Context, repositoryID, baseCommitID, headCommitID string) ([]*vcs.PullRequestFile, error) {
+	var files []*vcs.PullRequestFile
+	// The spec "head..base" is the diff of the head commit against the merge base of the two commits.
+	next := fmt.Sprintf("%s/repositories/%s/diffstat/%s..%s?pagelen=%d", p.APIURL(p.instanceURL), repositoryID, headCommitID, baseCommitID, apiPageSize)
+	for next != "" {
+		var err error
+		var diffs []*CommitDiffStat
+		diffs, next, err = p.fetchPaginatedDiffFileList(ctx, next)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetch paginated list")
+		}
+		for _, d := range diffs {
+			path := d.New.Path
+			if d.Status == "removed" {
+				path = d.Old.Path
+			}
+			files = append(files, &vcs.PullRequestFile{
+				Path:         path,
+				LastCommitID: headCommitID,
+				IsDeleted:    d.Status == "removed",
+			})
+		}
+	}
+	return files, nil
+}
+
 type Comment struct {
 	Content CommentContent `json:"content"`
 }
@@ -351,6 +444,291 @@ func (p *Provider) CreatePullRequestComment(ctx context.Context, repositoryID, p
 	return nil
 }
 
+// PullRequestComment is the API message for the Bitbucket pull request comment.
+type PullRequestComment struct {
+	ID      int            `json:"id"`
+	Content CommentContent `json:"content"`
+	Deleted bool           `json:"deleted"`
+}
+
+// ListPullRequestComments lists the comments of the pull request.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-get
+func (p *Provider) ListPullRequestComments(ctx context.Context, repositoryID, pullRequestID string) ([]*vcs.PullRequestComment, error) {
+	var comments []*vcs.PullRequestComment
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests/%s/comments?pagelen=100", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	for url != "" {
+		code, body, err := internal.Get(ctx, url, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to list pull request comments through URL %s", url)
+		}
+		if code >= 300 {
+			return nil, errors.Errorf("failed to list pull request comments through URL %s, status code: %d, body: %s", url, code, body)
+		}
+		var resp struct {
+			Values []*PullRequestComment `json:"values"`
+			Next   string                `json:"next"`
+		}
+		if err := json.Unmarshal([]byte(body), &resp); err != nil {
+			return nil, errors.Wrap(err, "unmarshal body")
+		}
+		for _, c := range resp.Values {
+			if c.Deleted {
+				continue
+			}
+			comments = append(comments, &vcs.PullRequestComment{ID: strconv.Itoa(c.ID), Body: c.Content.Raw})
+		}
+		url = resp.Next
+	}
+	return comments, nil
+}
+
+// UpdatePullRequestComment updates the comment on the pull request.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-comment-id-put
+func (p *Provider) UpdatePullRequestComment(ctx context.Context, repositoryID, pullRequestID, commentID, comment string) error {
+	payload, err := json.Marshal(Comment{Content: CommentContent{Raw: comment}})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for updating pull request comment")
+	}
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests/%s/comments/%s", p.APIURL(p.instanceURL), repositoryID, pullRequestID, commentID)
+	code, body, err := internal.Put(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "PUT %s", url)
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to update pull request comment through URL %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to update pull request comment through URL %s, status code: %d, body: %s", url, code, body)
+	}
+	return nil
+}
+
+// CommentInline is the API message for the inline position of the comment.
+type CommentInline struct {
+	Path string `json:"path"`
+	// To is the line number in the new version of the file.
+	To int `json:"to"`
+}
+
+// InlineComment is the API message for the inline comment.
+type InlineComment struct {
+	Content CommentContent `json:"content"`
+	Inline  CommentInline  `json:"inline"`
+}
+
+// CreatePullRequestReviewComments creates an inline pull request comment for each comment.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-post
+func (p *Provider) CreatePullRequestReviewComments(ctx context.Context, repositoryID, pullRequestID, _ string, comments []*vcs.PullRequestReviewComment) error {
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests/%s/comments", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	for _, comment := range comments {
+		commentCreatePayload, err := json.Marshal(InlineComment{
+			Content: CommentContent{Raw: comment.Body},
+			Inline: CommentInline{
+				Path: comment.Path,
+				To:   comment.Line,
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request body for creating pull request comment")
+		}
+		code, body, err := internal.Post(ctx, url, p.getAuthorization(), commentCreatePayload)
+		if err != nil {
+			return errors.Wrapf(err, "POST %s", url)
+		}
+
+		if code == http.StatusNotFound {
+			return common.Errorf(common.NotFound, "failed to create pull request comment through URL %s", url)
+		} else if code >= 300 {
+			return errors.Errorf("failed to create pull request comment through URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+		}
+	}
+	return nil
+}
+
+// CommitStatus is the API message for Bitbucket commit build status.
+type CommitStatus struct {
+	// Key is the identifier of the status, the status with the same key is overwritten.
+	Key string `json:"key"`
+	// State is one of "INPROGRESS", "SUCCESSFUL", "FAILED" and "STOPPED".
+	State       string `json:"state"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// SetCommitStatus creates a build status for the commit, which overwrites the previous status with the same key.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commit-statuses/#api-repositories-workspace-repo-slug-commit-commit-statuses-build-post
+func (p *Provider) SetCommitStatus(ctx context.Context, repositoryID, commitID string, status *vcs.CommitStatus) error {
+	var state string
+	switch status.State {
+	case vcs.CommitStatePending:
+		state = "INPROGRESS"
+	case vcs.CommitStateSuccess:
+		state = "SUCCESSFUL"
+	case vcs.CommitStateFailure:
+		state = "FAILED"
+	default:
+		return errors.Errorf("unsupported commit state %q", status.State)
+	}
+	statusCreatePayload, err := json.Marshal(CommitStatus{
+		Key:         status.Context,
+		State:       state,
+		Name:        status.Context,
+		URL:         status.TargetURL,
+		Description: status.Description,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/repositories/%s/commit/%s/statuses/build", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), statusCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitFile creates or updates the file with the content on the branch.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-post
+func (p *Provider) CommitFile(ctx context.Context, repositoryID, branch, filePath, content, message string) error {
+	form := url.Values{}
+	form.Set("message", message)
+	form.Set("branch", branch)
+	// The field name is the path of the file and the field value is the content of the file.
+	form.Set("/"+strings.TrimPrefix(filePath, "/"), content)
+
+	url := fmt.Sprintf("%s/repositories/%s/src", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.PostWithHeader(ctx, url, p.getAuthorization(),
+		map[string]string{
+			"Content-Type": "application/x-www-form-urlencoded",
+		},
+		[]byte(form.Encode()),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to commit file through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to commit file through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CreateBranch creates the branch from the commit.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-branches-post
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	payload, err := json.Marshal(Branch{
+		Name:   branchName,
+		Target: Target{Hash: commitID},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	url := fmt.Sprintf("%s/repositories/%s/refs/branches", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// PullRequestBranch is the API message for the branch of Bitbucket Cloud pull request.
+type PullRequestBranch struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+}
+
+// PullRequestCreate is the API message for creating a Bitbucket Cloud pull request.
+type PullRequestCreate struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Source      PullRequestBranch `json:"source"`
+	Destination PullRequestBranch `json:"destination"`
+}
+
+// PullRequest is the API message for Bitbucket Cloud pull request.
+type PullRequest struct {
+	Links Links `json:"links"`
+}
+
+// CreatePullRequest creates the pull request and returns its URL.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-post
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (string, error) {
+	pullRequestCreate := PullRequestCreate{
+		Title:       create.Title,
+		Description: create.Body,
+	}
+	pullRequestCreate.Source.Branch.Name = create.Head
+	pullRequestCreate.Destination.Branch.Name = create.Base
+	payload, err := json.Marshal(pullRequestCreate)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating pull request")
+	}
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create pull request through URL %s", url)
+	}
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create pull request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var pullRequest PullRequest
+	if err := json.Unmarshal([]byte(body), &pullRequest); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return pullRequest.Links.HTML.Href, nil
+}
+
 // Link is the API message for link.
 type Link struct {
 	Href string `json:"href"`
@@ -37,3 +37,32 @@ type EventLinks struct {
 type EventHTML struct {
 	Href string `json:"href"`
 }
+
+// Header X-Event-Key: repo:push.
+// PushEvent is the json message for push event, including the pushes of branches and tags.
+type PushEvent struct {
+	Push EventPush `json:"push"`
+}
+
+type EventPush struct {
+	Changes []EventPushChange `json:"changes"`
+}
+
+type EventPushChange struct {
+	// New is nil if the ref is deleted, and Old is nil if the ref is created.
+	New   *EventRef  `json:"new"`
+	Old   *EventRef  `json:"old"`
+	Links EventLinks `json:"links"`
+}
+
+type EventRef struct {
+	// branch, tag.
+	Type   string            `json:"type"`
+	Name   string            `json:"name"`
+	Target EventTargetCommit `json:"target"`
+}
+
+type EventTargetCommit struct {
+	Hash    string `json:"hash"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,276 @@
+package vcs_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
+	_ "github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	_ "github.com/bytebase/bytebase/backend/plugin/vcs/git"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	commitFileBranch  = "main"
+	commitFilePath    = "schema/prod/db.sql"
+	commitFileContent = "CREATE TABLE t(id INT);"
+	commitFileMessage = "Update schema"
+	existingBlobSHA   = "3d21ec53a331a6f037a91c368710b99387d012c1"
+)
+
+// TestCommitFile commits the file to each provider with and without the existing file,
+// and checks the requests in the format of the provider.
+func TestCommitFile(t *testing.T) {
+	tests := []struct {
+		vcsType      storepb.VCSType
+		repositoryID string
+		authToken    string
+		// serve starts the provider with or without the existing file, and returns the instance URL
+		// and the function to check the committed file after CommitFile returns.
+		serve func(t *testing.T, exists bool) (string, func())
+	}{
+		{vcsType: storepb.VCSType_GITHUB, repositoryID: "octocat/hello", authToken: "token", serve: serveGitHub},
+		{vcsType: storepb.VCSType_GITEA, repositoryID: "octocat/hello", authToken: "token", serve: serveGitea},
+		{vcsType: storepb.VCSType_GITLAB, repositoryID: "123", authToken: "token", serve: serveGitLab},
+		{vcsType: storepb.VCSType_BITBUCKET, repositoryID: "octocat/hello", authToken: "user:token", serve: serveBitbucket},
+		{vcsType: storepb.VCSType_AZURE_DEVOPS, repositoryID: "org/project/repo", authToken: "token", serve: serveAzure},
+		{vcsType: storepb.VCSType_GIT, serve: serveGit},
+	}
+
+	for _, test := range tests {
+		for _, exists := range []bool{false, true} {
+			instanceURL, check := test.serve(t, exists)
+			provider := vcs.Get(test.vcsType, vcs.ProviderConfig{InstanceURL: instanceURL, AuthToken: test.authToken})
+			err := provider.CommitFile(context.Background(), test.repositoryID, commitFileBranch, commitFilePath, commitFileContent, commitFileMessage)
+			require.NoError(t, err, test.vcsType.String())
+			check()
+		}
+	}
+}
+
+// serveGitHub checks that the file is put with the blob SHA of the existing file.
+func serveGitHub(t *testing.T, exists bool) (string, func()) {
+	var got *github.FileCommit
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v3/repos/octocat/hello/contents/"+commitFilePath, r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			require.Equal(t, commitFileBranch, r.URL.Query().Get("ref"))
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(github.File{SHA: existingBlobSHA})
+		case http.MethodPut:
+			got = new(github.FileCommit)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(got))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server.URL, func() {
+		wantSHA := ""
+		if exists {
+			wantSHA = existingBlobSHA
+		}
+		require.Equal(t, &github.FileCommit{
+			Message: commitFileMessage,
+			Content: base64.StdEncoding.EncodeToString([]byte(commitFileContent)),
+			SHA:     wantSHA,
+			Branch:  commitFileBranch,
+		}, got)
+	}
+}
+
+// serveGitea checks that the file is created by POST, and updated by PUT with the blob SHA of the existing file.
+func serveGitea(t *testing.T, exists bool) (string, func()) {
+	var gotMethod string
+	var got *gitea.FileCommit
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/repos/octocat/hello/contents/"+commitFilePath, r.URL.Path)
+		if r.Method == http.MethodGet {
+			require.Equal(t, commitFileBranch, r.URL.Query().Get("ref"))
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(gitea.File{SHA: existingBlobSHA})
+			return
+		}
+		gotMethod = r.Method
+		got = new(gitea.FileCommit)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(got))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	t.Cleanup(server.Close)
+	return server.URL, func() {
+		wantMethod, wantSHA := http.MethodPost, ""
+		if exists {
+			wantMethod, wantSHA = http.MethodPut, existingBlobSHA
+		}
+		require.Equal(t, wantMethod, gotMethod)
+		require.NotNil(t, got)
+		require.Equal(t, commitFileMessage, got.Message)
+		require.Equal(t, commitFileBranch, got.Branch)
+		require.Equal(t, wantSHA, got.SHA)
+		require.Equal(t, base64.StdEncoding.EncodeToString([]byte(commitFileContent)), got.Content)
+	}
+}
+
+// serveGitLab checks that the file is created by POST and updated by PUT with the raw content.
+func serveGitLab(t *testing.T, exists bool) (string, func()) {
+	var gotMethod string
+	var got *gitlab.FileCommit
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			require.Equal(t, "/api/v4/projects/123/repository/files/"+commitFilePath+"/raw", r.URL.Path)
+			require.Equal(t, commitFileBranch, r.URL.Query().Get("ref"))
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte("CREATE TABLE t(id BIGINT);"))
+			return
+		}
+		require.Equal(t, "/api/v4/projects/123/repository/files/"+commitFilePath, r.URL.Path)
+		gotMethod = r.Method
+		got = new(gitlab.FileCommit)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(got))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	t.Cleanup(server.Close)
+	return server.URL, func() {
+		wantMethod := http.MethodPost
+		if exists {
+			wantMethod = http.MethodPut
+		}
+		require.Equal(t, wantMethod, gotMethod)
+		require.Equal(t, &gitlab.FileCommit{Branch: commitFileBranch, Content: commitFileContent, CommitMessage: commitFileMessage}, got)
+	}
+}
+
+// serveBitbucket checks that the file is posted as a form field named after the absolute path, whether it exists or not.
+func serveBitbucket(t *testing.T, _ bool) (string, func()) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/2.0/repositories/octocat/hello/src", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, commitFileMessage, r.PostForm.Get("message"))
+		require.Equal(t, commitFileBranch, r.PostForm.Get("branch"))
+		require.Equal(t, commitFileContent, r.PostForm.Get("/"+commitFilePath))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	t.Cleanup(server.Close)
+	return server.URL, func() {
+		require.True(t, called)
+	}
+}
+
+// serveAzure checks that the file is pushed on the head of the branch with the "add" or "edit" change.
+func serveAzure(t *testing.T, exists bool) (string, func()) {
+	const repositoryPath = "/org/project/_apis/git/repositories/repo"
+	var got *azure.PushCreate
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case repositoryPath + "/stats/branches":
+			require.Equal(t, commitFileBranch, r.URL.Query().Get("name"))
+			_ = json.NewEncoder(w).Encode(azure.Branch{Name: commitFileBranch, Commit: &azure.BranchCommit{CommitID: "abc"}})
+		case repositoryPath + "/items":
+			require.Equal(t, "/"+commitFilePath, r.URL.Query().Get("path"))
+			require.Equal(t, commitFileBranch, r.URL.Query().Get("versionDescriptor.version"))
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case repositoryPath + "/pushes":
+			require.Equal(t, http.MethodPost, r.Method)
+			got = new(azure.PushCreate)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(got))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server.URL, func() {
+		wantChangeType := "add"
+		if exists {
+			wantChangeType = "edit"
+		}
+		require.Equal(t, &azure.PushCreate{
+			RefUpdates: []*azure.RefUpdate{{Name: "refs/heads/" + commitFileBranch, OldObjectID: "abc"}},
+			Commits: []*azure.PushCommitCreate{{
+				Comment: commitFileMessage,
+				Changes: []*azure.PushChange{{
+					ChangeType: wantChangeType,
+					Item:       azure.CommitItem{Path: "/" + commitFilePath},
+					NewContent: &azure.ChangeContent{Content: commitFileContent, ContentType: "rawtext"},
+				}},
+			}},
+		}, got)
+	}
+}
+
+// serveGit creates a bare repository as the remote, and checks the file in the commit pushed to the branch.
+func serveGit(t *testing.T, exists bool) (string, func()) {
+	dir := t.TempDir()
+	remote, err := gogit.PlainInit(dir, true /* isBare */)
+	require.NoError(t, err)
+
+	local, err := gogit.Init(memory.NewStorage(), memfs.New())
+	require.NoError(t, err)
+	worktree, err := local.Worktree()
+	require.NoError(t, err)
+	files := map[string]string{"README.md": "hello"}
+	if exists {
+		files[commitFilePath] = "CREATE TABLE t(id BIGINT);"
+	}
+	for path, content := range files {
+		require.NoError(t, util.WriteFile(worktree.Filesystem, path, []byte(content), 0644))
+		_, err = worktree.Add(path)
+		require.NoError(t, err)
+	}
+	signature := &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()}
+	_, err = worktree.Commit("init", &gogit.CommitOptions{Author: signature, Committer: signature})
+	require.NoError(t, err)
+	_, err = local.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{dir}})
+	require.NoError(t, err)
+	require.NoError(t, local.Push(&gogit.PushOptions{
+		RemoteName: "origin",
+		RefSpecs:   []config.RefSpec{config.RefSpec("refs/heads/master:refs/heads/" + commitFileBranch)},
+	}))
+
+	return dir, func() {
+		ref, err := remote.Reference(plumbing.NewBranchReferenceName(commitFileBranch), true)
+		require.NoError(t, err)
+		commit, err := remote.CommitObject(ref.Hash())
+		require.NoError(t, err)
+		require.Equal(t, commitFileMessage, commit.Message)
+		file, err := commit.File(commitFilePath)
+		require.NoError(t, err)
+		got, err := file.Contents()
+		require.NoError(t, err)
+		require.Equal(t, commitFileContent, got)
+	}
+}
@@ -0,0 +1,154 @@
+package vcs
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// FilePathTemplateRoot is the placeholder for the base directory of the VCS connector.
+	FilePathTemplateRoot = "{{ROOT}}"
+	// FilePathTemplateEnvironmentID is the placeholder for the environment ID of the target databases.
+	FilePathTemplateEnvironmentID = "{{ENV_ID}}"
+	// FilePathTemplateDatabaseName is the placeholder for the name of the target databases.
+	FilePathTemplateDatabaseName = "{{DB_NAME}}"
+	// FilePathTemplateDatabaseGroup is the placeholder for the ID of the target database group.
+	FilePathTemplateDatabaseGroup = "{{DB_GROUP}}"
+	// FilePathTemplateVersion is the placeholder for the schema version.
+	FilePathTemplateVersion = "{{VERSION}}"
+	// FilePathTemplateType is the placeholder for the change type, one of "ddl", "dml" and "ghost".
+	FilePathTemplateType = "{{TYPE}}"
+	// FilePathTemplateDescription is the placeholder for the description.
+	FilePathTemplateDescription = "{{DESC}}"
+)
+
+var filePathTemplateTokenRE = regexp.MustCompile(`\{\{[A-Z_]+\}\}|\*\*/|\*`)
+
+// FilePathTemplate is the compiled file path template of a VCS connector.
+//
+// A template is a slash separated path with placeholders, e.g. {{ROOT}}/{{ENV_ID}}/{{DB_NAME}}/{{VERSION}}_{{TYPE}}_{{DESC}}.sql.
+// "**/" matches zero or more directories, and "*" matches any characters except "/".
+type FilePathTemplate struct {
+	re *regexp.Regexp
+}
+
+// FilePathInfo is the information extracted from a file path by the file path template.
+type FilePathInfo struct {
+	EnvironmentID string
+	DatabaseName  string
+	DatabaseGroup string
+	Version       string
+	Type          string
+	Description   string
+}
+
+// ParseFilePathTemplate compiles the file path template with the base directory.
+func ParseFilePathTemplate(template, baseDirectory string) (*FilePathTemplate, error) {
+	if strings.Contains(template, FilePathTemplateDatabaseName) && strings.Contains(template, FilePathTemplateDatabaseGroup) {
+		return nil, errors.Errorf("file path template %q cannot contain both %s and %s", template, FilePathTemplateDatabaseName, FilePathTemplateDatabaseGroup)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("^")
+	seen := make(map[string]bool)
+	last := 0
+	for _, loc := range filePathTemplateTokenRE.FindAllStringIndex(template, -1) {
+		buf.WriteString(regexp.QuoteMeta(template[last:loc[0]]))
+		last = loc[1]
+
+		token := template[loc[0]:loc[1]]
+		switch token {
+		case "**/":
+			buf.WriteString(`(?:[^/]+/)*`)
+			continue
+		case "*":
+			buf.WriteString(`[^/]*`)
+			continue
+		}
+		if seen[token] {
+			return nil, errors.Errorf("duplicate %s in file path template %q", token, template)
+		}
+		seen[token] = true
+		switch token {
+		case FilePathTemplateRoot:
+			buf.WriteString(regexp.QuoteMeta(strings.TrimSuffix(baseDirectory, "/")))
+		case FilePathTemplateEnvironmentID:
+			buf.WriteString(`(?P<ENV_ID>[^/]+)`)
+		case FilePathTemplateDatabaseName:
+			buf.WriteString(`(?P<DB_NAME>[^/]+)`)
+		case FilePathTemplateDatabaseGroup:
+			buf.WriteString(`(?P<DB_GROUP>[^/]+)`)
+		case FilePathTemplateVersion:
+			buf.WriteString(`(?P<VERSION>[0-9]+)`)
+		case FilePathTemplateType:
+			buf.WriteString(`(?P<TYPE>ddl|dml|ghost)`)
+		case FilePathTemplateDescription:
+			buf.WriteString(`(?P<DESC>[^/]*)`)
+		default:
+			return nil, errors.Errorf("unknown placeholder %s in file path template %q", token, template)
+		}
+	}
+	buf.WriteString(regexp.QuoteMeta(template[last:]))
+	buf.WriteString("$")
+
+	re, err := regexp.Compile(buf.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to compile file path template %q", template)
+	}
+	return &FilePathTemplate{re: re}, nil
+}
+
+// Match matches the file path against the template, and returns nil if the file path does not match.
+// The file path is normalized to start with "/".
+func (t *FilePathTemplate) Match(filePath string) *FilePathInfo {
+	if !strings.HasPrefix(filePath, "/") {
+		filePath = "/" + filePath
+	}
+	matches := t.re.FindStringSubmatch(filePath)
+	if matches == nil {
+		return nil
+	}
+	info := &FilePathInfo{}
+	for i, name := range t.re.SubexpNames() {
+		switch name {
+		case "ENV_ID":
+			info.EnvironmentID = matches[i]
+		case "DB_NAME":
+			info.DatabaseName = matches[i]
+		case "DB_GROUP":
+			info.DatabaseGroup = matches[i]
+		case "VERSION":
+			info.Version = matches[i]
+		case "TYPE":
+			info.Type = matches[i]
+		case "DESC":
+			info.Description = matches[i]
+		}
+	}
+	return info
+}
+
+// RenderFilePathTemplate renders the file path of the database from the template without wildcards,
+// which only supports the placeholders {{ROOT}}, {{ENV_ID}} and {{DB_NAME}}.
+// The file path is relative to the repository root without the leading "/".
+func RenderFilePathTemplate(template, baseDirectory, environmentID, databaseName string) (string, error) {
+	for _, token := range filePathTemplateTokenRE.FindAllString(template, -1) {
+		switch token {
+		case FilePathTemplateRoot, FilePathTemplateEnvironmentID, FilePathTemplateDatabaseName:
+		default:
+			return "", errors.Errorf("unsupported %s in file path template %q", token, template)
+		}
+	}
+	if !strings.Contains(template, FilePathTemplateDatabaseName) {
+		return "", errors.Errorf("file path template %q must contain %s", template, FilePathTemplateDatabaseName)
+	}
+	filePath := strings.NewReplacer(
+		FilePathTemplateRoot, strings.Trim(baseDirectory, "/"),
+		FilePathTemplateEnvironmentID, environmentID,
+		FilePathTemplateDatabaseName, databaseName,
+	).Replace(template)
+	return path.Clean(strings.TrimPrefix(filePath, "/")), nil
+}
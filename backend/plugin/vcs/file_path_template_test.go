@@ -0,0 +1,112 @@
+package vcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilePathTemplate(t *testing.T) {
+	tests := []struct {
+		template      string
+		baseDirectory string
+		path          string
+		want          *FilePathInfo
+	}{
+		{
+			template:      "{{ROOT}}/{{DB_NAME}}/{{VERSION}}_{{TYPE}}_{{DESC}}.sql",
+			baseDirectory: "/migrations",
+			path:          "migrations/employee/0001_ddl_create_table.sql",
+			want:          &FilePathInfo{DatabaseName: "employee", Version: "0001", Type: "ddl", Description: "create_table"},
+		},
+		{
+			template:      "{{ROOT}}/{{ENV_ID}}/{{DB_NAME}}/V{{VERSION}}__{{DESC}}.sql",
+			baseDirectory: "/migrations",
+			path:          "/migrations/prod/employee/V1__init.sql",
+			want:          &FilePathInfo{EnvironmentID: "prod", DatabaseName: "employee", Version: "1", Description: "init"},
+		},
+		{
+			template:      "{{ROOT}}/**/{{DB_GROUP}}/{{VERSION}}_{{DESC}}.sql",
+			baseDirectory: "/",
+			path:          "/a/b/tenants/123_add_column.sql",
+			want:          &FilePathInfo{DatabaseGroup: "tenants", Version: "123", Description: "add_column"},
+		},
+		{
+			template:      "{{ROOT}}/**/{{DB_GROUP}}/{{VERSION}}_{{DESC}}.sql",
+			baseDirectory: "/",
+			path:          "/tenants/123_add_column.sql",
+			want:          &FilePathInfo{DatabaseGroup: "tenants", Version: "123", Description: "add_column"},
+		},
+		{
+			// The templates of the declarative schema files have no version.
+			// The versioned migration files are required to have the version by the VCS connector validation.
+			template:      "{{ROOT}}/{{ENV_ID}}/{{DB_NAME}}/**/{{DESC}}.sql",
+			baseDirectory: "/schema",
+			path:          "/schema/prod/employee/tables/salary.sql",
+			want:          &FilePathInfo{EnvironmentID: "prod", DatabaseName: "employee", Description: "salary"},
+		},
+		{
+			template:      "{{ROOT}}/{{DB_NAME}}/{{VERSION}}_{{DESC}}.sql",
+			baseDirectory: "/migrations",
+			path:          "/other/employee/0001_init.sql",
+			want:          nil,
+		},
+		{
+			template:      "{{ROOT}}/{{DB_NAME}}/{{VERSION}}_{{DESC}}.sql",
+			baseDirectory: "/migrations",
+			path:          "/migrations/employee/README.md",
+			want:          nil,
+		},
+	}
+
+	for _, test := range tests {
+		template, err := ParseFilePathTemplate(test.template, test.baseDirectory)
+		require.NoError(t, err)
+		require.Equal(t, test.want, template.Match(test.path), test.path)
+	}
+}
+
+func TestParseFilePathTemplateError(t *testing.T) {
+	for _, template := range []string{
+		"{{ROOT}}/{{DB_NAME}}/{{DB_GROUP}}/{{VERSION}}.sql",
+		"{{ROOT}}/{{VERSION}}/{{VERSION}}.sql",
+		"{{ROOT}}/{{UNKNOWN}}/{{VERSION}}.sql",
+	} {
+		_, err := ParseFilePathTemplate(template, "/")
+		require.Error(t, err, template)
+	}
+}
+
+func TestRenderFilePathTemplate(t *testing.T) {
+	tests := []struct {
+		template      string
+		baseDirectory string
+		want          string
+	}{
+		{
+			template:      "{{ROOT}}/schema/{{ENV_ID}}/{{DB_NAME}}.sql",
+			baseDirectory: "/migrations/",
+			want:          "migrations/schema/prod/employee.sql",
+		},
+		{
+			template:      "{{ROOT}}/{{DB_NAME}}.sql",
+			baseDirectory: "/",
+			want:          "employee.sql",
+		},
+	}
+
+	for _, test := range tests {
+		got, err := RenderFilePathTemplate(test.template, test.baseDirectory, "prod", "employee")
+		require.NoError(t, err)
+		require.Equal(t, test.want, got, test.template)
+	}
+
+	for _, template := range []string{
+		"{{ROOT}}/{{ENV_ID}}.sql",
+		"{{ROOT}}/{{DB_NAME}}/{{VERSION}}.sql",
+		"{{ROOT}}/**/{{DB_NAME}}.sql",
+	} {
+		_, err := RenderFilePathTemplate(template, "/", "prod", "employee")
+		require.Error(t, err, template)
+	}
+}
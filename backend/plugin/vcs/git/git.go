@@ -0,0 +1,518 @@
+// Package git is the plugin for the plain Git servers over HTTPS or SSH without a hosting-provider API.
+package git
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/go-git/go-git/v5/utils/merkletrie"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	remoteName = "origin"
+	// defaultUser is the user of the repository URL without the user info, e.g. git@example.com:org/repo.git.
+	defaultUser = "git"
+	// committerName and committerEmail are the committer of the commits pushed by Bytebase.
+	committerName  = "Bytebase"
+	committerEmail = "support@bytebase.com"
+)
+
+func init() {
+	vcs.Register(storepb.VCSType_GIT, newProvider)
+}
+
+var _ vcs.Provider = (*Provider)(nil)
+
+// Provider is a generic Git VCS provider.
+//
+// The instance URL of the provider is the URL of a single repository, e.g. https://git.example.com/org/repo.git
+// or ssh://git@git.example.com/org/repo.git, and the repository ID is ignored.
+// The auth token is the password or the access token for HTTPS, or the PEM encoded private key for SSH.
+// The SSH host keys are verified with the known_hosts files, see SSH_KNOWN_HOSTS.
+//
+// The pull requests, the commit statuses and the webhooks are not available on a plain Git server,
+// so the repository is polled for the new commits instead.
+type Provider struct {
+	instanceURL string
+	authToken   string
+}
+
+func newProvider(config vcs.ProviderConfig) vcs.Provider {
+	return &Provider{
+		instanceURL: config.InstanceURL,
+		authToken:   config.AuthToken,
+	}
+}
+
+// APIURL returns the URL of the repository, as there is no API for a plain Git server.
+func (*Provider) APIURL(instanceURL string) string {
+	return instanceURL
+}
+
+// Commit is the commit in the repository.
+type Commit struct {
+	ID          string
+	Message     string
+	AuthorEmail string
+}
+
+// repository is a bare clone of the remote repository in memory, which is shared by the providers with the same URL and credentials.
+type repository struct {
+	sync.Mutex
+	repo *gogit.Repository
+	// lastUsed is the last time the repository is used, which is guarded by repositoriesMu.
+	lastUsed time.Time
+}
+
+// maxRepositories is the max number of the repositories cached in memory.
+// The least recently used repository is evicted when the limit is reached.
+const maxRepositories = 32
+
+var (
+	repositoriesMu sync.Mutex
+	repositories   = make(map[string]*repository)
+)
+
+// repositoryKey returns the key of the cached repository of the provider.
+// The repositories are not shared across the credentials, otherwise the objects fetched with one credential
+// would be served to the providers without the access to the repository.
+func (p *Provider) repositoryKey() string {
+	return fmt.Sprintf("%s#%x", p.instanceURL, sha256.Sum256([]byte(p.authToken)))
+}
+
+func (p *Provider) getRepository() (*repository, error) {
+	repositoriesMu.Lock()
+	defer repositoriesMu.Unlock()
+	key := p.repositoryKey()
+	if r, ok := repositories[key]; ok {
+		r.lastUsed = time.Now()
+		return r, nil
+	}
+	repo, err := gogit.Init(memory.NewStorage(), nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to init repository")
+	}
+	if _, err := repo.CreateRemote(&config.RemoteConfig{Name: remoteName, URLs: []string{p.instanceURL}}); err != nil {
+		return nil, errors.Wrapf(err, "failed to create remote")
+	}
+	if len(repositories) >= maxRepositories {
+		evictLeastRecentlyUsedRepository()
+	}
+	r := &repository{repo: repo, lastUsed: time.Now()}
+	repositories[key] = r
+	return r, nil
+}
+
+// evictLeastRecentlyUsedRepository evicts the least recently used repository from the cache.
+// The evicted repository is still valid for the callers holding it.
+// The caller must hold repositoriesMu.
+func evictLeastRecentlyUsedRepository() {
+	var evictKey string
+	var evictTime time.Time
+	for key, r := range repositories {
+		if evictKey == "" || r.lastUsed.Before(evictTime) {
+			evictKey, evictTime = key, r.lastUsed
+		}
+	}
+	delete(repositories, evictKey)
+}
+
+func (p *Provider) auth() (transport.AuthMethod, error) {
+	if p.authToken == "" {
+		return nil, nil
+	}
+	endpoint, err := transport.NewEndpoint(p.instanceURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid repository URL %q", p.instanceURL)
+	}
+	user := endpoint.User
+	if user == "" {
+		user = defaultUser
+	}
+	switch endpoint.Protocol {
+	case "ssh":
+		auth, err := gitssh.NewPublicKeys(user, []byte(p.authToken), "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the SSH private key")
+		}
+		return auth, nil
+	case "http", "https":
+		return &githttp.BasicAuth{Username: user, Password: p.authToken}, nil
+	default:
+		return nil, errors.Errorf("unsupported protocol %q of repository URL %q", endpoint.Protocol, p.instanceURL)
+	}
+}
+
+// fetch fetches the branches and the tags from the remote repository.
+// The caller must hold the lock of the repository.
+func (p *Provider) fetch(ctx context.Context, r *repository) error {
+	auth, err := p.auth()
+	if err != nil {
+		return err
+	}
+	if err := r.repo.FetchContext(ctx, &gogit.FetchOptions{
+		RemoteName: remoteName,
+		RefSpecs: []config.RefSpec{
+			config.RefSpec(fmt.Sprintf("+refs/heads/*:refs/remotes/%s/*", remoteName)),
+			"+refs/tags/*:refs/tags/*",
+		},
+		Auth:  auth,
+		Force: true,
+	}); err != nil && err != gogit.NoErrAlreadyUpToDate && err != transport.ErrEmptyRemoteRepository {
+		return errors.Wrapf(err, "failed to fetch repository %q", p.instanceURL)
+	}
+	return nil
+}
+
+// getCommit gets the commit by the ID, and fetches the repository if the commit is not fetched yet.
+// The caller must hold the lock of the repository.
+func (p *Provider) getCommit(ctx context.Context, r *repository, commitID string) (*object.Commit, error) {
+	hash := plumbing.NewHash(commitID)
+	commit, err := r.repo.CommitObject(hash)
+	if err == plumbing.ErrObjectNotFound {
+		if err := p.fetch(ctx, r); err != nil {
+			return nil, err
+		}
+		commit, err = r.repo.CommitObject(hash)
+	}
+	if err == plumbing.ErrObjectNotFound {
+		return nil, common.Errorf(common.NotFound, "commit %q not found", commitID)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get commit %q", commitID)
+	}
+	return commit, nil
+}
+
+// resolveCommit fetches the repository and gets the commit of the ref.
+// The caller must hold the lock of the repository.
+func (p *Provider) resolveCommit(ctx context.Context, r *repository, refInfo vcs.RefInfo) (*object.Commit, error) {
+	var revision plumbing.Revision
+	switch refInfo.RefType {
+	case vcs.RefTypeCommit:
+		return p.getCommit(ctx, r, refInfo.RefName)
+	case vcs.RefTypeBranch:
+		revision = plumbing.Revision(plumbing.NewRemoteReferenceName(remoteName, refInfo.RefName))
+	case vcs.RefTypeTag:
+		revision = plumbing.Revision(plumbing.NewTagReferenceName(refInfo.RefName))
+	default:
+		return nil, errors.Errorf("unknown ref type %q", refInfo.RefType)
+	}
+	if err := p.fetch(ctx, r); err != nil {
+		return nil, err
+	}
+	hash, err := r.repo.ResolveRevision(revision)
+	if err == plumbing.ErrReferenceNotFound {
+		return nil, common.Errorf(common.NotFound, "%s %q not found", refInfo.RefType, refInfo.RefName)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve %s %q", refInfo.RefType, refInfo.RefName)
+	}
+	return p.getCommit(ctx, r, hash.String())
+}
+
+// FetchRepositoryList returns the repository of the provider after checking the access to it.
+func (p *Provider) FetchRepositoryList(ctx context.Context, _ bool) ([]*vcs.Repository, error) {
+	auth, err := p.auth()
+	if err != nil {
+		return nil, err
+	}
+	remote := gogit.NewRemote(memory.NewStorage(), &config.RemoteConfig{Name: remoteName, URLs: []string{p.instanceURL}})
+	if _, err := remote.ListContext(ctx, &gogit.ListOptions{Auth: auth}); err != nil && err != transport.ErrEmptyRemoteRepository {
+		return nil, errors.Wrapf(err, "failed to list the refs of repository %q", p.instanceURL)
+	}
+	endpoint, err := transport.NewEndpoint(p.instanceURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid repository URL %q", p.instanceURL)
+	}
+	fullPath := strings.TrimSuffix(strings.Trim(endpoint.Path, "/"), ".git")
+	return []*vcs.Repository{
+		{
+			ID:       p.instanceURL,
+			Name:     path.Base(fullPath),
+			FullPath: fullPath,
+			WebURL:   p.instanceURL,
+		},
+	}, nil
+}
+
+// ReadFileContent reads the content of the given file in the repository.
+func (p *Provider) ReadFileContent(ctx context.Context, _, filePath string, refInfo vcs.RefInfo) (string, error) {
+	r, err := p.getRepository()
+	if err != nil {
+		return "", err
+	}
+	r.Lock()
+	defer r.Unlock()
+
+	commit, err := p.resolveCommit(ctx, r, refInfo)
+	if err != nil {
+		return "", err
+	}
+	file, err := commit.File(strings.TrimPrefix(filePath, "/"))
+	if err == object.ErrFileNotFound {
+		return "", common.Errorf(common.NotFound, "file %q not found at %s %q", filePath, refInfo.RefType, refInfo.RefName)
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get file %q", filePath)
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read file %q", filePath)
+	}
+	return content, nil
+}
+
+// ListFiles lists the paths of all the files under the directory recursively at the ref.
+func (p *Provider) ListFiles(ctx context.Context, _, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	r, err := p.getRepository()
+	if err != nil {
+		return nil, err
+	}
+	r.Lock()
+	defer r.Unlock()
+
+	commit, err := p.resolveCommit(ctx, r, refInfo)
+	if err != nil {
+		return nil, err
+	}
+	files, err := commit.Files()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list files of commit %q", commit.Hash)
+	}
+	var paths []string
+	if err := files.ForEach(func(file *object.File) error {
+		if vcs.IsUnderDirectory(file.Name, directory) {
+			paths = append(paths, file.Name)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list files of commit %q", commit.Hash)
+	}
+	return paths, nil
+}
+
+// GetBranch gets the given branch in the repository.
+func (p *Provider) GetBranch(ctx context.Context, _, branchName string) (*vcs.BranchInfo, error) {
+	r, err := p.getRepository()
+	if err != nil {
+		return nil, err
+	}
+	r.Lock()
+	defer r.Unlock()
+
+	commit, err := p.resolveCommit(ctx, r, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: branchName})
+	if err != nil {
+		return nil, err
+	}
+	return &vcs.BranchInfo{
+		Name:         branchName,
+		LastCommitID: commit.Hash.String(),
+	}, nil
+}
+
+// GetCommit gets the commit in the repository.
+func (p *Provider) GetCommit(ctx context.Context, commitID string) (*Commit, error) {
+	r, err := p.getRepository()
+	if err != nil {
+		return nil, err
+	}
+	r.Lock()
+	defer r.Unlock()
+
+	commit, err := p.getCommit(ctx, r, commitID)
+	if err != nil {
+		return nil, err
+	}
+	return &Commit{
+		ID:          commit.Hash.String(),
+		Message:     commit.Message,
+		AuthorEmail: commit.Author.Email,
+	}, nil
+}
+
+// ListPullRequestFile is not supported, as there is no pull request on a plain Git server.
+func (*Provider) ListPullRequestFile(context.Context, string, string) ([]*vcs.PullRequestFile, error) {
+	return nil, errors.New("pull requests are not supported by the generic Git VCS")
+}
+
+// CompareCommits lists the files changed from the base commit to the head commit.
+func (p *Provider) CompareCommits(ctx context.Context, _, baseCommitID, headCommitID string) ([]*vcs.PullRequestFile, error) {
+	r, err := p.getRepository()
+	if err != nil {
+		return nil, err
+	}
+	r.Lock()
+	defer r.Unlock()
+
+	base, err := p.getCommit(ctx, r, baseCommitID)
+	if err != nil {
+		return nil, err
+	}
+	head, err := p.getCommit(ctx, r, headCommitID)
+	if err != nil {
+		return nil, err
+	}
+	baseTree, err := base.Tree()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get tree of commit %q", baseCommitID)
+	}
+	headTree, err := head.Tree()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get tree of commit %q", headCommitID)
+	}
+	changes, err := object.DiffTreeWithOptions(ctx, baseTree, headTree, object.DefaultDiffTreeOptions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to compare commits %q and %q", baseCommitID, headCommitID)
+	}
+
+	var files []*vcs.PullRequestFile
+	for _, change := range changes {
+		action, err := change.Action()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the action of the change")
+		}
+		if action == merkletrie.Delete {
+			files = append(files, &vcs.PullRequestFile{
+				Path:         change.From.Name,
+				LastCommitID: headCommitID,
+				IsDeleted:    true,
+			})
+			continue
+		}
+		files = append(files, &vcs.PullRequestFile{
+			Path:         change.To.Name,
+			LastCommitID: headCommitID,
+		})
+	}
+	return files, nil
+}
+
+// CreatePullRequestComment is not supported, as there is no pull request on a plain Git server.
+func (*Provider) CreatePullRequestComment(context.Context, string, string, string) error {
+	return errors.New("pull requests are not supported by the generic Git VCS")
+}
+
+// ListPullRequestComments is not supported, as there is no pull request on a plain Git server.
+func (*Provider) ListPullRequestComments(context.Context, string, string) ([]*vcs.PullRequestComment, error) {
+	return nil, errors.New("pull requests are not supported by the generic Git VCS")
+}
+
+// UpdatePullRequestComment is not supported, as there is no pull request on a plain Git server.
+func (*Provider) UpdatePullRequestComment(context.Context, string, string, string, string) error {
+	return errors.New("pull requests are not supported by the generic Git VCS")
+}
+
+// CreatePullRequestReviewComments is not supported, as there is no pull request on a plain Git server.
+func (*Provider) CreatePullRequestReviewComments(context.Context, string, string, string, []*vcs.PullRequestReviewComment) error {
+	return errors.New("pull requests are not supported by the generic Git VCS")
+}
+
+// SetCommitStatus does nothing, as there is no commit status on a plain Git server.
+func (*Provider) SetCommitStatus(context.Context, string, string, *vcs.CommitStatus) error {
+	return nil
+}
+
+// CommitFile creates or updates the file with the content on the branch in a new commit, and pushes the commit.
+func (p *Provider) CommitFile(ctx context.Context, _, branch, filePath, content, message string) error {
+	auth, err := p.auth()
+	if err != nil {
+		return err
+	}
+	repo, err := gogit.CloneContext(ctx, memory.NewStorage(), memfs.New(), &gogit.CloneOptions{
+		URL:           p.instanceURL,
+		Auth:          auth,
+		RemoteName:    remoteName,
+		ReferenceName: plumbing.NewBranchReferenceName(branch),
+		SingleBranch:  true,
+		Depth:         1,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to clone branch %q", branch)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get worktree")
+	}
+	filePath = strings.TrimPrefix(filePath, "/")
+	if err := util.WriteFile(worktree.Filesystem, filePath, []byte(content), 0644); err != nil {
+		return errors.Wrapf(err, "failed to write file %q", filePath)
+	}
+	if _, err := worktree.Add(filePath); err != nil {
+		return errors.Wrapf(err, "failed to add file %q", filePath)
+	}
+	signature := &object.Signature{Name: committerName, Email: committerEmail, When: time.Now()}
+	if _, err := worktree.Commit(message, &gogit.CommitOptions{Author: signature, Committer: signature}); err != nil {
+		return errors.Wrapf(err, "failed to commit file %q", filePath)
+	}
+	if err := repo.PushContext(ctx, &gogit.PushOptions{RemoteName: remoteName, Auth: auth}); err != nil {
+		return errors.Wrapf(err, "failed to push branch %q", branch)
+	}
+	return nil
+}
+
+// CreateBranch creates the branch from the commit.
+func (p *Provider) CreateBranch(ctx context.Context, _, branchName, commitID string) error {
+	r, err := p.getRepository()
+	if err != nil {
+		return err
+	}
+	r.Lock()
+	defer r.Unlock()
+
+	commit, err := p.getCommit(ctx, r, commitID)
+	if err != nil {
+		return err
+	}
+	refName := plumbing.NewBranchReferenceName(branchName)
+	if err := r.repo.Storer.SetReference(plumbing.NewHashReference(refName, commit.Hash)); err != nil {
+		return errors.Wrapf(err, "failed to create branch %q", branchName)
+	}
+	auth, err := p.auth()
+	if err != nil {
+		return err
+	}
+	if err := r.repo.PushContext(ctx, &gogit.PushOptions{
+		RemoteName: remoteName,
+		RefSpecs:   []config.RefSpec{config.RefSpec(fmt.Sprintf("%s:%s", refName, refName))},
+		Auth:       auth,
+	}); err != nil && err != gogit.NoErrAlreadyUpToDate {
+		return errors.Wrapf(err, "failed to push branch %q", branchName)
+	}
+	return nil
+}
+
+// CreatePullRequest is not supported, as there is no pull request on a plain Git server.
+func (*Provider) CreatePullRequest(context.Context, string, *vcs.PullRequestCreate) (string, error) {
+	return "", errors.New("pull requests are not supported by the generic Git VCS")
+}
+
+// CreateWebhook is not supported, as the repository is polled instead.
+func (*Provider) CreateWebhook(context.Context, string, []byte) (string, error) {
+	return "", errors.New("webhooks are not supported by the generic Git VCS")
+}
+
+// DeleteWebhook does nothing, as no webhook is created for the generic Git VCS.
+func (*Provider) DeleteWebhook(context.Context, string, string) error {
+	return nil
+}
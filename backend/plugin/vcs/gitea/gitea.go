@@ -0,0 +1,831 @@
+// Package gitea is the plugin for Gitea and Forgejo.
+package gitea
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/internal"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// apiPageSize is the default page size when making API requests.
+	// Gitea caps the page size with the MAX_RESPONSE_ITEMS setting, which defaults to 50.
+	apiPageSize = 50
+)
+
+func init() {
+	vcs.Register(storepb.VCSType_GITEA, newProvider)
+}
+
+var _ vcs.Provider = (*Provider)(nil)
+
+// Provider is a Gitea VCS provider, which also works with Forgejo.
+type Provider struct {
+	client      *http.Client
+	instanceURL string
+	authToken   string
+}
+
+func newProvider(config vcs.ProviderConfig) vcs.Provider {
+	return &Provider{
+		client:      &http.Client{},
+		instanceURL: config.InstanceURL,
+		authToken:   config.AuthToken,
+	}
+}
+
+// APIURL returns the API URL path of Gitea.
+func (*Provider) APIURL(instanceURL string) string {
+	return fmt.Sprintf("%s/api/v1", strings.TrimSuffix(instanceURL, "/"))
+}
+
+// Repository represents a Gitea API response for a repository.
+type Repository struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	FullName    string `json:"full_name"`
+	HTMLURL     string `json:"html_url"`
+	Permissions struct {
+		Admin bool `json:"admin"`
+	} `json:"permissions"`
+}
+
+// WebhookInfo represents a Gitea API response for the webhook information.
+type WebhookInfo struct {
+	ID int `json:"id"`
+}
+
+// WebhookConfig represents the Gitea API message for webhook configuration.
+type WebhookConfig struct {
+	// URL is the URL to which the payloads will be delivered.
+	URL string `json:"url"`
+	// ContentType is the media type used to serialize the payloads. Supported
+	// values include "json" and "form".
+	ContentType string `json:"content_type"`
+	// Secret is the secret will be used as the key to generate the HMAC hex digest
+	// value for the X-Gitea-Signature header.
+	Secret string `json:"secret"`
+}
+
+// WebhookCreate represents a Gitea API request for creating a webhook.
+type WebhookCreate struct {
+	// Type is the type of the webhook, "gitea" for the native JSON payloads.
+	// Forgejo accepts both "gitea" and "forgejo".
+	Type string `json:"type"`
+	// Config contains settings for the webhook.
+	Config WebhookConfig `json:"config"`
+	// Events determines what events the hook is triggered for.
+	Events []string `json:"events"`
+	// BranchFilter is the glob pattern of the branches to trigger the hook.
+	BranchFilter string `json:"branch_filter,omitempty"`
+	Active       bool   `json:"active"`
+}
+
+// FetchRepositoryList fetches all repositories where the authenticated user
+// has admin permissions, which is required to create webhook in the repository.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/user/operation/userCurrentListRepos
+func (p *Provider) FetchRepositoryList(ctx context.Context, listAll bool) ([]*vcs.Repository, error) {
+	var giteaRepos []Repository
+	page := 1
+	for {
+		repos, hasNextPage, err := p.fetchPaginatedRepositoryList(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetch paginated list")
+		}
+		giteaRepos = append(giteaRepos, repos...)
+
+		if !hasNextPage || !listAll {
+			break
+		}
+		page++
+	}
+
+	var allRepos []*vcs.Repository
+	for _, r := range giteaRepos {
+		if !r.Permissions.Admin {
+			continue
+		}
+		allRepos = append(allRepos,
+			&vcs.Repository{
+				// The API paths of Gitea repositories are in the form of "owner/repo".
+				ID:       r.FullName,
+				Name:     r.Name,
+				FullPath: r.FullName,
+				WebURL:   r.HTMLURL,
+			},
+		)
+	}
+	return allRepos, nil
+}
+
+// fetchPaginatedRepositoryList fetches repositories where the authenticated
+// user has access to in given page. It returns the paginated results along
+// with a boolean indicating whether the next page exists.
+func (p *Provider) fetchPaginatedRepositoryList(ctx context.Context, page int) (repos []Repository, hasNextPage bool, err error) {
+	url := fmt.Sprintf("%s/user/repos?page=%d&limit=%d", p.APIURL(p.instanceURL), page, apiPageSize)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, false, common.Errorf(common.NotFound, "failed to fetch repository list from URL %s", url)
+	} else if code >= 300 {
+		return nil, false,
+			errors.Errorf("failed to fetch repository list from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+
+	if err := json.Unmarshal([]byte(body), &repos); err != nil {
+		return nil, false, errors.Wrap(err, "unmarshal")
+	}
+	return repos, len(repos) >= apiPageSize, nil
+}
+
+// ReadFileContent reads the content of the given file in the repository.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoGetRawFile
+func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath string, refInfo vcs.RefInfo) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/raw/%s?ref=%s", p.APIURL(p.instanceURL), repositoryID, escapePath(filePath), url.QueryEscape(refInfo.RefName))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return "", errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to read file content from URL %s", url)
+	} else if code >= 300 {
+		return "",
+			errors.Errorf("failed to read file content from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+	return body, nil
+}
+
+// Tree is the API message for Gitea git tree.
+type Tree struct {
+	Tree []TreeEntry `json:"tree"`
+	// Truncated is true if there are more entries in the next pages.
+	Truncated bool `json:"truncated"`
+}
+
+// TreeEntry is the API message for the entry of Gitea git tree.
+type TreeEntry struct {
+	Path string `json:"path"`
+	// Type is "blob" for files and "tree" for directories.
+	Type string `json:"type"`
+}
+
+// ListFiles lists the paths of all the files under the directory recursively at the ref.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/GetTree
+func (p *Provider) ListFiles(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	var paths []string
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("%s/repos/%s/git/trees/%s?recursive=true&page=%d", p.APIURL(p.instanceURL), repositoryID, url.PathEscape(refInfo.RefName), page)
+		code, body, err := internal.Get(ctx, url, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to list files from URL %s", url)
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to list files from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+		}
+
+		tree := new(Tree)
+		if err := json.Unmarshal([]byte(body), tree); err != nil {
+			return nil, errors.Wrap(err, "unmarshal")
+		}
+		for _, entry := range tree.Tree {
+			if entry.Type == "blob" && vcs.IsUnderDirectory(entry.Path, directory) {
+				paths = append(paths, entry.Path)
+			}
+		}
+		if !tree.Truncated || len(tree.Tree) == 0 {
+			break
+		}
+	}
+	return paths, nil
+}
+
+// PullRequest is the API message for Gitea pull request.
+type PullRequest struct {
+	HTMLURL string      `json:"html_url"`
+	Head    EventBranch `json:"head"`
+}
+
+// Comparison is the API message for the comparison of two Gitea commits.
+type Comparison struct {
+	Commits []ComparisonCommit `json:"commits"`
+}
+
+// ComparisonCommit is the API message for the commit in the comparison.
+type ComparisonCommit struct {
+	Files []CommitAffectedFile `json:"files"`
+}
+
+// CommitAffectedFile is the API message for the file affected by the commit.
+type CommitAffectedFile struct {
+	FileName string `json:"filename"`
+	// Available values: "added", "removed", "modified".
+	Status string `json:"status"`
+}
+
+// CompareCommits lists the files changed from the base commit to the head commit.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoCompareDiff
+func (p *Provider) CompareCommits(ctx context.Context, repositoryID, baseCommitID, headCommitID string) ([]*vcs.PullRequestFile, error) {
+	url := fmt.Sprintf("%s/repos/%s/compare/%s...%s", p.APIURL(p.instanceURL), repositoryID, baseCommitID, headCommitID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to compare commits from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to compare commits from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	comparison := new(Comparison)
+	if err := json.Unmarshal([]byte(body), comparison); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	// The comparison only contains the files affected by each commit, the status of a file is the one of its last commit.
+	var res []*vcs.PullRequestFile
+	files := map[string]*vcs.PullRequestFile{}
+	for _, commit := range comparison.Commits {
+		for _, f := range commit.Files {
+			file, ok := files[f.FileName]
+			if !ok {
+				file = &vcs.PullRequestFile{
+					Path:         f.FileName,
+					LastCommitID: headCommitID,
+				}
+				files[f.FileName] = file
+				res = append(res, file)
+			}
+			file.IsDeleted = f.Status == "removed" || f.Status == "deleted"
+		}
+	}
+	return res, nil
+}
+
+// PullRequestFile is the API message for files in Gitea pull request.
+type PullRequestFile struct {
+	FileName string `json:"filename"`
+	// The file status in Gitea PR.
+	// Available values: "added", "deleted", "changed", "renamed", "copied", "unchanged".
+	Status string `json:"status"`
+}
+
+// ListPullRequestFile lists the changed files in the pull request.
+//
+// Gitea does not return the commit of each file, so we use the head commit of the pull request.
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoGetPullRequestFiles
+func (p *Provider) ListPullRequestFile(ctx context.Context, repositoryID, pullRequestID string) ([]*vcs.PullRequestFile, error) {
+	pr, err := p.getPullRequest(ctx, repositoryID, pullRequestID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pull request")
+	}
+
+	var allPRFiles []PullRequestFile
+	page := 1
+	for {
+		fileList, err := p.listPaginatedPullRequestFile(ctx, repositoryID, pullRequestID, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to list pull request file")
+		}
+
+		allPRFiles = append(allPRFiles, fileList...)
+		if len(fileList) < apiPageSize {
+			break
+		}
+		page++
+	}
+
+	var res []*vcs.PullRequestFile
+	for _, file := range allPRFiles {
+		res = append(res, &vcs.PullRequestFile{
+			Path:         file.FileName,
+			LastCommitID: pr.Head.SHA,
+			IsDeleted:    file.Status == "deleted",
+		})
+	}
+	return res, nil
+}
+
+// getPullRequest gets the pull request.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoGetPullRequest
+func (p *Provider) getPullRequest(ctx context.Context, repositoryID, pullRequestID string) (*PullRequest, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get pull request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get pull request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	pr := new(PullRequest)
+	if err := json.Unmarshal([]byte(body), pr); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// listPaginatedPullRequestFile lists the changed files in the pull request with pagination.
+func (p *Provider) listPaginatedPullRequestFile(ctx context.Context, repositoryID, pullRequestID string, page int) ([]PullRequestFile, error) {
+	requestURL := fmt.Sprintf("%s/repos/%s/pulls/%s/files?limit=%d&page=%d", p.APIURL(p.instanceURL), repositoryID, pullRequestID, apiPageSize, page)
+	code, body, err := internal.Get(ctx, requestURL, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", requestURL)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list pull request file from URL %s", requestURL)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list pull request file from URL %s, status code: %d, body: %s",
+			requestURL,
+			code,
+			body,
+		)
+	}
+
+	var prFiles []PullRequestFile
+	if err := json.Unmarshal([]byte(body), &prFiles); err != nil {
+		return nil, err
+	}
+	return prFiles, nil
+}
+
+type Comment struct {
+	Body string `json:"body"`
+}
+
+// CreatePullRequestComment creates a comment on the pull request.
+//
+// Gitea shares the index and the comments between issues and pull requests.
+// Docs: https://docs.gitea.com/api/1.22/#tag/issue/operation/issueCreateComment
+func (p *Provider) CreatePullRequestComment(ctx context.Context, repositoryID, pullRequestID, comment string) error {
+	commentMessage := Comment{Body: comment}
+	commentCreatePayload, err := json.Marshal(commentMessage)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating pull request comment")
+	}
+	url := fmt.Sprintf("%s/repos/%s/issues/%s/comments", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), commentCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request comment through URL %s", url)
+	}
+
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create pull request comment through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// IssueComment is the API message for the Gitea issue comment.
+type IssueComment struct {
+	ID   int64  `json:"id"`
+	Body string `json:"body"`
+}
+
+// ListPullRequestComments lists the comments of the pull request.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/issue/operation/issueGetComments
+func (p *Provider) ListPullRequestComments(ctx context.Context, repositoryID, pullRequestID string) ([]*vcs.PullRequestComment, error) {
+	url := fmt.Sprintf("%s/repos/%s/issues/%s/comments", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list pull request comments through URL %s", url)
+	}
+	if code >= 300 {
+		return nil, errors.Errorf("failed to list pull request comments through URL %s, status code: %d, body: %s", url, code, body)
+	}
+	var issueComments []*IssueComment
+	if err := json.Unmarshal([]byte(body), &issueComments); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	var comments []*vcs.PullRequestComment
+	for _, c := range issueComments {
+		comments = append(comments, &vcs.PullRequestComment{ID: strconv.FormatInt(c.ID, 10), Body: c.Body})
+	}
+	return comments, nil
+}
+
+// UpdatePullRequestComment updates the comment on the pull request.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/issue/operation/issueEditComment
+func (p *Provider) UpdatePullRequestComment(ctx context.Context, repositoryID, _, commentID, comment string) error {
+	payload, err := json.Marshal(Comment{Body: comment})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for updating pull request comment")
+	}
+	url := fmt.Sprintf("%s/repos/%s/issues/comments/%s", p.APIURL(p.instanceURL), repositoryID, commentID)
+	code, body, err := internal.Patch(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "PATCH %s", url)
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to update pull request comment through URL %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to update pull request comment through URL %s, status code: %d, body: %s", url, code, body)
+	}
+	return nil
+}
+
+// ReviewComment is the API message for Gitea pull request review comment.
+type ReviewComment struct {
+	Path string `json:"path"`
+	// NewPosition is the line number in the new version of the file.
+	NewPosition int    `json:"new_position"`
+	Body        string `json:"body"`
+}
+
+// ReviewCreate is the API message for creating Gitea pull request review.
+type ReviewCreate struct {
+	CommitID string `json:"commit_id"`
+	// Event is the review state, COMMENT for the review without approval or change request.
+	Event    string           `json:"event"`
+	Body     string           `json:"body,omitempty"`
+	Comments []*ReviewComment `json:"comments"`
+}
+
+// CreatePullRequestReviewComments creates a pull request review with the comments on the lines.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoCreatePullReview
+func (p *Provider) CreatePullRequestReviewComments(ctx context.Context, repositoryID, pullRequestID, commitID string, comments []*vcs.PullRequestReviewComment) error {
+	review := ReviewCreate{
+		CommitID: commitID,
+		Event:    "COMMENT",
+	}
+	for _, comment := range comments {
+		review.Comments = append(review.Comments, &ReviewComment{
+			Path:        comment.Path,
+			NewPosition: comment.Line,
+			Body:        comment.Body,
+		})
+	}
+	reviewCreatePayload, err := json.Marshal(review)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating pull request review")
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s/reviews", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), reviewCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request review through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create pull request review through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitStatus is the API message for Gitea commit status.
+type CommitStatus struct {
+	// State is one of "pending", "success", "error", "failure" and "warning".
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+	Context     string `json:"context"`
+}
+
+// SetCommitStatus creates a commit status, which overwrites the previous status with the same context.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoCreateStatus
+func (p *Provider) SetCommitStatus(ctx context.Context, repositoryID, commitID string, status *vcs.CommitStatus) error {
+	statusCreatePayload, err := json.Marshal(CommitStatus{
+		State:       string(status.State),
+		TargetURL:   status.TargetURL,
+		Description: status.Description,
+		Context:     status.Context,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/repos/%s/statuses/%s", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), statusCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// Branch is the API message for Gitea branch.
+type Branch struct {
+	Name   string       `json:"name"`
+	Commit BranchCommit `json:"commit"`
+}
+
+// BranchCommit is the last commit of the Gitea branch.
+type BranchCommit struct {
+	ID string `json:"id"`
+}
+
+// GetBranch gets the given branch in the repository.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoGetBranch
+func (p *Provider) GetBranch(ctx context.Context, repositoryID, branchName string) (*vcs.BranchInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/branches/%s", p.APIURL(p.instanceURL), repositoryID, escapePath(branchName))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get branch from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get branch from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	res := new(Branch)
+	if err := json.Unmarshal([]byte(body), res); err != nil {
+		return nil, err
+	}
+
+	return &vcs.BranchInfo{
+		Name:         res.Name,
+		LastCommitID: res.Commit.ID,
+	}, nil
+}
+
+// File is the API message for Gitea file content.
+type File struct {
+	SHA string `json:"sha"`
+}
+
+// FileCommit is the API message for creating or updating a Gitea file.
+type FileCommit struct {
+	Message string `json:"message"`
+	Content string `json:"content"`
+	SHA     string `json:"sha,omitempty"`
+	Branch  string `json:"branch"`
+}
+
+// CommitFile creates or updates the file with the content on the branch.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoCreateFile
+// and https://docs.gitea.com/api/1.22/#tag/repository/operation/repoUpdateFile
+func (p *Provider) CommitFile(ctx context.Context, repositoryID, branch, filePath, content, message string) error {
+	url := fmt.Sprintf("%s/repos/%s/contents/%s", p.APIURL(p.instanceURL), repositoryID, escapePath(filePath))
+
+	// The blob SHA of the existing file is required to update it.
+	commit := internal.Post
+	var sha string
+	code, body, err := internal.Get(ctx, fmt.Sprintf("%s?ref=%s", url, escapePath(branch)), p.getAuthorization())
+	if err != nil {
+		return errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusOK {
+		file := new(File)
+		if err := json.Unmarshal([]byte(body), file); err != nil {
+			return errors.Wrap(err, "unmarshal body")
+		}
+		commit, sha = internal.Put, file.SHA
+	} else if code != http.StatusNotFound {
+		return errors.Errorf("failed to get file from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	payload, err := json.Marshal(FileCommit{
+		Message: message,
+		Content: base64.StdEncoding.EncodeToString([]byte(content)),
+		SHA:     sha,
+		Branch:  branch,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for committing file")
+	}
+	code, body, err = commit(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "commit file %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to commit file through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to commit file through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// BranchCreate is the API message for creating a Gitea branch.
+type BranchCreate struct {
+	NewBranchName string `json:"new_branch_name"`
+	OldRefName    string `json:"old_ref_name"`
+}
+
+// CreateBranch creates the branch from the commit.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoCreateBranch
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	payload, err := json.Marshal(BranchCreate{
+		NewBranchName: branchName,
+		OldRefName:    commitID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	url := fmt.Sprintf("%s/repos/%s/branches", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// PullRequestCreate is the API message for creating a Gitea pull request.
+type PullRequestCreate struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+}
+
+// CreatePullRequest creates the pull request and returns its URL.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoCreatePullRequest
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (string, error) {
+	payload, err := json.Marshal(PullRequestCreate{
+		Title: create.Title,
+		Body:  create.Body,
+		Head:  create.Head,
+		Base:  create.Base,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating pull request")
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create pull request through URL %s", url)
+	}
+	// Gitea returns 409 if a pull request already exists for the head and base branches.
+	if code == http.StatusConflict {
+		return "", common.Errorf(common.Conflict, "pull request from %q to %q already exists", create.Head, create.Base)
+	}
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create pull request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	pullRequest := new(PullRequest)
+	if err := json.Unmarshal([]byte(body), pullRequest); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return pullRequest.HTMLURL, nil
+}
+
+// CreateWebhook creates a webhook in the repository with given payload.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoCreateHook
+func (p *Provider) CreateWebhook(ctx context.Context, repositoryID string, payload []byte) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/hooks", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create webhook through URL %s", url)
+	}
+
+	// Gitea returns 201 HTTP status codes upon successful webhook creation.
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create webhook through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var webhookInfo WebhookInfo
+	if err = json.Unmarshal([]byte(body), &webhookInfo); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return strconv.Itoa(webhookInfo.ID), nil
+}
+
+// DeleteWebhook deletes the webhook from the repository.
+//
+// Docs: https://docs.gitea.com/api/1.22/#tag/repository/operation/repoDeleteHook
+func (p *Provider) DeleteWebhook(ctx context.Context, repositoryID, webhookID string) error {
+	url := fmt.Sprintf("%s/repos/%s/hooks/%s", p.APIURL(p.instanceURL), repositoryID, webhookID)
+	code, body, err := internal.Delete(ctx, url, p.getAuthorization())
+	if err != nil {
+		return errors.Wrapf(err, "DELETE %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil // It is OK if the webhook has already gone
+	} else if code >= 300 {
+		return errors.Errorf("failed to delete webhook through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+func (p *Provider) getAuthorization() string {
+	return fmt.Sprintf("token %s", p.authToken)
+}
+
+// escapePath escapes each segment of the slash separated path.
+func escapePath(path string) string {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,42 @@
+package gitea
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalWebhookEvents(t *testing.T) {
+	const pullRequestPayload = `{"action":"closed","number":3,"pull_request":{"id":12,"url":"https://gitea.example.com/octocat/hello/pulls/3","number":3,"user":{"id":1,"login":"octocat","email":"octocat@example.com"},"title":"Create table t","body":"Create the table t.","state":"closed","html_url":"https://gitea.example.com/octocat/hello/pulls/3","mergeable":true,"merged":true,"merged_at":"2024-01-01T10:00:00Z","merge_commit_sha":"1f3a5c7e9b0d2f4a6c9c2e4a6b8d0f1a3c5e7b9d","base":{"label":"main","ref":"main","sha":"4b1f6a0e2c1d3f5a7b9c0d2e4f6a8b0c1d3e5f7a","repo_id":1},"head":{"label":"feature","ref":"feature","sha":"9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c","repo_id":1}},"repository":{"id":1,"full_name":"octocat/hello"},"sender":{"id":1,"login":"octocat","email":"octocat@example.com"}}`
+	var pullRequestEvent PullRequestEvent
+	require.NoError(t, json.Unmarshal([]byte(pullRequestPayload), &pullRequestEvent))
+	require.Equal(t, PullRequestEvent{
+		Action: "closed",
+		Number: 3,
+		PullRequest: EventPullRequest{
+			HTMLURL: "https://gitea.example.com/octocat/hello/pulls/3",
+			Title:   "Create table t",
+			Body:    "Create the table t.",
+			Base:    EventBranch{Ref: "main", SHA: "4b1f6a0e2c1d3f5a7b9c0d2e4f6a8b0c1d3e5f7a"},
+			Head:    EventBranch{Ref: "feature", SHA: "9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c"},
+			Merged:  true,
+		},
+		Sender: EventUser{Email: "octocat@example.com"},
+	}, pullRequestEvent)
+
+	const pushPayload = `{"ref":"refs/tags/v1.0.0","before":"0000000000000000000000000000000000000000","after":"9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c","compare_url":"","commits":[],"total_commits":0,"head_commit":{"id":"9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c","message":"Add migration\n","url":"https://gitea.example.com/octocat/hello/commit/9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c","timestamp":"2024-01-01T10:00:00Z"},"repository":{"id":1,"full_name":"octocat/hello"},"pusher":{"id":1,"login":"octocat","email":"octocat@example.com"},"sender":{"id":1,"login":"octocat"}}`
+	var pushEvent PushEvent
+	require.NoError(t, json.Unmarshal([]byte(pushPayload), &pushEvent))
+	require.Equal(t, PushEvent{
+		Ref:    "refs/tags/v1.0.0",
+		Before: "0000000000000000000000000000000000000000",
+		After:  "9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c",
+		HeadCommit: EventCommit{
+			ID:      "9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c",
+			Message: "Add migration\n",
+			URL:     "https://gitea.example.com/octocat/hello/commit/9c2e4a6b8d0f1a3c5e7b9d1f3a5c7e9b0d2f4a6c",
+		},
+		Pusher: EventUser{Email: "octocat@example.com"},
+	}, pushEvent)
+}
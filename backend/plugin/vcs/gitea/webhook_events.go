@@ -0,0 +1,48 @@
+package gitea
+
+// PullRequestEvent is the json message for pull request event.
+type PullRequestEvent struct {
+	// opened, edited, closed, reopened, synchronized.
+	// PR merge will send webhook event with "closed" action, so we need to check the "merged" field.
+	Action      string           `json:"action"`
+	Number      int              `json:"number"`
+	PullRequest EventPullRequest `json:"pull_request"`
+	Sender      EventUser        `json:"sender"`
+}
+
+type EventPullRequest struct {
+	HTMLURL string      `json:"html_url"`
+	Title   string      `json:"title"`
+	Body    string      `json:"body"`
+	Base    EventBranch `json:"base"`
+	Head    EventBranch `json:"head"`
+	Merged  bool        `json:"merged"`
+}
+
+type EventBranch struct {
+	// The branch name, e.g. main.
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+type EventUser struct {
+	Email string `json:"email"`
+}
+
+// PushEvent is the json message for push event, including the pushes of branches and tags.
+type PushEvent struct {
+	// The full git ref, e.g. refs/heads/main, refs/tags/v1.0.0.
+	Ref string `json:"ref"`
+	// The commits before and after the push. The before commit is all zeros for a new ref.
+	Before     string      `json:"before"`
+	After      string      `json:"after"`
+	CompareURL string      `json:"compare_url"`
+	HeadCommit EventCommit `json:"head_commit"`
+	Pusher     EventUser   `json:"pusher"`
+}
+
+type EventCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	URL     string `json:"url"`
+}
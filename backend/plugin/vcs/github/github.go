@@ -3,12 +3,14 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -238,6 +240,97 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	return body, nil
 }
 
+// Tree is the API message for GitHub git tree.
+type Tree struct {
+	Tree []TreeEntry `json:"tree"`
+	// Truncated is true if the number of entries exceeds the limit of the recursive tree.
+	Truncated bool `json:"truncated"`
+}
+
+// TreeEntry is the API message for the entry of GitHub git tree.
+type TreeEntry struct {
+	Path string `json:"path"`
+	// Type is "blob" for files and "tree" for directories.
+	Type string `json:"type"`
+}
+
+// ListFiles lists the paths of all the files under the directory recursively at the ref.
+//
+// Docs: https://docs.github.com/en/rest/git/trees#get-a-tree
+func (p *Provider) ListFiles(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	url := fmt.Sprintf("%s/repos/%s/git/trees/%s?recursive=1", p.APIURL(p.instanceURL), repositoryID, url.PathEscape(refInfo.RefName))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list files from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list files from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	tree := new(Tree)
+	if err := json.Unmarshal([]byte(body), tree); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	if tree.Truncated {
+		return nil, errors.Errorf("the tree of %s at %q is too large to list", repositoryID, refInfo.RefName)
+	}
+
+	var paths []string
+	for _, entry := range tree.Tree {
+		if entry.Type == "blob" && vcs.IsUnderDirectory(entry.Path, directory) {
+			paths = append(paths, entry.Path)
+		}
+	}
+	return paths, nil
+}
+
+// Comparison is the API message for the comparison of two GitHub commits.
+type Comparison struct {
+	Files []PullRequestFile `json:"files"`
+}
+
+// CompareCommits lists the files changed from the base commit to the head commit.
+//
+// Docs: https://docs.github.com/en/rest/commits/commits#compare-two-commits
+func (p *Provider) CompareCommits(ctx context.Context, repositoryID, baseCommitID, headCommitID string) ([]*vcs.PullRequestFile, error) {
+	url := fmt.Sprintf("%s/repos/%s/compare/%s...%s", p.APIURL(p.instanceURL), repositoryID, baseCommitID, headCommitID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to compare commits from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to compare commits from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	comparison := new(Comparison)
+	if err := json.Unmarshal([]byte(body), comparison); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	var res []*vcs.PullRequestFile
+	for _, file := range comparison.Files {
+		res = append(res, &vcs.PullRequestFile{
+			Path:         file.FileName,
+			LastCommitID: headCommitID,
+			IsDeleted:    file.Status == "removed",
+		})
+	}
+	return res, nil
+}
+
 // PullRequestFile is the API message for files in GitHub pull request.
 type PullRequestFile struct {
 	FileName string `json:"filename"`
@@ -366,6 +459,160 @@ func (p *Provider) CreatePullRequestComment(ctx context.Context, repositoryID, p
 	return nil
 }
 
+// IssueComment is the API message for the GitHub issue comment.
+type IssueComment struct {
+	ID   int64  `json:"id"`
+	Body string `json:"body"`
+}
+
+// ListPullRequestComments lists the comments of the pull request.
+//
+// Docs: https://docs.github.com/en/rest/issues/comments?apiVersion=2022-11-28#list-issue-comments
+func (p *Provider) ListPullRequestComments(ctx context.Context, repositoryID, pullRequestID string) ([]*vcs.PullRequestComment, error) {
+	var comments []*vcs.PullRequestComment
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("%s/repos/%s/issues/%s/comments?per_page=%d&page=%d", p.APIURL(p.instanceURL), repositoryID, pullRequestID, apiPageSize, page)
+		code, body, err := internal.Get(ctx, url, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to list pull request comments through URL %s", url)
+		}
+		if code >= 300 {
+			return nil, errors.Errorf("failed to list pull request comments through URL %s, status code: %d, body: %s", url, code, body)
+		}
+		var issueComments []*IssueComment
+		if err := json.Unmarshal([]byte(body), &issueComments); err != nil {
+			return nil, errors.Wrap(err, "unmarshal body")
+		}
+		for _, c := range issueComments {
+			comments = append(comments, &vcs.PullRequestComment{ID: strconv.FormatInt(c.ID, 10), Body: c.Body})
+		}
+		if len(issueComments) < apiPageSize {
+			return comments, nil
+		}
+	}
+}
+
+// UpdatePullRequestComment updates the comment on the pull request.
+//
+// Docs: https://docs.github.com/en/rest/issues/comments?apiVersion=2022-11-28#update-an-issue-comment
+func (p *Provider) UpdatePullRequestComment(ctx context.Context, repositoryID, _, commentID, comment string) error {
+	payload, err := json.Marshal(Comment{Body: comment})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for updating pull request comment")
+	}
+	url := fmt.Sprintf("%s/repos/%s/issues/comments/%s", p.APIURL(p.instanceURL), repositoryID, commentID)
+	code, body, err := internal.Patch(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "PATCH %s", url)
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to update pull request comment through URL %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to update pull request comment through URL %s, status code: %d, body: %s", url, code, body)
+	}
+	return nil
+}
+
+// ReviewComment is the API message for GitHub pull request review comment.
+type ReviewComment struct {
+	Path string `json:"path"`
+	Line int    `json:"line"`
+	// Side is the side of the diff, RIGHT for the new version.
+	Side string `json:"side"`
+	Body string `json:"body"`
+}
+
+// ReviewCreate is the API message for creating GitHub pull request review.
+type ReviewCreate struct {
+	CommitID string `json:"commit_id"`
+	// Event is the review action, COMMENT for the review without approval or change request.
+	Event    string           `json:"event"`
+	Body     string           `json:"body,omitempty"`
+	Comments []*ReviewComment `json:"comments"`
+}
+
+// CreatePullRequestReviewComments creates a pull request review with the comments on the lines.
+//
+// Docs: https://docs.github.com/en/rest/pulls/reviews#create-a-review-for-a-pull-request
+func (p *Provider) CreatePullRequestReviewComments(ctx context.Context, repositoryID, pullRequestID, commitID string, comments []*vcs.PullRequestReviewComment) error {
+	review := ReviewCreate{
+		CommitID: commitID,
+		Event:    "COMMENT",
+	}
+	for _, comment := range comments {
+		review.Comments = append(review.Comments, &ReviewComment{
+			Path: comment.Path,
+			Line: comment.Line,
+			Side: "RIGHT",
+			Body: comment.Body,
+		})
+	}
+	reviewCreatePayload, err := json.Marshal(review)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating pull request review")
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s/reviews", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), reviewCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request review through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create pull request review through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitStatus is the API message for GitHub commit status.
+type CommitStatus struct {
+	// State is one of "error", "failure", "pending" and "success".
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+	Context     string `json:"context"`
+}
+
+// SetCommitStatus creates a commit status, which overwrites the previous status with the same context.
+//
+// Docs: https://docs.github.com/en/rest/commits/statuses#create-a-commit-status
+func (p *Provider) SetCommitStatus(ctx context.Context, repositoryID, commitID string, status *vcs.CommitStatus) error {
+	statusCreatePayload, err := json.Marshal(CommitStatus{
+		State:       string(status.State),
+		TargetURL:   status.TargetURL,
+		Description: status.Description,
+		Context:     status.Context,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/repos/%s/statuses/%s", p.APIURL(p.instanceURL), repositoryID, commitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), statusCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
 // Branch is the API message for GitHub branch.
 type Branch struct {
 	Ref    string          `json:"ref"`
@@ -418,6 +665,143 @@ type PullRequest struct {
 	HTMLURL string `json:"html_url"`
 }
 
+// File is the API message for GitHub file content.
+type File struct {
+	SHA string `json:"sha"`
+}
+
+// CommitFile creates or updates the file with the content on the branch.
+//
+// Docs: https://docs.github.com/en/rest/repos/contents#create-or-update-file-contents
+func (p *Provider) CommitFile(ctx context.Context, repositoryID, branch, filePath, content, message string) error {
+	url := fmt.Sprintf("%s/repos/%s/contents/%s", p.APIURL(p.instanceURL), repositoryID, url.QueryEscape(filePath))
+
+	// The blob SHA of the existing file is required to update it.
+	var sha string
+	code, body, err := internal.Get(ctx, fmt.Sprintf("%s?ref=%s", url, branch), p.getAuthorization())
+	if err != nil {
+		return errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusOK {
+		file := new(File)
+		if err := json.Unmarshal([]byte(body), file); err != nil {
+			return errors.Wrap(err, "unmarshal body")
+		}
+		sha = file.SHA
+	} else if code != http.StatusNotFound {
+		return errors.Errorf("failed to get file from URL %s, status code: %d, body: %s", url, code, body)
+	}
+
+	payload, err := json.Marshal(FileCommit{
+		Message: message,
+		Content: base64.StdEncoding.EncodeToString([]byte(content)),
+		SHA:     sha,
+		Branch:  branch,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for committing file")
+	}
+	code, body, err = internal.Put(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "PUT %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to commit file through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to commit file through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// ReferenceCreate is the API message for creating a GitHub reference.
+type ReferenceCreate struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+// CreateBranch creates the branch from the commit.
+//
+// Docs: https://docs.github.com/en/rest/git/refs#create-a-reference
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	payload, err := json.Marshal(ReferenceCreate{
+		Ref: fmt.Sprintf("refs/heads/%s", branchName),
+		SHA: commitID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	url := fmt.Sprintf("%s/repos/%s/git/refs", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// PullRequestCreate is the API message for creating a GitHub pull request.
+type PullRequestCreate struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+}
+
+// CreatePullRequest creates the pull request and returns its URL.
+//
+// Docs: https://docs.github.com/en/rest/pulls/pulls#create-a-pull-request
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (string, error) {
+	payload, err := json.Marshal(PullRequestCreate{
+		Title: create.Title,
+		Body:  create.Body,
+		Head:  create.Head,
+		Base:  create.Base,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating pull request")
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create pull request through URL %s", url)
+	}
+	// GitHub returns 422 if a pull request already exists for the head and base branches.
+	if code == http.StatusUnprocessableEntity && strings.Contains(body, "already exists") {
+		return "", common.Errorf(common.Conflict, "pull request from %q to %q already exists", create.Head, create.Base)
+	}
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create pull request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	pullRequest := new(PullRequest)
+	if err := json.Unmarshal([]byte(body), pullRequest); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return pullRequest.HTMLURL, nil
+}
+
 // CreateWebhook creates a webhook in the repository with given payload.
 //
 // Docs: https://docs.github.com/en/rest/webhooks/repos#create-a-repository-webhook
@@ -23,3 +23,28 @@ type EventBranch struct {
 	Ref string `json:"ref"`
 	SHA string `json:"sha"`
 }
+
+// PushEvent is the json message for push event, including the pushes of branches and tags.
+type PushEvent struct {
+	// The full git ref, e.g. refs/heads/main, refs/tags/v1.0.0.
+	Ref string `json:"ref"`
+	// The commits before and after the push. The before commit is all zeros for a new ref.
+	Before string `json:"before"`
+	After  string `json:"after"`
+	// Deleted is true if the push deletes the ref.
+	Deleted    bool        `json:"deleted"`
+	Compare    string      `json:"compare"`
+	HeadCommit EventCommit `json:"head_commit"`
+	Pusher     EventPusher `json:"pusher"`
+}
+
+type EventCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	URL     string `json:"url"`
+}
+
+type EventPusher struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
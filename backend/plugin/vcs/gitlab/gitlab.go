@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -40,6 +41,8 @@ type WebhookType string
 const (
 	// WebhookPush is the webhook type for push.
 	WebhookPush WebhookType = "push"
+	// WebhookTagPush is the webhook type for tag push.
+	WebhookTagPush WebhookType = "tag_push"
 )
 
 // WebhookInfo represents a GitLab API response for the webhook information.
@@ -53,6 +56,7 @@ type WebhookCreate struct {
 	SecretToken string `json:"token"`
 	// This is set to true
 	PushEvents          bool `json:"push_events"`
+	TagPushEvents       bool `json:"tag_push_events"`
 	NoteEvents          bool `json:"note_events"`
 	MergeRequestsEvents bool `json:"merge_requests_events"`
 	// For now, there is no native dry run DDL support in mysql/postgres. One may wonder if we could wrap the DDL
@@ -96,6 +100,7 @@ type WebhookPushEvent struct {
 	Before     string          `json:"before"`
 	After      string          `json:"after"`
 	AuthorName string          `json:"user_name"`
+	UserEmail  string          `json:"user_email"`
 	Project    WebhookProject  `json:"project"`
 	CommitList []WebhookCommit `json:"commits"`
 }
@@ -238,6 +243,100 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	return file.Content, nil
 }
 
+// TreeEntry is the API message for the entry of GitLab repository tree.
+type TreeEntry struct {
+	Path string `json:"path"`
+	// Type is "blob" for files and "tree" for directories.
+	Type string `json:"type"`
+}
+
+// ListFiles lists the paths of all the files under the directory recursively at the ref.
+//
+// Docs: https://docs.gitlab.com/ee/api/repositories.html#list-repository-tree
+func (p *Provider) ListFiles(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	var paths []string
+	for page := 1; ; page++ {
+		values := &url.Values{}
+		values.Set("path", strings.Trim(directory, "/"))
+		values.Set("ref", refInfo.RefName)
+		values.Set("recursive", "true")
+		values.Set("per_page", strconv.Itoa(apiPageSize))
+		values.Set("page", strconv.Itoa(page))
+		url := fmt.Sprintf("%s/projects/%s/repository/tree?%s", p.APIURL(p.instanceURL), repositoryID, values.Encode())
+		code, body, err := internal.Get(ctx, url, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to list files from URL %s", url)
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to list files from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+		}
+
+		var entries []TreeEntry
+		if err := json.Unmarshal([]byte(body), &entries); err != nil {
+			return nil, errors.Wrap(err, "unmarshal")
+		}
+		for _, entry := range entries {
+			if entry.Type == "blob" {
+				paths = append(paths, entry.Path)
+			}
+		}
+		if len(entries) < apiPageSize {
+			break
+		}
+	}
+	return paths, nil
+}
+
+// Comparison is the API message for the comparison of two GitLab commits.
+type Comparison struct {
+	Diffs []MergeRequestFile `json:"diffs"`
+}
+
+// CompareCommits lists the files changed from the base commit to the head commit.
+//
+// Docs: https://docs.gitlab.com/ee/api/repositories.html#compare-branches-tags-or-commits
+func (p *Provider) CompareCommits(ctx context.Context, repositoryID, baseCommitID, headCommitID string) ([]*vcs.PullRequestFile, error) {
+	values := &url.Values{}
+	values.Set("from", baseCommitID)
+	values.Set("to", headCommitID)
+	url := fmt.Sprintf("%s/projects/%s/repository/compare?%s", p.APIURL(p.instanceURL), repositoryID, values.Encode())
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to compare commits from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to compare commits from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	comparison := new(Comparison)
+	if err := json.Unmarshal([]byte(body), comparison); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	var res []*vcs.PullRequestFile
+	for _, file := range comparison.Diffs {
+		res = append(res, &vcs.PullRequestFile{
+			Path:         file.NewPath,
+			LastCommitID: headCommitID,
+			IsDeleted:    file.DeletedFile,
+		})
+	}
+	return res, nil
+}
+
 // MergeRequestChange is the API message for GitLab merge request changes.
 type MergeRequestChange struct {
 	SHA     string             `json:"sha"`
@@ -312,6 +411,200 @@ func (p *Provider) CreatePullRequestComment(ctx context.Context, repositoryID, p
 	return nil
 }
 
+// MergeRequestNote is the API message for the GitLab merge request note.
+type MergeRequestNote struct {
+	ID     int    `json:"id"`
+	Body   string `json:"body"`
+	System bool   `json:"system"`
+}
+
+// ListPullRequestComments lists the notes of the merge request, excluding the system notes.
+//
+// Docs: https://docs.gitlab.com/ee/api/notes.html#list-all-merge-request-notes
+func (p *Provider) ListPullRequestComments(ctx context.Context, repositoryID, pullRequestID string) ([]*vcs.PullRequestComment, error) {
+	var comments []*vcs.PullRequestComment
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("%s/projects/%s/merge_requests/%s/notes?per_page=%d&page=%d", p.APIURL(p.instanceURL), repositoryID, pullRequestID, apiPageSize, page)
+		code, body, err := internal.Get(ctx, url, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to list pull request comments through URL %s", url)
+		}
+		if code >= 300 {
+			return nil, errors.Errorf("failed to list pull request comments through URL %s, status code: %d, body: %s", url, code, body)
+		}
+		var notes []*MergeRequestNote
+		if err := json.Unmarshal([]byte(body), &notes); err != nil {
+			return nil, errors.Wrap(err, "unmarshal body")
+		}
+		for _, note := range notes {
+			if note.System {
+				continue
+			}
+			comments = append(comments, &vcs.PullRequestComment{ID: strconv.Itoa(note.ID), Body: note.Body})
+		}
+		if len(notes) < apiPageSize {
+			return comments, nil
+		}
+	}
+}
+
+// UpdatePullRequestComment updates the note of the merge request.
+//
+// Docs: https://docs.gitlab.com/ee/api/notes.html#modify-existing-merge-request-note
+func (p *Provider) UpdatePullRequestComment(ctx context.Context, repositoryID, pullRequestID, commentID, comment string) error {
+	url := fmt.Sprintf("%s/projects/%s/merge_requests/%s/notes/%s?body=%s", p.APIURL(p.instanceURL), repositoryID, pullRequestID, commentID, url.QueryEscape(comment))
+	code, body, err := internal.Put(ctx, url, p.getAuthorization(), nil)
+	if err != nil {
+		return errors.Wrapf(err, "PUT %s", url)
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to update pull request comment through URL %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to update pull request comment through URL %s, status code: %d, body: %s", url, code, body)
+	}
+	return nil
+}
+
+// MergeRequestDiffRefs is the API message for the diff refs of GitLab merge request.
+type MergeRequestDiffRefs struct {
+	BaseSHA  string `json:"base_sha"`
+	HeadSHA  string `json:"head_sha"`
+	StartSHA string `json:"start_sha"`
+}
+
+// MergeRequest is the API message for GitLab merge request.
+type MergeRequest struct {
+	WebURL   string               `json:"web_url"`
+	DiffRefs MergeRequestDiffRefs `json:"diff_refs"`
+}
+
+// DiscussionPosition is the API message for the position of GitLab merge request discussion.
+type DiscussionPosition struct {
+	PositionType string `json:"position_type"`
+	BaseSHA      string `json:"base_sha"`
+	HeadSHA      string `json:"head_sha"`
+	StartSHA     string `json:"start_sha"`
+	NewPath      string `json:"new_path"`
+	NewLine      int    `json:"new_line"`
+}
+
+// DiscussionCreate is the API message for creating GitLab merge request discussion.
+type DiscussionCreate struct {
+	Body     string              `json:"body"`
+	Position *DiscussionPosition `json:"position,omitempty"`
+}
+
+// getMergeRequest gets the merge request.
+//
+// Docs: https://docs.gitlab.com/ee/api/merge_requests.html#get-single-mr
+func (p *Provider) getMergeRequest(ctx context.Context, repositoryID, pullRequestID string) (*MergeRequest, error) {
+	url := fmt.Sprintf("%s/projects/%s/merge_requests/%s", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get merge request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get merge request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	mr := new(MergeRequest)
+	if err := json.Unmarshal([]byte(body), mr); err != nil {
+		return nil, err
+	}
+	return mr, nil
+}
+
+// CreatePullRequestReviewComments creates a merge request discussion on the line for each comment.
+//
+// Docs: https://docs.gitlab.com/ee/api/discussions.html#create-a-new-thread-in-the-merge-request-diff
+func (p *Provider) CreatePullRequestReviewComments(ctx context.Context, repositoryID, pullRequestID, commitID string, comments []*vcs.PullRequestReviewComment) error {
+	mr, err := p.getMergeRequest(ctx, repositoryID, pullRequestID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get merge request")
+	}
+	headSHA := mr.DiffRefs.HeadSHA
+	if commitID != "" {
+		headSHA = commitID
+	}
+
+	url := fmt.Sprintf("%s/projects/%s/merge_requests/%s/discussions", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	for _, comment := range comments {
+		discussionCreatePayload, err := json.Marshal(DiscussionCreate{
+			Body: comment.Body,
+			Position: &DiscussionPosition{
+				PositionType: "text",
+				BaseSHA:      mr.DiffRefs.BaseSHA,
+				HeadSHA:      headSHA,
+				StartSHA:     mr.DiffRefs.StartSHA,
+				NewPath:      comment.Path,
+				NewLine:      comment.Line,
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request body for creating merge request discussion")
+		}
+		code, body, err := internal.Post(ctx, url, p.getAuthorization(), discussionCreatePayload)
+		if err != nil {
+			return errors.Wrapf(err, "POST %s", url)
+		}
+
+		if code == http.StatusNotFound {
+			return common.Errorf(common.NotFound, "failed to create merge request discussion through URL %s", url)
+		} else if code >= 300 {
+			return errors.Errorf("failed to create merge request discussion through URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+		}
+	}
+	return nil
+}
+
+// SetCommitStatus sets the status of the commit.
+//
+// Docs: https://docs.gitlab.com/ee/api/commits.html#set-the-pipeline-status-of-a-commit
+func (p *Provider) SetCommitStatus(ctx context.Context, repositoryID, commitID string, status *vcs.CommitStatus) error {
+	// GitLab uses "failed" instead of "failure".
+	state := string(status.State)
+	if status.State == vcs.CommitStateFailure {
+		state = "failed"
+	}
+	values := &url.Values{}
+	values.Set("state", state)
+	values.Set("name", status.Context)
+	values.Set("description", status.Description)
+	if status.TargetURL != "" {
+		values.Set("target_url", status.TargetURL)
+	}
+	url := fmt.Sprintf("%s/projects/%s/statuses/%s?%s", p.APIURL(p.instanceURL), repositoryID, commitID, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), nil)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to set commit status through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to set commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
 // Branch is the API message for GitLab branch.
 type Branch struct {
 	Name   string `json:"name"`
@@ -364,9 +657,112 @@ func (p *Provider) GetBranch(ctx context.Context, repositoryID, branchName strin
 	}, nil
 }
 
-// MergeRequest is the API message for GitLab merge request.
-type MergeRequest struct {
-	WebURL string `json:"web_url"`
+// CommitFile creates or updates the file with the content on the branch.
+//
+// Docs: https://docs.gitlab.com/ee/api/repository_files.html#create-new-file-in-repository
+// and https://docs.gitlab.com/ee/api/repository_files.html#update-existing-file-in-repository
+func (p *Provider) CommitFile(ctx context.Context, repositoryID, branch, filePath, content, message string) error {
+	commit := internal.Post
+	if _, err := p.readFile(ctx, repositoryID, filePath, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: branch}); err == nil {
+		commit = internal.Put
+	} else if common.ErrorCode(err) != common.NotFound {
+		return err
+	}
+
+	payload, err := json.Marshal(FileCommit{
+		Branch:        branch,
+		Content:       content,
+		CommitMessage: message,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for committing file")
+	}
+	url := fmt.Sprintf("%s/projects/%s/repository/files/%s", p.APIURL(p.instanceURL), repositoryID, url.QueryEscape(filePath))
+	code, body, err := commit(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "commit file %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to commit file through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to commit file through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CreateBranch creates the branch from the commit.
+//
+// Docs: https://docs.gitlab.com/ee/api/branches.html#create-repository-branch
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error {
+	payload, err := json.Marshal(BranchCreate{
+		Branch: branchName,
+		Ref:    commitID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating branch")
+	}
+	url := fmt.Sprintf("%s/projects/%s/repository/branches", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create branch through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CreatePullRequest creates the merge request and returns its URL.
+//
+// Docs: https://docs.gitlab.com/ee/api/merge_requests.html#create-mr
+func (p *Provider) CreatePullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (string, error) {
+	payload, err := json.Marshal(MergeRequestCreate{
+		Title:        create.Title,
+		Description:  create.Body,
+		SourceBranch: create.Head,
+		TargetBranch: create.Base,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request body for creating merge request")
+	}
+	url := fmt.Sprintf("%s/projects/%s/merge_requests", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create merge request through URL %s", url)
+	}
+	// GitLab returns 409 if a merge request already exists for the source and target branches.
+	if code == http.StatusConflict {
+		return "", common.Errorf(common.Conflict, "merge request from %q to %q already exists", create.Head, create.Base)
+	}
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create merge request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	mergeRequest := new(MergeRequest)
+	if err := json.Unmarshal([]byte(body), mergeRequest); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return mergeRequest.WebURL, nil
 }
 
 // CreateWebhook creates a webhook in the repository with given payload.
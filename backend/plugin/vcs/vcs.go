@@ -3,8 +3,11 @@ package vcs
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
+	"github.com/pkg/errors"
+
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
@@ -68,6 +71,61 @@ type BranchInfo struct {
 	LastCommitID string
 }
 
+// PullRequestReviewComment is the API message for the review comment on a line of the file in the pull request.
+type PullRequestReviewComment struct {
+	Path string
+	// Line is the 1-based line number in the file of the head commit.
+	Line int
+	Body string
+}
+
+// CommitState is the state of the commit status.
+type CommitState string
+
+const (
+	// CommitStatePending is the pending state of the commit status.
+	CommitStatePending CommitState = "pending"
+	// CommitStateSuccess is the success state of the commit status.
+	CommitStateSuccess CommitState = "success"
+	// CommitStateFailure is the failure state of the commit status.
+	CommitStateFailure CommitState = "failure"
+)
+
+// PlanCheckCommitStatusContext is the context of the commit status for the plan check results.
+const PlanCheckCommitStatusContext = "bytebase/plan-check"
+
+// RolloutCommitStatusContext is the context of the commit status for the approval and the rollout of the issue.
+const RolloutCommitStatusContext = "bytebase/rollout"
+
+// MigrationVersionCommitStatusContext is the context of the commit status for the version check of the migration files.
+const MigrationVersionCommitStatusContext = "bytebase/migration-version"
+
+// PullRequestComment is the comment on the pull request.
+type PullRequestComment struct {
+	// ID is the identifier of the comment used to update it.
+	ID   string
+	Body string
+}
+
+// CommitStatus is the API message for the status of the commit.
+type CommitStatus struct {
+	State CommitState
+	// Context is the identifier of the status, the status with the same context is overwritten.
+	Context     string
+	Description string
+	TargetURL   string
+}
+
+// PullRequestCreate is the API message for creating a pull request.
+type PullRequestCreate struct {
+	Title string
+	Body  string
+	// Head is the branch with the changes.
+	Head string
+	// Base is the branch the changes are merged into.
+	Base string
+}
+
 // Provider is the interface for VCS provider.
 type Provider interface {
 	// Returns the API URL for a given VCS instance URL
@@ -79,15 +137,44 @@ type Provider interface {
 	// Reads the file content
 	ReadFileContent(ctx context.Context, repositoryID, filePath string, refInfo RefInfo) (string, error)
 
+	// ListFiles lists the paths of all the files under the directory recursively at the ref.
+	// The paths are relative to the repository root without the leading "/".
+	ListFiles(ctx context.Context, repositoryID, directory string, refInfo RefInfo) ([]string, error)
+
 	// GetBranch gets the given branch in the repository.
 	GetBranch(ctx context.Context, repositoryID, branchName string) (*BranchInfo, error)
 
 	// CreatePullRequest creates the pull request in the repository.
 	ListPullRequestFile(ctx context.Context, repositoryID, pullRequestID string) ([]*PullRequestFile, error)
 
+	// CompareCommits lists the files changed from the base commit to the head commit.
+	CompareCommits(ctx context.Context, repositoryID, baseCommitID, headCommitID string) ([]*PullRequestFile, error)
+
 	// CreatePullRequestComment creates a pull request comment.
 	CreatePullRequestComment(ctx context.Context, repositoryID, pullRequestID, comment string) error
 
+	// ListPullRequestComments lists the comments of the pull request, excluding the system notes.
+	ListPullRequestComments(ctx context.Context, repositoryID, pullRequestID string) ([]*PullRequestComment, error)
+
+	// UpdatePullRequestComment updates the body of the pull request comment.
+	UpdatePullRequestComment(ctx context.Context, repositoryID, pullRequestID, commentID, comment string) error
+
+	// CreatePullRequestReviewComments creates the review comments on the lines of the files at the commit in the pull request.
+	CreatePullRequestReviewComments(ctx context.Context, repositoryID, pullRequestID, commitID string, comments []*PullRequestReviewComment) error
+
+	// SetCommitStatus sets the status of the commit.
+	SetCommitStatus(ctx context.Context, repositoryID, commitID string, status *CommitStatus) error
+
+	// CommitFile creates or updates the file with the content on the branch in a new commit.
+	CommitFile(ctx context.Context, repositoryID, branch, filePath, content, message string) error
+
+	// CreateBranch creates the branch from the commit.
+	CreateBranch(ctx context.Context, repositoryID, branchName, commitID string) error
+
+	// CreatePullRequest creates the pull request and returns its URL.
+	// It returns a common.Conflict error if the VCS reports that the pull request for the branches already exists.
+	CreatePullRequest(ctx context.Context, repositoryID string, create *PullRequestCreate) (string, error)
+
 	// Creates a webhook. Returns the created webhook ID on success.
 	CreateWebhook(ctx context.Context, repositoryID string, payload []byte) (string, error)
 
@@ -134,3 +221,44 @@ func Get(vcsType storepb.VCSType, providerConfig ProviderConfig) Provider {
 
 	return f(providerConfig)
 }
+
+// IsUnderDirectory returns true if the file path is under the directory.
+// Both of the paths may have a leading "/", and the root directory is either empty or "/".
+func IsUnderDirectory(filePath, directory string) bool {
+	directory = strings.Trim(directory, "/")
+	if directory == "" {
+		return true
+	}
+	return strings.HasPrefix(strings.TrimPrefix(filePath, "/"), directory+"/")
+}
+
+// FindPullRequestComment finds the pull request comment containing the marker, and returns nil if there is none.
+// The marker is usually an HTML comment hidden in the rendered comment.
+func FindPullRequestComment(ctx context.Context, provider Provider, repositoryID, pullRequestID, marker string) (*PullRequestComment, error) {
+	comments, err := provider.ListPullRequestComments(ctx, repositoryID, pullRequestID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list pull request comments")
+	}
+	for _, comment := range comments {
+		if strings.Contains(comment.Body, marker) {
+			return comment, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpsertPullRequestComment updates the pull request comment containing the marker, or creates the comment if there is none,
+// so that the repeated reports on the pull request do not pile up. The comment must contain the marker.
+func UpsertPullRequestComment(ctx context.Context, provider Provider, repositoryID, pullRequestID, marker, comment string) error {
+	existing, err := FindPullRequestComment(ctx, provider, repositoryID, pullRequestID, marker)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return provider.CreatePullRequestComment(ctx, repositoryID, pullRequestID, comment)
+	}
+	if existing.Body == comment {
+		return nil
+	}
+	return provider.UpdatePullRequestComment(ctx, repositoryID, pullRequestID, existing.ID, comment)
+}
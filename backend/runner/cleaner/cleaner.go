@@ -0,0 +1,130 @@
+// Package cleaner is a runner that deletes the expired data.
+package cleaner
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	cleanInterval = time.Hour
+	// ExportArchiveRetention is the retention of the export archives, they can be downloaded repeatedly until they expire.
+	ExportArchiveRetention = 24 * time.Hour
+)
+
+// NewCleaner creates a new cleaner.
+func NewCleaner(store *store.Store) *Cleaner {
+	return &Cleaner{
+		store: store,
+	}
+}
+
+// Cleaner is the cleaner of the expired data.
+type Cleaner struct {
+	store *store.Store
+}
+
+// Run will run the cleaner.
+func (c *Cleaner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(cleanInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Cleaner started and will run every %v", cleanInterval))
+	for {
+		select {
+		case <-ticker.C:
+			c.clean(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *Cleaner) clean(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(error)
+			if !ok {
+				err = errors.Errorf("%v", r)
+			}
+			slog.Error("Cleaner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+		}
+	}()
+
+	count, err := c.store.DeleteExpiredExportArchives(ctx, time.Now().Add(-ExportArchiveRetention))
+	if err != nil {
+		slog.Error("failed to delete expired export archives", log.BBError(err))
+	} else if count > 0 {
+		slog.Debug("deleted expired export archives", slog.Int64("count", count))
+	}
+	count, err = c.store.DeleteExpiredQueryResultShares(ctx)
+	if err != nil {
+		slog.Error("failed to delete expired query result shares", log.BBError(err))
+	} else if count > 0 {
+		slog.Debug("deleted expired query result shares", slog.Int64("count", count))
+	}
+	if err := c.purgeQueryHistories(ctx); err != nil {
+		slog.Error("failed to purge query histories", log.BBError(err))
+	}
+}
+
+// purgeQueryHistories deletes the query histories out of the retention of the projects, and the workspace for the projects without the policies.
+func (c *Cleaner) purgeQueryHistories(ctx context.Context) error {
+	pType := api.PolicyTypeQueryHistoryRetention
+	policies, err := c.store.ListPoliciesV2(ctx, &store.FindPolicyMessage{Type: &pType})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list query history retention policies")
+	}
+	var workspaceRetention time.Duration
+	var projectIDs []string
+	for _, policy := range policies {
+		payload := &storepb.QueryHistoryRetentionPolicy{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), payload); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal query history retention policy")
+		}
+		retention := payload.GetRetention().AsDuration()
+		switch policy.ResourceType {
+		case api.PolicyResourceTypeWorkspace:
+			workspaceRetention = retention
+		case api.PolicyResourceTypeProject:
+			project, err := c.store.GetProjectV2(ctx, &store.FindProjectMessage{UID: &policy.ResourceUID})
+			if err != nil {
+				return errors.Wrapf(err, "failed to get project %d", policy.ResourceUID)
+			}
+			if project == nil {
+				continue
+			}
+			projectIDs = append(projectIDs, project.ResourceID)
+			if retention <= 0 {
+				continue
+			}
+			if _, err := c.store.PurgeQueryHistories(ctx, &store.PurgeQueryHistoryMessage{
+				CreatedTsBefore: time.Now().Add(-retention).Unix(),
+				ProjectID:       &project.ResourceID,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to purge query histories of project %q", project.ResourceID)
+			}
+		}
+	}
+	if workspaceRetention <= 0 {
+		return nil
+	}
+	if _, err := c.store.PurgeQueryHistories(ctx, &store.PurgeQueryHistoryMessage{
+		CreatedTsBefore:    time.Now().Add(-workspaceRetention).Unix(),
+		ExcludedProjectIDs: projectIDs,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to purge query histories")
+	}
+	return nil
+}
@@ -38,6 +38,9 @@ type Scheduler struct {
 	licenseService enterprise.LicenseService
 	stateCfg       *state.State
 	executors      map[store.PlanCheckRunType]Executor
+
+	// vcsReportMu serializes the claims of the plan check results to report to VCS.
+	vcsReportMu sync.Mutex
 }
 
 // Run runs the scheduler.
@@ -129,9 +132,10 @@ func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.Pla
 		results, err := runExecutorOnce(ctx, executor, planCheckRun.Config)
 		if err != nil {
 			s.markPlanCheckRunFailed(ctx, planCheckRun, err.Error())
-			return
+		} else {
+			s.markPlanCheckRunDone(ctx, planCheckRun, results)
 		}
-		s.markPlanCheckRunDone(ctx, planCheckRun, results)
+		s.reportToVCS(ctx, planCheckRun.PlanUID)
 	}()
 }
 
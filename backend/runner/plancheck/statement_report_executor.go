@@ -117,6 +117,8 @@ func (e *StatementReportExecutor) runForDatabaseTarget(ctx context.Context, conf
 	materials := utils.GetSecretMapFromDatabaseMessage(database)
 	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 	renderedStatement := utils.RenderStatement(statement, materials)
+	// The rollback statement is derived from the original statement to avoid leaking the secrets.
+	rollbackStatement := getRollbackStatementPreview(instance.Engine, config.ChangeDatabaseType, dbSchema, statement)
 
 	switch instance.Engine {
 	case storepb.Engine_POSTGRES:
@@ -127,7 +129,8 @@ func (e *StatementReportExecutor) runForDatabaseTarget(ctx context.Context, conf
 		defer driver.Close(ctx)
 		sqlDB := driver.GetDB()
 
-		return reportForPostgres(ctx, e.sheetManager, sqlDB, database.DatabaseName, renderedStatement, dbSchema)
+		results, err := reportForPostgres(ctx, e.sheetManager, sqlDB, database.DatabaseName, renderedStatement, dbSchema)
+		return setRollbackStatementPreview(results, rollbackStatement), err
 	case storepb.Engine_MYSQL, storepb.Engine_OCEANBASE:
 		driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
 		if err != nil {
@@ -136,9 +139,11 @@ func (e *StatementReportExecutor) runForDatabaseTarget(ctx context.Context, conf
 		defer driver.Close(ctx)
 		sqlDB := driver.GetDB()
 
-		return reportForMySQL(ctx, e.sheetManager, sqlDB, instance.Engine, database.DatabaseName, renderedStatement, dbSchema)
+		results, err := reportForMySQL(ctx, e.sheetManager, sqlDB, instance.Engine, database.DatabaseName, renderedStatement, dbSchema)
+		return setRollbackStatementPreview(results, rollbackStatement), err
 	case storepb.Engine_ORACLE, storepb.Engine_DM, storepb.Engine_OCEANBASE_ORACLE:
-		return reportForOracle(e.sheetManager, database.DatabaseName, database.DatabaseName, renderedStatement, dbSchema)
+		results, err := reportForOracle(e.sheetManager, database.DatabaseName, database.DatabaseName, renderedStatement, dbSchema)
+		return setRollbackStatementPreview(results, rollbackStatement), err
 	default:
 		return []*storepb.PlanCheckRunResult_Result{
 			{
@@ -151,6 +156,30 @@ func (e *StatementReportExecutor) runForDatabaseTarget(ctx context.Context, conf
 	}
 }
 
+// getRollbackStatementPreview returns the statement to roll back the schema change of the plan check before the change is applied.
+func getRollbackStatementPreview(engine storepb.Engine, changeType storepb.PlanCheckRunConfig_ChangeDatabaseType, dbSchema *model.DBSchema, statement string) string {
+	var migrationType db.MigrationType
+	switch changeType {
+	case storepb.PlanCheckRunConfig_DDL, storepb.PlanCheckRunConfig_DDL_GHOST:
+		migrationType = db.Migrate
+	case storepb.PlanCheckRunConfig_SDL:
+		migrationType = db.MigrateSDL
+	default:
+		return ""
+	}
+	return utils.GenerateRollbackStatementPreview(engine, migrationType, string(dbSchema.GetSchema()), statement)
+}
+
+// setRollbackStatementPreview sets the rollback statement to the summary reports of the results.
+func setRollbackStatementPreview(results []*storepb.PlanCheckRunResult_Result, rollbackStatement string) []*storepb.PlanCheckRunResult_Result {
+	for _, result := range results {
+		if report := result.GetSqlSummaryReport(); report != nil {
+			report.RollbackStatement = rollbackStatement
+		}
+	}
+	return results
+}
+
 func (e *StatementReportExecutor) runForDatabaseGroupTarget(ctx context.Context, config *storepb.PlanCheckRunConfig, databaseGroupUID int64) ([]*storepb.PlanCheckRunResult_Result, error) {
 	databaseGroup, err := e.store.GetDatabaseGroup(ctx, &store.FindDatabaseGroupMessage{
 		UID: &databaseGroupUID,
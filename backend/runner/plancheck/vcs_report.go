@@ -0,0 +1,314 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// reportToVCS reports the results of the latest plan check runs of the plan to the pull request
+// if the plan is created from a pull request and all the latest plan check runs are finished.
+// The SQL review advices are posted as review comments on the lines of the files,
+// and the overall result is set as the commit status of the head commit of the pull request.
+// For the plans created from pushes, only the commit status of the pushed commit is set.
+func (s *Scheduler) reportToVCS(ctx context.Context, planUID int64) {
+	if err := s.reportToVCSImpl(ctx, planUID); err != nil {
+		slog.Error("failed to report plan check results to VCS", slog.Int64("plan_uid", planUID), log.BBError(err))
+	}
+}
+
+func (s *Scheduler) reportToVCSImpl(ctx context.Context, planUID int64) error {
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{UID: &planUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get plan")
+	}
+	if plan == nil {
+		return nil
+	}
+	vcsSource := plan.Config.GetVcsSource()
+	if vcsSource.GetVcsConnector() == "" || vcsSource.GetCommitId() == "" {
+		return nil
+	}
+
+	latestRuns, err := s.claimVCSReport(ctx, planUID)
+	if err != nil {
+		return err
+	}
+	if len(latestRuns) == 0 {
+		return nil
+	}
+
+	projectID, vcsConnectorID, err := common.GetProjectVCSConnectorID(vcsSource.GetVcsConnector())
+	if err != nil {
+		return err
+	}
+	vcsConnector, err := s.store.GetVCSConnector(ctx, &store.FindVCSConnectorMessage{ProjectID: &projectID, ResourceID: &vcsConnectorID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get VCS connector %q", vcsSource.GetVcsConnector())
+	}
+	if vcsConnector == nil {
+		return nil
+	}
+	vcsProvider, err := s.store.GetVCSProvider(ctx, &store.FindVCSProviderMessage{ResourceID: &vcsConnector.VCSResourceID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get VCS provider %q", vcsConnector.VCSResourceID)
+	}
+	if vcsProvider == nil {
+		return nil
+	}
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get workspace general setting")
+	}
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PlanUID: &planUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue")
+	}
+	targetURL := ""
+	if setting.ExternalUrl != "" {
+		// The plans created to check the open pull requests have no issues.
+		targetURL = fmt.Sprintf("%s/%s", setting.ExternalUrl, fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, plan.ProjectID, common.PlanPrefix, plan.UID))
+		if issue != nil {
+			targetURL = fmt.Sprintf("%s/%s", setting.ExternalUrl, common.FormatIssue(issue.Project.ResourceID, issue.UID))
+		}
+	}
+
+	report, err := s.getVCSReport(ctx, latestRuns)
+	if err != nil {
+		return err
+	}
+	if issue != nil {
+		report.riskLevel = issue.Payload.GetApproval().GetRiskLevel()
+	}
+
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	repositoryID := vcsConnector.Payload.ExternalId
+	if vcsSource.GetPullRequestUrl() != "" {
+		pullRequestID := getPullRequestID(vcsSource.GetPullRequestUrl())
+		if len(report.comments) > 0 {
+			if err := provider.CreatePullRequestReviewComments(ctx, repositoryID, pullRequestID, vcsSource.GetCommitId(), report.comments); err != nil {
+				// The lines may be outside of the diff, so we still post the summary and the commit status.
+				slog.Warn("failed to create pull request review comments", slog.Int64("plan_uid", planUID), log.BBError(err))
+			}
+		}
+		if err := provider.CreatePullRequestComment(ctx, repositoryID, pullRequestID, report.summary(targetURL)); err != nil {
+			return errors.Wrapf(err, "failed to create pull request comment")
+		}
+	}
+	if err := provider.SetCommitStatus(ctx, repositoryID, vcsSource.GetCommitId(), &vcs.CommitStatus{
+		State:       report.state(),
+		Context:     vcs.PlanCheckCommitStatusContext,
+		Description: report.description(),
+		TargetURL:   targetURL,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to set commit status")
+	}
+	return nil
+}
+
+// claimVCSReport returns the latest plan check runs of the plan to report if they are all finished and not reported yet.
+// The latest plan check run is marked as reported, so that the results of the same plan check runs are reported only once.
+func (s *Scheduler) claimVCSReport(ctx context.Context, planUID int64) ([]*store.PlanCheckRunMessage, error) {
+	// The lock only serializes the claims of the plan check runs finished at the same time, the reports are sent without it.
+	s.vcsReportMu.Lock()
+	defer s.vcsReportMu.Unlock()
+
+	planCheckRuns, err := s.store.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{PlanUID: &planUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list plan check runs")
+	}
+	latestRuns := getLatestPlanCheckRuns(planCheckRuns)
+	if len(latestRuns) == 0 {
+		return nil, nil
+	}
+	for _, run := range latestRuns {
+		if run.Status == store.PlanCheckRunStatusRunning {
+			return nil, nil
+		}
+	}
+	// The latest runs are sorted by UID.
+	lastRun := latestRuns[len(latestRuns)-1]
+	if lastRun.Result.GetVcsReported() {
+		return nil, nil
+	}
+	result, ok := proto.Clone(lastRun.Result).(*storepb.PlanCheckRunResult)
+	if !ok || result == nil {
+		result = &storepb.PlanCheckRunResult{}
+	}
+	result.VcsReported = true
+	if err := s.store.UpdatePlanCheckRun(ctx, api.SystemBotID, lastRun.Status, result, lastRun.UID); err != nil {
+		return nil, errors.Wrapf(err, "failed to mark plan check run %d as reported", lastRun.UID)
+	}
+	return latestRuns, nil
+}
+
+// vcsReport is the report of the plan check results for the pull request.
+type vcsReport struct {
+	comments  []*vcs.PullRequestReviewComment
+	rows      []string
+	errors    int
+	warnings  int
+	riskLevel storepb.IssuePayloadApproval_RiskLevel
+}
+
+func (s *Scheduler) getVCSReport(ctx context.Context, runs []*store.PlanCheckRunMessage) (*vcsReport, error) {
+	report := &vcsReport{}
+	sheetPaths := map[int32]string{}
+	seenComments := map[string]bool{}
+	for _, run := range runs {
+		path, ok := sheetPaths[run.Config.SheetUid]
+		if !ok {
+			sheetUID := int(run.Config.SheetUid)
+			sheet, err := s.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+			}
+			// The sheets created from the pull request are named after the file paths.
+			if sheet != nil {
+				path = strings.TrimPrefix(sheet.Title, "/")
+			}
+			sheetPaths[run.Config.SheetUid] = path
+		}
+
+		if run.Status == store.PlanCheckRunStatusFailed {
+			report.errors++
+			report.rows = append(report.rows, fmt.Sprintf("| %s | %s | %s | ❌ %s |", path, run.Config.DatabaseName, run.Type, escapeTableCell(run.Result.GetError())))
+			continue
+		}
+		for _, result := range run.Result.GetResults() {
+			switch result.Status {
+			case storepb.PlanCheckRunResult_Result_ERROR:
+				report.errors++
+			case storepb.PlanCheckRunResult_Result_WARNING:
+				report.warnings++
+			}
+
+			if summary := result.GetSqlSummaryReport(); summary != nil {
+				report.rows = append(report.rows, fmt.Sprintf("| %s | %s | %s | affected rows: %d, statement types: %s |", path, run.Config.DatabaseName, run.Type, summary.AffectedRows, strings.Join(summary.StatementTypes, ", ")))
+				continue
+			}
+			if result.Status == storepb.PlanCheckRunResult_Result_SUCCESS {
+				continue
+			}
+
+			body := fmt.Sprintf("%s **%s** (%d)\n\n%s", getResultStatusIcon(result.Status), result.Title, result.Code, result.Content)
+			line := int(result.GetSqlReviewReport().GetLine())
+			if line <= 0 || path == "" {
+				report.rows = append(report.rows, fmt.Sprintf("| %s | %s | %s | %s %s |", path, run.Config.DatabaseName, run.Type, getResultStatusIcon(result.Status), escapeTableCell(result.Title+": "+result.Content)))
+				continue
+			}
+			// The same advice is found on every database the file applies to.
+			key := fmt.Sprintf("%s:%d:%s", path, line, body)
+			if seenComments[key] {
+				continue
+			}
+			seenComments[key] = true
+			report.comments = append(report.comments, &vcs.PullRequestReviewComment{
+				Path: path,
+				Line: line,
+				Body: body,
+			})
+		}
+	}
+	sort.Slice(report.comments, func(i, j int) bool {
+		if report.comments[i].Path != report.comments[j].Path {
+			return report.comments[i].Path < report.comments[j].Path
+		}
+		return report.comments[i].Line < report.comments[j].Line
+	})
+	return report, nil
+}
+
+func (r *vcsReport) state() vcs.CommitState {
+	if r.errors > 0 {
+		return vcs.CommitStateFailure
+	}
+	return vcs.CommitStateSuccess
+}
+
+func (r *vcsReport) description() string {
+	return fmt.Sprintf("Bytebase plan checks: %d error(s), %d warning(s)", r.errors, r.warnings)
+}
+
+func (r *vcsReport) summary(targetURL string) string {
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "Bytebase Bot: %s.\n\n", r.description())
+	if r.riskLevel != storepb.IssuePayloadApproval_RISK_LEVEL_UNSPECIFIED {
+		_, _ = fmt.Fprintf(&buf, "Risk level: %s\n\n", r.riskLevel.String())
+	}
+	if len(r.rows) > 0 {
+		_, _ = buf.WriteString("| File | Database | Check | Result |\n| --- | --- | --- | --- |\n")
+		for _, row := range r.rows {
+			_, _ = buf.WriteString(row)
+			_, _ = buf.WriteString("\n")
+		}
+		_, _ = buf.WriteString("\n")
+	}
+	if targetURL != "" {
+		_, _ = fmt.Fprintf(&buf, "Check out the details at %s.", targetURL)
+	}
+	return buf.String()
+}
+
+// getLatestPlanCheckRuns returns the latest plan check run for each type of check on each database for each sheet.
+func getLatestPlanCheckRuns(planCheckRuns []*store.PlanCheckRunMessage) []*store.PlanCheckRunMessage {
+	type key struct {
+		sheetUID     int32
+		instanceUID  int32
+		databaseName string
+		checkType    store.PlanCheckRunType
+	}
+	latestRuns := map[key]*store.PlanCheckRunMessage{}
+	for _, run := range planCheckRuns {
+		k := key{
+			sheetUID:     run.Config.SheetUid,
+			instanceUID:  run.Config.InstanceUid,
+			databaseName: run.Config.DatabaseName,
+			checkType:    run.Type,
+		}
+		if latest, ok := latestRuns[k]; !ok || latest.UID < run.UID {
+			latestRuns[k] = run
+		}
+	}
+	var runs []*store.PlanCheckRunMessage
+	for _, run := range latestRuns {
+		runs = append(runs, run)
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].UID < runs[j].UID
+	})
+	return runs
+}
+
+func getPullRequestID(url string) string {
+	fields := strings.Split(strings.TrimSuffix(url, "/"), "/")
+	return fields[len(fields)-1]
+}
+
+func getResultStatusIcon(status storepb.PlanCheckRunResult_Result_Status) string {
+	switch status {
+	case storepb.PlanCheckRunResult_Result_ERROR:
+		return "❌"
+	case storepb.PlanCheckRunResult_Result_WARNING:
+		return "⚠️"
+	default:
+		return "✅"
+	}
+}
+
+func escapeTableCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
@@ -0,0 +1,52 @@
+package plancheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetLatestPlanCheckRuns(t *testing.T) {
+	newRun := func(uid int, sheetUID int32, databaseName string, checkType store.PlanCheckRunType) *store.PlanCheckRunMessage {
+		return &store.PlanCheckRunMessage{
+			UID:  uid,
+			Type: checkType,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:     sheetUID,
+				InstanceUid:  1,
+				DatabaseName: databaseName,
+			},
+		}
+	}
+	runs := getLatestPlanCheckRuns([]*store.PlanCheckRunMessage{
+		newRun(1, 1, "db1", store.PlanCheckDatabaseStatementAdvise),
+		newRun(2, 1, "db1", store.PlanCheckDatabaseStatementSummaryReport),
+		newRun(3, 2, "db1", store.PlanCheckDatabaseStatementAdvise),
+		newRun(4, 1, "db1", store.PlanCheckDatabaseStatementAdvise),
+		newRun(5, 1, "db2", store.PlanCheckDatabaseStatementAdvise),
+	})
+	var uids []int
+	for _, run := range runs {
+		uids = append(uids, run.UID)
+	}
+	require.Equal(t, []int{2, 3, 4, 5}, uids)
+}
+
+func TestVCSReport(t *testing.T) {
+	report := &vcsReport{
+		rows:     []string{"| migrations/1_init.sql | db | bb.plan-check.database.statement.advise | ❌ error |"},
+		errors:   1,
+		warnings: 2,
+	}
+	require.Equal(t, vcs.CommitStateFailure, report.state())
+	require.Equal(t, "Bytebase plan checks: 1 error(s), 2 warning(s)", report.description())
+	require.Contains(t, report.summary("https://bytebase.example.com/projects/p/issues/1"), "| migrations/1_init.sql |")
+
+	report = &vcsReport{warnings: 1}
+	require.Equal(t, vcs.CommitStateSuccess, report.state())
+	require.Equal(t, "1", getPullRequestID("https://github.com/owner/repo/pull/1"))
+}
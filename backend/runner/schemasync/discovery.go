@@ -0,0 +1,397 @@
+package schemasync
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	defaultDiscoverySampleSize = 100
+	maxDiscoverySampleSize     = 1000
+	// discoveryInterval is the interval to discover the sensitive data again if the schema is not changed.
+	discoveryInterval = 24 * time.Hour
+	// discoveryMatchRatio is the minimum ratio of the sampled values detected as a data type to propose it.
+	discoveryMatchRatio = 0.8
+)
+
+// discoveryEngines are the engines whose tables can be sampled for sensitive data discovery.
+var discoveryEngines = map[storepb.Engine]bool{
+	storepb.Engine_MYSQL:     true,
+	storepb.Engine_MARIADB:   true,
+	storepb.Engine_TIDB:      true,
+	storepb.Engine_OCEANBASE: true,
+	storepb.Engine_POSTGRES:  true,
+}
+
+// sensitiveDataDetector detects a sensitive data type by the column name and the sampled values.
+type sensitiveDataDetector struct {
+	dataType storepb.SensitiveDataReport_DataType
+	// columnName matches the column names indicating the data type.
+	columnName *regexp.Regexp
+	// match returns true if the value is the data type.
+	match func(value string, columnNameMatched bool) bool
+	// keywords match the titles of the semantic types and the classifications for the data type.
+	keywords []string
+}
+
+var (
+	emailRegexp         = regexp.MustCompile(`^[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}$`)
+	phoneNumberRegexp   = regexp.MustCompile(`^\+?[0-9 ().\-]+$`)
+	usSSNRegexp         = regexp.MustCompile(`^([0-9]{3})-([0-9]{2})-([0-9]{4})$`)
+	chinaResidentRegexp = regexp.MustCompile(`^[1-9][0-9]{16}[0-9Xx]$`)
+	ibanRegexp          = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+)
+
+// sensitiveDataDetectors are ordered by the precedence, the detectors with checksums come first.
+var sensitiveDataDetectors = []*sensitiveDataDetector{
+	{
+		dataType:   storepb.SensitiveDataReport_CREDIT_CARD,
+		columnName: regexp.MustCompile(`(?i)(credit_?card|card_?(no|num|number)$|^cc_?(no|num|number)$|^pan$)`),
+		match:      func(value string, _ bool) bool { return isCreditCardNumber(value) },
+		keywords:   []string{"credit card", "bank card", "card number"},
+	},
+	{
+		dataType:   storepb.SensitiveDataReport_IBAN,
+		columnName: regexp.MustCompile(`(?i)iban`),
+		match:      func(value string, _ bool) bool { return isIBAN(value) },
+		keywords:   []string{"iban", "bank account"},
+	},
+	{
+		dataType:   storepb.SensitiveDataReport_NATIONAL_ID,
+		columnName: regexp.MustCompile(`(?i)(ssn|national_?id|id_?card|id_?number|identity)`),
+		match:      func(value string, _ bool) bool { return isNationalID(value) },
+		keywords:   []string{"national id", "id card", "identity", "ssn", "social security"},
+	},
+	{
+		dataType:   storepb.SensitiveDataReport_EMAIL,
+		columnName: regexp.MustCompile(`(?i)e_?mail`),
+		match:      func(value string, _ bool) bool { return emailRegexp.MatchString(value) },
+		keywords:   []string{"email", "e-mail", "mail"},
+	},
+	{
+		dataType:   storepb.SensitiveDataReport_PHONE_NUMBER,
+		columnName: regexp.MustCompile(`(?i)(phone|mobile|^tel|telephone|^fax)`),
+		match:      isPhoneNumber,
+		keywords:   []string{"phone", "mobile", "telephone"},
+	},
+}
+
+// discoverSensitiveData samples the rows of the tables and writes the sensitive data report to the database metadata.
+func (s *Syncer) discoverSensitiveData(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, databaseMetadata *storepb.DatabaseSchemaMetadata, dbModelConfig *model.DatabaseConfig, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig) error {
+	sampleSize := int(classificationConfig.GetDiscovery().GetSampleSize())
+	if sampleSize <= 0 {
+		sampleSize = defaultDiscoverySampleSize
+	}
+	sampleSize = min(sampleSize, maxDiscoverySampleSize)
+
+	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get semantic types setting")
+	}
+	columnConfigs := map[string]*storepb.ColumnConfig{}
+	for _, schemaConfig := range dbModelConfig.BuildDatabaseConfig().SchemaConfigs {
+		for _, tableConfig := range schemaConfig.TableConfigs {
+			for _, columnConfig := range tableConfig.ColumnConfigs {
+				columnConfigs[fmt.Sprintf("%s/%s/%s", schemaConfig.Name, tableConfig.Name, columnConfig.Name)] = columnConfig
+			}
+		}
+	}
+
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
+	if err != nil {
+		return err
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		return errors.Errorf("sensitive data discovery is not supported for %s", instance.Engine.String())
+	}
+	deadlineCtx, cancelFunc := context.WithDeadline(ctx, time.Now().Add(syncTimeout))
+	defer cancelFunc()
+	conn, err := sqlDB.Conn(deadlineCtx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	report := &storepb.SensitiveDataReport{
+		DiscoverTime: timestamppb.Now(),
+		SampleSize:   int32(sampleSize),
+	}
+	for _, schema := range databaseMetadata.Schemas {
+		for _, table := range schema.Tables {
+			var columns []*storepb.ColumnMetadata
+			for _, column := range table.Columns {
+				// Skip the columns that are reviewed.
+				if config := columnConfigs[fmt.Sprintf("%s/%s/%s", schema.Name, table.Name, column.Name)]; config != nil && config.ClassificationId != "" && config.SemanticTypeId != "" {
+					continue
+				}
+				columns = append(columns, column)
+			}
+			if len(columns) == 0 {
+				continue
+			}
+			statement := getSampleStatement(instance.Engine, schema.Name, table.Name, columns, sampleSize)
+			// Skip the tables that cannot be sampled, for example, the user has no privilege.
+			skip := func(reason string) {
+				report.SkippedTables = append(report.SkippedTables, &storepb.SensitiveDataReport_SkippedTable{
+					Schema: schema.Name,
+					Table:  table.Name,
+					Reason: reason,
+				})
+			}
+			results, err := driver.QueryConn(deadlineCtx, conn, statement, &db.QueryContext{ReadOnly: true})
+			if err != nil {
+				if deadlineCtx.Err() != nil {
+					return errors.Wrapf(err, "failed to sample table %q", table.Name)
+				}
+				skip(err.Error())
+				continue
+			}
+			if len(results) != 1 {
+				skip(fmt.Sprintf("expect 1 result, got %d", len(results)))
+				continue
+			}
+			if results[0].Error != "" {
+				skip(results[0].Error)
+				continue
+			}
+			if len(results[0].ColumnNames) != len(columns) {
+				skip(fmt.Sprintf("expect %d columns, got %d", len(columns), len(results[0].ColumnNames)))
+				continue
+			}
+			for i, column := range columns {
+				var values []string
+				for _, row := range results[0].Rows {
+					if i >= len(row.Values) {
+						continue
+					}
+					// Only the string values are sampled, the numeric columns are mostly identifiers.
+					if value := strings.TrimSpace(row.Values[i].GetStringValue()); value != "" {
+						values = append(values, value)
+					}
+				}
+				finding := detectSensitiveData(column.Name, values)
+				if finding == nil {
+					continue
+				}
+				finding.Schema = schema.Name
+				finding.Table = table.Name
+				finding.Column = column.Name
+				proposeClassification(finding, semanticTypesSetting, classificationConfig)
+				report.Findings = append(report.Findings, finding)
+			}
+		}
+	}
+
+	if _, err := s.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
+		InstanceID:   database.InstanceID,
+		DatabaseName: database.DatabaseName,
+		MetadataUpsert: &storepb.DatabaseMetadata{
+			SensitiveDataReport: report,
+		},
+	}, api.SystemBotID); err != nil {
+		return errors.Wrapf(err, "failed to update sensitive data report for database %q", database.DatabaseName)
+	}
+	return nil
+}
+
+// shouldDiscoverSensitiveData returns true if the sensitive data discovery is enabled and the report is outdated.
+func shouldDiscoverSensitiveData(instance *store.InstanceMessage, database *store.DatabaseMessage, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig, schemaChanged bool) bool {
+	if !classificationConfig.GetDiscovery().GetEnabled() || !discoveryEngines[instance.Engine] {
+		return false
+	}
+	if schemaChanged {
+		return true
+	}
+	report := database.Metadata.GetSensitiveDataReport()
+	if report == nil || !report.DiscoverTime.IsValid() {
+		return true
+	}
+	return time.Since(report.DiscoverTime.AsTime()) >= discoveryInterval
+}
+
+func getSampleStatement(engine storepb.Engine, schemaName, tableName string, columns []*storepb.ColumnMetadata, sampleSize int) string {
+	quote := func(identifier string) string {
+		if engine == storepb.Engine_POSTGRES {
+			return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+		}
+		return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+	}
+	var columnNames []string
+	for _, column := range columns {
+		columnNames = append(columnNames, quote(column.Name))
+	}
+	table := quote(tableName)
+	if schemaName != "" {
+		table = fmt.Sprintf("%s.%s", quote(schemaName), table)
+	}
+	return fmt.Sprintf("SELECT %s FROM %s LIMIT %d", strings.Join(columnNames, ", "), table, sampleSize)
+}
+
+// detectSensitiveData detects the data type of the column by the column name and the sampled non-empty values.
+// It returns nil if no data type is detected.
+func detectSensitiveData(columnName string, values []string) *storepb.SensitiveDataReport_Finding {
+	var best *storepb.SensitiveDataReport_Finding
+	var bestScore float64
+	for _, detector := range sensitiveDataDetectors {
+		columnNameMatched := detector.columnName.MatchString(columnName)
+		matched := 0
+		for _, value := range values {
+			if detector.match(value, columnNameMatched) {
+				matched++
+			}
+		}
+		var ratio float64
+		if len(values) > 0 {
+			ratio = float64(matched) / float64(len(values))
+		}
+		// Propose the data type if most of the values match it, or only the column name matches it if there is no value sampled.
+		if ratio < discoveryMatchRatio && !(columnNameMatched && len(values) == 0) {
+			continue
+		}
+		score := ratio
+		if columnNameMatched {
+			score += 0.5
+		}
+		if best == nil || score > bestScore {
+			best = &storepb.SensitiveDataReport_Finding{
+				DataType:          detector.dataType,
+				MatchRatio:        ratio,
+				SampledCount:      int32(len(values)),
+				ColumnNameMatched: columnNameMatched,
+			}
+			bestScore = score
+		}
+	}
+	return best
+}
+
+// proposeClassification proposes the semantic type and the classification whose titles match the data type of the finding.
+func proposeClassification(finding *storepb.SensitiveDataReport_Finding, semanticTypesSetting *storepb.SemanticTypeSetting, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig) {
+	var keywords []string
+	for _, detector := range sensitiveDataDetectors {
+		if detector.dataType == finding.DataType {
+			keywords = detector.keywords
+		}
+	}
+	matchKeywords := func(texts ...string) bool {
+		for _, text := range texts {
+			text = strings.ToLower(text)
+			for _, keyword := range keywords {
+				if strings.Contains(text, keyword) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	for _, semanticType := range semanticTypesSetting.GetTypes() {
+		if matchKeywords(semanticType.Title, semanticType.Description) {
+			finding.SemanticTypeId = semanticType.Id
+			break
+		}
+	}
+	var classificationIDs []string
+	for id := range classificationConfig.GetClassification() {
+		classificationIDs = append(classificationIDs, id)
+	}
+	slices.Sort(classificationIDs)
+	for _, id := range classificationIDs {
+		classification := classificationConfig.Classification[id]
+		if matchKeywords(classification.Title, classification.Description) {
+			finding.ClassificationId = classification.Id
+			finding.LevelId = classification.GetLevelId()
+			break
+		}
+	}
+}
+
+func isCreditCardNumber(value string) bool {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(value)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		if digits[i] < '0' || digits[i] > '9' {
+			return false
+		}
+		d := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func isIBAN(value string) bool {
+	iban := strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+	if !ibanRegexp.MatchString(iban) {
+		return false
+	}
+	// Move the country code and the check digits to the end, and the IBAN is valid if it mod 97 is 1.
+	remainder := 0
+	for _, r := range iban[4:] + iban[:4] {
+		if r >= 'A' && r <= 'Z' {
+			remainder = (remainder*100 + int(r-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(r-'0')) % 97
+		}
+	}
+	return remainder == 1
+}
+
+// isNationalID returns true if the value is a US social security number or a China resident identity card number.
+func isNationalID(value string) bool {
+	if matches := usSSNRegexp.FindStringSubmatch(value); matches != nil {
+		area, group, serial := matches[1], matches[2], matches[3]
+		return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+	}
+	if chinaResidentRegexp.MatchString(value) {
+		// The check digit is computed by ISO 7064 MOD 11-2.
+		weights := []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+		sum := 0
+		for i, weight := range weights {
+			sum += int(value[i]-'0') * weight
+		}
+		return "10X98765432"[sum%11] == strings.ToUpper(value)[17]
+	}
+	return false
+}
+
+// isPhoneNumber returns true if the value is a phone number with 7 to 15 digits.
+// The values of digits only are ambiguous with the identifiers, so they are phone numbers only if the column name indicates it.
+func isPhoneNumber(value string, columnNameMatched bool) bool {
+	if !phoneNumberRegexp.MatchString(value) {
+		return false
+	}
+	digits := 0
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+	if digits < 7 || digits > 15 {
+		return false
+	}
+	return columnNameMatched || digits != len(value) || strings.HasPrefix(value, "+")
+}
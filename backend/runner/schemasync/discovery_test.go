@@ -0,0 +1,113 @@
+package schemasync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestDetectSensitiveData(t *testing.T) {
+	tests := []struct {
+		columnName string
+		values     []string
+		want       storepb.SensitiveDataReport_DataType
+	}{
+		{
+			columnName: "contact",
+			values:     []string{"alice@example.com", "bob@example.org", "carol@example.net"},
+			want:       storepb.SensitiveDataReport_EMAIL,
+		},
+		{
+			columnName: "contact",
+			values:     []string{"alice@example.com", "N/A", "unknown", "bob@example.org"},
+			want:       storepb.SensitiveDataReport_DATA_TYPE_UNSPECIFIED,
+		},
+		{
+			columnName: "payment",
+			values:     []string{"4111 1111 1111 1111", "5500-0000-0000-0004", "378282246310005"},
+			want:       storepb.SensitiveDataReport_CREDIT_CARD,
+		},
+		{
+			// The Luhn checksums are invalid.
+			columnName: "payment",
+			values:     []string{"4111 1111 1111 1112", "5500-0000-0000-0005"},
+			want:       storepb.SensitiveDataReport_DATA_TYPE_UNSPECIFIED,
+		},
+		{
+			columnName: "account",
+			values:     []string{"GB82 WEST 1234 5698 7654 32", "DE89370400440532013000"},
+			want:       storepb.SensitiveDataReport_IBAN,
+		},
+		{
+			columnName: "code",
+			values:     []string{"123-45-6789", "11010519491231002X"},
+			want:       storepb.SensitiveDataReport_NATIONAL_ID,
+		},
+		{
+			columnName: "code",
+			values:     []string{"000-45-6789", "110105194912310021"},
+			want:       storepb.SensitiveDataReport_DATA_TYPE_UNSPECIFIED,
+		},
+		{
+			columnName: "contact",
+			values:     []string{"+1 415-555-0132", "(415) 555-0199"},
+			want:       storepb.SensitiveDataReport_PHONE_NUMBER,
+		},
+		{
+			// The values of digits only are identifiers unless the column name indicates phone numbers.
+			columnName: "order_no",
+			values:     []string{"4155550132", "4155550199"},
+			want:       storepb.SensitiveDataReport_DATA_TYPE_UNSPECIFIED,
+		},
+		{
+			columnName: "mobile",
+			values:     []string{"4155550132", "4155550199"},
+			want:       storepb.SensitiveDataReport_PHONE_NUMBER,
+		},
+		{
+			// The column name is the only evidence if the table is empty.
+			columnName: "user_email",
+			want:       storepb.SensitiveDataReport_EMAIL,
+		},
+		{
+			columnName: "name",
+			values:     []string{"alice", "bob"},
+			want:       storepb.SensitiveDataReport_DATA_TYPE_UNSPECIFIED,
+		},
+	}
+
+	a := assert.New(t)
+	for _, test := range tests {
+		got := detectSensitiveData(test.columnName, test.values)
+		a.Equal(test.want, got.GetDataType(), "%s: %v", test.columnName, test.values)
+	}
+}
+
+func TestProposeClassification(t *testing.T) {
+	a := assert.New(t)
+	finding := &storepb.SensitiveDataReport_Finding{DataType: storepb.SensitiveDataReport_EMAIL}
+	levelID := "2"
+	proposeClassification(finding, &storepb.SemanticTypeSetting{
+		Types: []*storepb.SemanticTypeSetting_SemanticType{
+			{Id: "phone-type", Title: "Phone number"},
+			{Id: "email-type", Title: "Email address"},
+		},
+	}, &storepb.DataClassificationSetting_DataClassificationConfig{
+		Classification: map[string]*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification{
+			"1-1": {Id: "1-1", Title: "Basic"},
+			"1-2": {Id: "1-2", Title: "Contact", Description: "The email and the address", LevelId: &levelID},
+		},
+	})
+	a.Equal("email-type", finding.SemanticTypeId)
+	a.Equal("1-2", finding.ClassificationId)
+	a.Equal("2", finding.LevelId)
+}
+
+func TestGetSampleStatement(t *testing.T) {
+	a := assert.New(t)
+	columns := []*storepb.ColumnMetadata{{Name: "id"}, {Name: `e"mail`}}
+	a.Equal(`SELECT "id", "e""mail" FROM "public"."users" LIMIT 100`, getSampleStatement(storepb.Engine_POSTGRES, "public", "users", columns, 100))
+	a.Equal("SELECT `id`, `e\"mail` FROM `users` LIMIT 10", getSampleStatement(storepb.Engine_MYSQL, "", "users", columns, 10))
+}
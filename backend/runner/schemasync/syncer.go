@@ -399,7 +399,8 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 		rawDump = dbSchema.GetSchema()
 	}
 
-	if force || !cmp.Equal(oldDatabaseMetadata, databaseMetadata, protocmp.Transform()) {
+	schemaChanged := force || !cmp.Equal(oldDatabaseMetadata, databaseMetadata, protocmp.Transform())
+	if schemaChanged {
 		// Avoid updating dump everytime by dumping the schema only when the database metadata is changed.
 		// if oldDatabaseMetadata is nil and databaseMetadata is not, they are not equal resulting a sync.
 		if force || !equalDatabaseMetadata(oldDatabaseMetadata, databaseMetadata) {
@@ -424,6 +425,16 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 		}
 	}
 
+	// Discover the sensitive data by sampling the rows, the failure doesn't fail the sync.
+	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil && shouldDiscoverSensitiveData(instance, database, classificationConfig, schemaChanged) {
+		if err := s.discoverSensitiveData(ctx, instance, database, databaseMetadata, dbModelConfig, classificationConfig); err != nil {
+			slog.Error("Failed to discover sensitive data",
+				slog.String("instance", instance.ResourceID),
+				slog.String("database", database.DatabaseName),
+				log.BBError(err))
+		}
+	}
+
 	// Check schema drift
 	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSchemaDrift, instance) == nil {
 		// Redis and MongoDB are schemaless.
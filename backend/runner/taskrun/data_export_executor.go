@@ -107,24 +107,17 @@ func (exec *DataExportExecutor) RunOnce(ctx context.Context, _ context.Context,
 		Format:    v1pb.ExportFormat(payload.Format),
 		Password:  payload.Password,
 	}
-	bytes, durationNs, exportErr := apiv1.DoExport(ctx, exec.store, exec.dbFactory, exec.license, exportRequest, instance, database, spans)
-	if exportErr != nil {
-		return true, nil, errors.Wrap(exportErr, "failed to export data")
-	}
-
-	encryptedBytes, err := apiv1.DoEncrypt(bytes, exportRequest)
+	setting, err := exec.store.GetWorkspaceGeneralSetting(ctx)
 	if err != nil {
-		return true, nil, errors.Wrap(err, "failed to encrypt data")
+		return true, nil, errors.Wrap(err, "failed to get workspace general setting")
 	}
-
-	exportArchive, err := exec.store.CreateExportArchive(ctx, &store.ExportArchiveMessage{
-		Bytes: encryptedBytes,
-		Payload: &storepb.ExportArchivePayload{
-			FileFormat: payload.Format,
-		},
-	})
+	maximumSize := setting.GetMaximumExportSize()
+	if maximumSize == 0 {
+		maximumSize = apiv1.DefaultMaximumExportSize
+	}
+	exportArchive, durationNs, err := apiv1.DoExportToArchive(ctx, exec.store, exec.dbFactory, exec.license, exportRequest, instance, database, spans, maximumSize)
 	if err != nil {
-		return true, nil, errors.Wrap(err, "failed to create export archive")
+		return true, nil, errors.Wrap(err, "failed to export data")
 	}
 
 	return true, &storepb.TaskRunResult{
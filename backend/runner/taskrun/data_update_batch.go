@@ -0,0 +1,547 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+// replicaLagCheckInterval is the interval to check the replica lag while the execution is throttled.
+const replicaLagCheckInterval = 5 * time.Second
+
+// runBatchedMigration runs the single-table UPDATE or DELETE statement in batches ranged by the primary key of the table.
+func (exec *DataUpdateExecutor) runBatchedMigration(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int, statement string, payload *api.TaskDatabaseDataUpdatePayload, version model.Version) (bool, *storepb.TaskRunResult, error) {
+	mi, err := getMigrationInfo(ctx, exec.store, exec.profile, task, db.Data, statement, version, &payload.SheetID)
+	if err != nil {
+		return true, nil, err
+	}
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	database, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+	if instance.Engine != storepb.Engine_MYSQL && instance.Engine != storepb.Engine_POSTGRES {
+		return true, nil, errors.Errorf("batched data update is not supported for engine %s", instance.Engine)
+	}
+	dbSchema, err := exec.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get database schema")
+	}
+	if dbSchema == nil {
+		return true, nil, errors.Errorf("database schema %d not found", database.UID)
+	}
+
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get driver connection for instance %q", instance.ResourceID)
+	}
+	defer driver.Close(ctx)
+
+	b := &dataUpdateBatcher{
+		ctx:        ctx,
+		store:      exec.store,
+		stateCfg:   exec.stateCfg,
+		taskRunUID: taskRunUID,
+		engine:     instance.Engine,
+		db:         driver.GetDB(),
+		detail:     payload.BatchDetail,
+	}
+	if payload.BatchDetail.MaxReplicaLagSeconds > 0 {
+		if utils.DataSourceFromInstanceWithType(instance, api.RO) == nil {
+			slog.Warn("skip replica lag throttling because the instance has no read-only data source", slog.String("instance", instance.ResourceID))
+		} else {
+			replicaDriver, err := exec.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "")
+			if err != nil {
+				return true, nil, errors.Wrapf(err, "failed to get read-only driver connection for instance %q", instance.ResourceID)
+			}
+			defer replicaDriver.Close(ctx)
+			b.replicaDB = replicaDriver.GetDB()
+		}
+	}
+
+	execFunc := func(ctx context.Context, execStatement string) error {
+		if err := b.prepare(execStatement, database.DatabaseName, dbSchema); err != nil {
+			return err
+		}
+		return b.run(ctx)
+	}
+	migrationID, _, err := utils.ExecuteMigrationWithFunc(ctx, driverCtx, exec.store, exec.stateCfg, taskRunUID, driver, mi, statement, &payload.SheetID, execFunc, db.ExecuteOptions{})
+	if err != nil {
+		return true, nil, err
+	}
+	return postMigration(ctx, exec.store, task, mi, migrationID, &payload.SheetID)
+}
+
+// dataUpdateBatcher executes the data update statement batch by batch.
+// The primary key values of the last row in the committed batches are persisted as the cursor in the task run payload,
+// so that a canceled or failed task run can be resumed from the next batch.
+// The batch is recorded as pending with its transaction id before it's committed, so that a resume after a crash
+// between the commit and the save of the cursor checks the transaction instead of re-running the batch.
+type dataUpdateBatcher struct {
+	// ctx is the context for store operations, which is not canceled when the task run is canceled.
+	ctx        context.Context
+	store      *store.Store
+	stateCfg   *state.State
+	taskRunUID int
+
+	engine    storepb.Engine
+	db        *sql.DB
+	replicaDB *sql.DB
+	detail    *api.BatchDetail
+
+	dml         *base.BatchDML
+	table       string
+	primaryKeys []*storepb.ColumnMetadata
+	rowCount    int64
+}
+
+func (b *dataUpdateBatcher) prepare(statement string, databaseName string, dbSchema *model.DBSchema) error {
+	dml, err := base.ExtractBatchDML(b.engine, statement)
+	if err != nil {
+		return errors.Wrapf(err, "failed to extract the statement to execute in batches")
+	}
+
+	schemaName := dml.Schema
+	switch b.engine {
+	case storepb.Engine_MYSQL:
+		if dml.Schema != "" && dml.Schema != databaseName {
+			return errors.Errorf("cannot change table %q in database %q", dml.Table, dml.Schema)
+		}
+		// MySQL database metadata has a single schema with empty name.
+		schemaName = ""
+		b.table = quoteIdentifier(b.engine, dml.Table)
+	default:
+		if schemaName == "" {
+			schemaName = "public"
+		}
+		b.table = fmt.Sprintf("%s.%s", quoteIdentifier(b.engine, schemaName), quoteIdentifier(b.engine, dml.Table))
+	}
+	schema := dbSchema.GetDatabaseMetadata().GetSchema(schemaName)
+	if schema == nil {
+		return errors.Errorf("schema %q not found", schemaName)
+	}
+	table := schema.GetTable(dml.Table)
+	if table == nil {
+		return errors.Errorf("table %q not found", dml.Table)
+	}
+	var primaryKeys []*storepb.ColumnMetadata
+	for _, index := range table.GetProto().GetIndexes() {
+		if !index.GetPrimary() {
+			continue
+		}
+		for _, expression := range index.GetExpressions() {
+			column := table.GetColumn(expression)
+			if column == nil {
+				return errors.Errorf("primary key column %q not found in table %q", expression, dml.Table)
+			}
+			primaryKeys = append(primaryKeys, column)
+		}
+	}
+	if len(primaryKeys) == 0 {
+		return errors.Errorf("table %q has no primary key to execute in batches", dml.Table)
+	}
+
+	b.dml = dml
+	b.primaryKeys = primaryKeys
+	b.rowCount = table.GetRowCount()
+	return nil
+}
+
+func (b *dataUpdateBatcher) run(ctx context.Context) error {
+	cursor, done, err := b.resolvePendingBatch(ctx)
+	if err != nil {
+		return err
+	}
+	if done {
+		return nil
+	}
+	if len(cursor) != 0 && len(cursor) != len(b.primaryKeys) {
+		return errors.Errorf("batch cursor %v does not match the primary key of table %q", cursor, b.dml.Table)
+	}
+
+	for completed := int32(0); ; completed++ {
+		if err := b.waitForReplica(ctx); err != nil {
+			return err
+		}
+		upper, err := b.getUpperBound(ctx, cursor)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the range of batch %d", completed+1)
+		}
+		predicate, args := b.getRangePredicate(cursor, upper)
+		xid := fmt.Sprintf("bytebase_batch_%d_%d", b.taskRunUID, completed+1)
+		if err := b.executeBatch(ctx, xid, upper, b.dml.Rewrite(predicate), args); err != nil {
+			return errors.Wrapf(err, "failed to execute batch %d", completed+1)
+		}
+		b.updateProgress(completed + 1)
+		if upper == nil {
+			return b.saveCursor(cursor, nil)
+		}
+		cursor = upper
+		if err := b.saveCursor(cursor, nil); err != nil {
+			return err
+		}
+
+		if b.detail.SleepIntervalMs > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(b.detail.SleepIntervalMs) * time.Millisecond):
+			}
+		}
+	}
+}
+
+// executeBatch executes the batch in a transaction, which is recorded as the pending batch before it's committed.
+// MySQL prepares the XA transaction before the record, so that the transaction can be committed after a crash.
+func (b *dataUpdateBatcher) executeBatch(ctx context.Context, xid string, upper []string, statement string, args []any) error {
+	conn, err := b.db.Conn(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get connection")
+	}
+	defer conn.Close()
+
+	switch b.engine {
+	case storepb.Engine_POSTGRES:
+		if _, err := conn.ExecContext(ctx, "BEGIN"); err != nil {
+			return err
+		}
+		if err := conn.QueryRowContext(ctx, "SELECT txid_current()::text").Scan(&xid); err != nil {
+			rollbackTransaction(conn, "ROLLBACK")
+			return errors.Wrapf(err, "failed to get transaction id")
+		}
+		if _, err := conn.ExecContext(ctx, statement, args...); err != nil {
+			rollbackTransaction(conn, "ROLLBACK")
+			return err
+		}
+		if err := b.savePendingBatch(upper, xid); err != nil {
+			rollbackTransaction(conn, "ROLLBACK")
+			return err
+		}
+		// The commit decision is made once the pending batch is recorded, even if the task run is canceled.
+		if err := finishTransaction(b.ctx, conn, b.engine, xid, false /* prepared */, true /* commit */); err != nil {
+			return err
+		}
+	default:
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("XA START '%s'", xid)); err != nil {
+			return err
+		}
+		if _, err := conn.ExecContext(ctx, statement, args...); err != nil {
+			rollbackTransaction(conn, fmt.Sprintf("XA END '%s'", xid), fmt.Sprintf("XA ROLLBACK '%s'", xid))
+			return err
+		}
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("XA END '%s'", xid)); err != nil {
+			rollbackTransaction(conn, fmt.Sprintf("XA ROLLBACK '%s'", xid))
+			return err
+		}
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("XA PREPARE '%s'", xid)); err != nil {
+			rollbackTransaction(conn, fmt.Sprintf("XA ROLLBACK '%s'", xid))
+			return err
+		}
+		if err := b.savePendingBatch(upper, xid); err != nil {
+			rollbackTransaction(conn, fmt.Sprintf("XA ROLLBACK '%s'", xid))
+			return err
+		}
+		if err := finishTransaction(b.ctx, conn, b.engine, xid, true /* prepared */, true /* commit */); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolvePendingBatch returns the cursor to continue from, and whether all batches are done.
+// The pending batch left by the crash is counted as committed if its transaction is committed, and re-run otherwise.
+func (b *dataUpdateBatcher) resolvePendingBatch(ctx context.Context) ([]string, bool, error) {
+	payload, err := b.getTaskRunPayload()
+	if err != nil {
+		return nil, false, err
+	}
+	cursor := payload.GetBatchCursor()
+	pending := payload.GetPendingBatch()
+	if pending == nil {
+		return cursor, false, nil
+	}
+	committed, err := b.isBatchCommitted(ctx, pending.Xid)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to check the transaction %q of the pending batch", pending.Xid)
+	}
+	if !committed {
+		return cursor, false, b.saveCursor(cursor, nil)
+	}
+	if err := b.saveCursor(pending.Cursor, nil); err != nil {
+		return nil, false, err
+	}
+	return pending.Cursor, len(pending.Cursor) == 0, nil
+}
+
+// isBatchCommitted returns true if the transaction of the pending batch is committed.
+// The XA transaction left prepared is committed, because the pending batch is recorded only after it's prepared.
+func (b *dataUpdateBatcher) isBatchCommitted(ctx context.Context, xid string) (bool, error) {
+	switch b.engine {
+	case storepb.Engine_POSTGRES:
+		var txStatus sql.NullString
+		if err := b.db.QueryRowContext(ctx, "SELECT txid_status($1::bigint)", xid).Scan(&txStatus); err != nil {
+			return false, err
+		}
+		if txStatus.String == "in progress" {
+			return false, errors.Errorf("transaction %s is still in progress", xid)
+		}
+		return txStatus.String == "committed", nil
+	default:
+		conn, err := b.db.Conn(ctx)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get connection")
+		}
+		defer conn.Close()
+		prepared, err := isTransactionPrepared(ctx, conn, b.engine, xid)
+		if err != nil {
+			return false, err
+		}
+		if prepared {
+			if err := finishTransaction(ctx, conn, b.engine, xid, true /* prepared */, true /* commit */); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+}
+
+// getUpperBound returns the primary key values of the last row in the batch after the cursor,
+// or nil if there are fewer rows than the batch size.
+func (b *dataUpdateBatcher) getUpperBound(ctx context.Context, cursor []string) ([]string, error) {
+	var columns []string
+	for _, column := range b.primaryKeys {
+		columns = append(columns, quoteIdentifier(b.engine, column.Name))
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(columns, ", "), b.table)
+	var args []any
+	if len(cursor) != 0 {
+		var predicate string
+		predicate, args = b.getRangePredicate(cursor, nil)
+		query += " WHERE " + predicate
+	}
+	query += fmt.Sprintf(" ORDER BY %s LIMIT 1 OFFSET %d", strings.Join(columns, ", "), b.detail.BatchSize-1)
+
+	rows, err := b.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	values := make([]sql.NullString, len(b.primaryKeys))
+	dest := make([]any, len(values))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	var upper []string
+	for _, value := range values {
+		upper = append(upper, value.String)
+	}
+	return upper, rows.Err()
+}
+
+// getRangePredicate returns the predicate for the rows after the lower bound and up to the upper bound.
+func (b *dataUpdateBatcher) getRangePredicate(lower, upper []string) (string, []any) {
+	var columns []string
+	for _, column := range b.primaryKeys {
+		columns = append(columns, quoteIdentifier(b.engine, column.Name))
+	}
+	tuple := fmt.Sprintf("(%s)", strings.Join(columns, ", "))
+
+	var predicates []string
+	var args []any
+	for _, bound := range []struct {
+		operator string
+		values   []string
+	}{{">", lower}, {"<=", upper}} {
+		if len(bound.values) == 0 {
+			continue
+		}
+		var placeholders []string
+		for i, value := range bound.values {
+			args = append(args, b.convertValue(b.primaryKeys[i], value))
+			placeholders = append(placeholders, b.placeholder(len(args)))
+		}
+		predicates = append(predicates, fmt.Sprintf("%s %s (%s)", tuple, bound.operator, strings.Join(placeholders, ", ")))
+	}
+	if len(predicates) == 0 {
+		return "1 = 1", nil
+	}
+	return strings.Join(predicates, " AND "), args
+}
+
+func (b *dataUpdateBatcher) placeholder(n int) string {
+	if b.engine == storepb.Engine_POSTGRES {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+func (*dataUpdateBatcher) convertValue(column *storepb.ColumnMetadata, value string) any {
+	if strings.Contains(strings.ToLower(column.Type), "int") {
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return v
+		}
+	}
+	return value
+}
+
+func (b *dataUpdateBatcher) waitForReplica(ctx context.Context) error {
+	if b.replicaDB == nil {
+		return nil
+	}
+	maxLag := time.Duration(b.detail.MaxReplicaLagSeconds) * time.Second
+	for {
+		lag, err := getReplicaLag(ctx, b.engine, b.replicaDB)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get replica lag")
+		}
+		if lag <= maxLag {
+			return nil
+		}
+		slog.Debug("throttle batched data update for replica lag", slog.Int("taskRunUID", b.taskRunUID), slog.Duration("lag", lag))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(replicaLagCheckInterval):
+		}
+	}
+}
+
+func (b *dataUpdateBatcher) updateProgress(completed int32) {
+	if b.stateCfg == nil {
+		return
+	}
+	total := int32((b.rowCount + int64(b.detail.BatchSize) - 1) / int64(b.detail.BatchSize))
+	if total < completed {
+		total = completed
+	}
+	b.stateCfg.TaskRunExecutionStatuses.Store(b.taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_EXECUTING,
+			ExecutionDetail: &v1pb.TaskRun_ExecutionDetail{
+				CommandsTotal:     total,
+				CommandsCompleted: completed,
+			},
+			UpdateTime: time.Now(),
+		})
+}
+
+func (b *dataUpdateBatcher) getTaskRunPayload() (*storepb.TaskRunPayload, error) {
+	taskRuns, err := b.store.ListTaskRunsV2(b.ctx, &store.FindTaskRunMessage{UID: &b.taskRunUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list task runs")
+	}
+	if len(taskRuns) == 0 {
+		return nil, errors.Errorf("task run %d not found", b.taskRunUID)
+	}
+	return taskRuns[0].Payload, nil
+}
+
+// saveCursor saves the cursor after the committed batches and the pending batch.
+func (b *dataUpdateBatcher) saveCursor(cursor []string, pending *storepb.PendingBatch) error {
+	payload, err := b.getTaskRunPayload()
+	if err != nil {
+		return err
+	}
+	if payload == nil {
+		payload = &storepb.TaskRunPayload{}
+	}
+	payload.BatchCursor = cursor
+	payload.PendingBatch = pending
+	// The task run stops if the cursor cannot be saved, otherwise a resume re-runs the committed batches.
+	if err := b.store.UpdateTaskRunPayload(b.ctx, b.taskRunUID, payload); err != nil {
+		return errors.Wrapf(err, "failed to update batch cursor of task run %d", b.taskRunUID)
+	}
+	return nil
+}
+
+// savePendingBatch records the batch about to commit after the current cursor.
+func (b *dataUpdateBatcher) savePendingBatch(upper []string, xid string) error {
+	payload, err := b.getTaskRunPayload()
+	if err != nil {
+		return err
+	}
+	return b.saveCursor(payload.GetBatchCursor(), &storepb.PendingBatch{Cursor: upper, Xid: xid})
+}
+
+// getReplicaLag returns the replication lag of the replica, or 0 if the database is not a replica.
+func getReplicaLag(ctx context.Context, engine storepb.Engine, replicaDB *sql.DB) (time.Duration, error) {
+	if engine == storepb.Engine_POSTGRES {
+		var seconds float64
+		if err := replicaDB.QueryRowContext(ctx, "SELECT COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)").Scan(&seconds); err != nil {
+			return 0, err
+		}
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+
+	rows, err := replicaDB.QueryContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		// SHOW REPLICA STATUS is introduced in MySQL 8.0.22.
+		rows, err = replicaDB.QueryContext(ctx, "SHOW SLAVE STATUS")
+		if err != nil {
+			return 0, err
+		}
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	if !rows.Next() {
+		return 0, rows.Err()
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(values))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, err
+	}
+	for i, column := range columns {
+		if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+			continue
+		}
+		if !values[i].Valid {
+			return 0, errors.New("replication is not running")
+		}
+		seconds, err := strconv.ParseInt(values[i].String, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return 0, rows.Err()
+}
+
+func quoteIdentifier(engine storepb.Engine, identifier string) string {
+	if engine == storepb.Engine_POSTGRES {
+		return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+	}
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+}
@@ -80,7 +80,13 @@ func (exec *DataUpdateExecutor) RunOnce(ctx context.Context, driverCtx context.C
 		return true, nil, err
 	}
 	version := model.Version{Version: payload.SchemaVersion}
-	terminated, result, err := runMigration(ctx, driverCtx, exec.store, exec.dbFactory, exec.stateCfg, exec.profile, task, taskRunUID, db.Data, statement, version, &payload.SheetID)
+	var terminated bool
+	var result *storepb.TaskRunResult
+	if payload.BatchDetail != nil {
+		terminated, result, err = exec.runBatchedMigration(ctx, driverCtx, task, taskRunUID, statement, payload, version)
+	} else {
+		terminated, result, err = runMigration(ctx, driverCtx, exec.store, exec.dbFactory, exec.stateCfg, exec.profile, task, taskRunUID, db.Data, statement, version, &payload.SheetID)
+	}
 	// sync database schema anyways
 	if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
 		slog.Error("failed to sync database schema",
@@ -95,6 +95,10 @@ func getMigrationInfo(ctx context.Context, stores *store.Store, profile config.P
 		return nil, err
 	}
 	if len(plans) == 1 {
+		// The changes pushed or tagged without pull requests are released by the commit and the tag.
+		if vcsSource := plans[0].Config.GetVcsSource(); vcsSource.GetPullRequestUrl() == "" && vcsSource.GetCommitId() != "" {
+			mi.ReleaseVersion = getVCSReleaseVersion(vcsSource)
+		}
 		planTypes := []store.PlanCheckRunType{store.PlanCheckDatabaseStatementSummaryReport}
 		status := []store.PlanCheckRunStatus{store.PlanCheckRunStatusDone}
 		runs, err := stores.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
@@ -242,6 +246,47 @@ func executeMigration(
 		}
 	}
 
+	groupKey, err := getTransactionGroupKey(task)
+	if err != nil {
+		return "", "", err
+	}
+	if groupKey != "" && stateCfg != nil {
+		members, err := getTransactionGroupTasks(ctx, stores, task, groupKey)
+		if err != nil {
+			return "", "", err
+		}
+		committed, err := isTransactionGroupCommitted(ctx, stores, members, "" /* groupID */)
+		if err != nil {
+			return "", "", err
+		}
+		// The transaction of the task run has been committed with the group if the task run is re-run after a restart,
+		// or if it failed after the commit.
+		execFunc := func(context.Context, string) error {
+			return nil
+		}
+		var group *state.TransactionGroup
+		if !committed {
+			group = stateCfg.TransactionGroups.Join(groupKey, len(members))
+			xid := getTransactionXID(task.ID, taskRunUID)
+			execFunc = func(execCtx context.Context, execStatement string) error {
+				return executeInTransactionGroup(ctx, execCtx, stores, group, instance.Engine, driver, taskRunUID, xid, execStatement)
+			}
+		}
+		migrationID, schema, err := utils.ExecuteMigrationWithFunc(ctx, driverCtx, stores, stateCfg, taskRunUID, driver, mi, statement, sheetID, execFunc, opts)
+		if err != nil {
+			// Roll back the group in case the migration fails before the statement is prepared.
+			if group != nil {
+				group.Fail()
+			}
+			return "", "", err
+		}
+		return migrationID, schema, nil
+	}
+
+	if err := setCommittedCommandOptions(ctx, stores, taskRunUID, &opts); err != nil {
+		return "", "", err
+	}
+
 	migrationID, schema, err := utils.ExecuteMigrationDefault(ctx, driverCtx, stores, stateCfg, taskRunUID, driver, mi, statement, sheetID, opts)
 	if err != nil {
 		return "", "", err
@@ -250,6 +295,41 @@ func executeMigration(
 	return migrationID, schema, nil
 }
 
+// setCommittedCommandOptions sets the execute options so that the commands committed by a previous task run are skipped,
+// and the committed commands of this task run are persisted for resuming from the failed command.
+func setCommittedCommandOptions(ctx context.Context, stores *store.Store, taskRunUID int, opts *db.ExecuteOptions) error {
+	taskRuns, err := stores.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{UID: &taskRunUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list task runs")
+	}
+	if len(taskRuns) == 0 {
+		return errors.Errorf("task run %d not found", taskRunUID)
+	}
+	payload := taskRuns[0].Payload
+
+	committedCommandIndexes := map[int32]bool{}
+	persistedCommandIndexes := map[int32]bool{}
+	for _, index := range payload.GetCommittedCommandIndexes() {
+		committedCommandIndexes[index] = true
+		persistedCommandIndexes[index] = true
+	}
+	opts.CommittedCommandIndexes = committedCommandIndexes
+	opts.CommitCommands = func(commandIndexes []int32) error {
+		// The session-level statements are replayed on resume, so they may be committed more than once.
+		for _, index := range commandIndexes {
+			if !persistedCommandIndexes[index] {
+				persistedCommandIndexes[index] = true
+				payload.CommittedCommandIndexes = append(payload.CommittedCommandIndexes, index)
+			}
+		}
+		if err := stores.UpdateTaskRunPayload(ctx, taskRunUID, payload); err != nil {
+			return errors.Wrapf(err, "failed to update committed commands of task run %d", taskRunUID)
+		}
+		return nil
+	}
+	return nil
+}
+
 func postMigration(ctx context.Context, stores *store.Store, task *store.TaskMessage, mi *db.MigrationInfo, migrationID string, sheetID *int) (bool, *storepb.TaskRunResult, error) {
 	instance, err := stores.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 	if err != nil {
@@ -335,9 +415,23 @@ func runMigration(ctx context.Context, driverCtx context.Context, store *store.S
 		return true, nil, err
 	}
 
-	migrationID, _, err := executeMigration(ctx, driverCtx, store, dbFactory, stateCfg, profile, task, taskRunUID, statement, sheetID, mi)
+	migrationID, schema, err := executeMigration(ctx, driverCtx, store, dbFactory, stateCfg, profile, task, taskRunUID, statement, sheetID, mi)
 	if err != nil {
 		return true, nil, err
 	}
-	return postMigration(ctx, store, task, mi, migrationID, sheetID)
+	terminated, result, err = postMigration(ctx, store, task, mi, migrationID, sheetID)
+	if err != nil {
+		return terminated, result, err
+	}
+	if mi.Type != db.Data {
+		writeBackSchema(ctx, store, task, schema)
+	}
+	return terminated, result, nil
+}
+
+func getVCSReleaseVersion(vcsSource *storepb.PlanConfig_VCSSource) string {
+	if vcsSource.GetTag() == "" {
+		return vcsSource.GetCommitId()
+	}
+	return fmt.Sprintf("%s@%s", vcsSource.GetTag(), vcsSource.GetCommitId())
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/component/webhook"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -31,15 +32,17 @@ type SchedulerV2 struct {
 	store          *store.Store
 	stateCfg       *state.State
 	webhookManager *webhook.Manager
+	dbFactory      *dbfactory.DBFactory
 	executorMap    map[api.TaskType]Executor
 }
 
 // NewSchedulerV2 will create a new scheduler.
-func NewSchedulerV2(store *store.Store, stateCfg *state.State, webhookManager *webhook.Manager) *SchedulerV2 {
+func NewSchedulerV2(store *store.Store, stateCfg *state.State, webhookManager *webhook.Manager, dbFactory *dbfactory.DBFactory) *SchedulerV2 {
 	return &SchedulerV2{
 		store:          store,
 		stateCfg:       stateCfg,
 		webhookManager: webhookManager,
+		dbFactory:      dbFactory,
 		executorMap:    map[api.TaskType]Executor{},
 	}
 }
@@ -59,6 +62,10 @@ func (s *SchedulerV2) Register(taskType api.TaskType, executorGetter Executor) {
 func (s *SchedulerV2) Run(ctx context.Context, wg *sync.WaitGroup) {
 	go s.ListenTaskSkippedOrDone(ctx)
 
+	if err := s.recoverTransactionGroups(ctx); err != nil {
+		slog.Error("failed to recover transaction groups", log.BBError(err))
+	}
+
 	ticker := time.NewTicker(taskSchedulerInterval)
 	defer ticker.Stop()
 	defer wg.Done()
@@ -290,6 +297,13 @@ func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store
 			return nil
 		}
 	}
+	planDependenciesDone, err := s.planDependenciesDone(ctx, task)
+	if err != nil {
+		return errors.Wrapf(err, "failed to check plan dependencies")
+	}
+	if !planDependenciesDone {
+		return nil
+	}
 
 	if _, err := s.store.UpdateTaskRunStatus(ctx, &store.TaskRunStatusPatch{
 		ID:        taskRun.ID,
@@ -301,6 +315,37 @@ func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store
 	return nil
 }
 
+// planDependenciesDone returns true if the stages in the same environment of the rollouts of the plans
+// that the plan of the task depends on are done.
+func (s *SchedulerV2) planDependenciesDone(ctx context.Context, task *store.TaskMessage) (bool, error) {
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{PipelineID: &task.PipelineID})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get plan")
+	}
+	if plan == nil || len(plan.Config.GetDependsOnPlans()) == 0 {
+		return true, nil
+	}
+	stages, err := s.store.ListStageV2(ctx, task.PipelineID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list stages")
+	}
+	for _, stage := range stages {
+		if stage.ID != task.StageID {
+			continue
+		}
+		dependencies, err := utils.GetPlanDependencies(ctx, s.store, plan.Config.GetDependsOnPlans(), stage.EnvironmentID)
+		if err != nil {
+			return false, err
+		}
+		for _, dependency := range dependencies {
+			if !dependency.Done {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
 func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
 		Status: &[]api.TaskRunStatus{api.TaskRunRunning},
@@ -159,12 +159,17 @@ func cutover(ctx context.Context, taskContext context.Context, stores *store.Sto
 		return true, nil, err
 	}
 	defer driver.Close(ctx)
-	migrationID, _, err := utils.ExecuteMigrationWithFunc(ctx, ctx, stores, stateCfg, taskRunUID, driver, mi, statement, &sheetID, execFunc, db.ExecuteOptions{})
+	migrationID, schema, err := utils.ExecuteMigrationWithFunc(ctx, ctx, stores, stateCfg, taskRunUID, driver, mi, statement, &sheetID, execFunc, db.ExecuteOptions{})
 	if err != nil {
 		return true, nil, err
 	}
 
-	return postMigration(ctx, stores, task, mi, migrationID, &sheetID)
+	terminated, result, err = postMigration(ctx, stores, task, mi, migrationID, &sheetID)
+	if err != nil {
+		return terminated, result, err
+	}
+	writeBackSchema(ctx, stores, task, schema)
+	return terminated, result, nil
 }
 
 func waitForCutover(ctx context.Context, taskContext context.Context, migrationContext *base.MigrationContext) bool {
@@ -0,0 +1,112 @@
+package taskrun
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// writeBackSchema commits the schema of the database after the migration to the repositories of the VCS connectors
+// with schema write-back in the project of the database.
+// The write-back is best effort, and the errors are only logged without failing the task.
+func writeBackSchema(ctx context.Context, stores *store.Store, task *store.TaskMessage, schema string) {
+	if schema == "" || task.DatabaseID == nil {
+		return
+	}
+	database, err := stores.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		slog.Error("failed to get database for schema write-back", slog.Int("task_id", task.ID), log.BBError(err))
+		return
+	}
+	if database == nil {
+		return
+	}
+	vcsConnectors, err := stores.ListVCSConnectors(ctx, &store.FindVCSConnectorMessage{ProjectID: &database.ProjectID})
+	if err != nil {
+		slog.Error("failed to list VCS connectors for schema write-back", slog.String("project", database.ProjectID), log.BBError(err))
+		return
+	}
+	for _, vcsConnector := range vcsConnectors {
+		if vcsConnector.Payload.GetSchemaWriteBack().GetFilePathTemplate() == "" {
+			continue
+		}
+		if err := writeBackSchemaToVCS(ctx, stores, vcsConnector, database, schema); err != nil {
+			slog.Error("failed to write back schema to VCS",
+				slog.String("vcs_connector", vcsConnector.ResourceID),
+				slog.String("database", database.DatabaseName),
+				log.BBError(err),
+			)
+		}
+	}
+}
+
+func writeBackSchemaToVCS(ctx context.Context, stores *store.Store, vcsConnector *store.VCSConnectorMessage, database *store.DatabaseMessage, schema string) error {
+	writeBack := vcsConnector.Payload.SchemaWriteBack
+	vcsProvider, err := stores.GetVCSProvider(ctx, &store.FindVCSProviderMessage{ResourceID: &vcsConnector.VCSResourceID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get VCS provider %q", vcsConnector.VCSResourceID)
+	}
+	if vcsProvider == nil {
+		return errors.Errorf("VCS provider %q not found", vcsConnector.VCSResourceID)
+	}
+	filePath, err := vcs.RenderFilePathTemplate(writeBack.FilePathTemplate, vcsConnector.Payload.BaseDirectory, database.EffectiveEnvironmentID, database.DatabaseName)
+	if err != nil {
+		return err
+	}
+	branch := writeBack.Branch
+	if branch == "" {
+		branch = vcsConnector.Payload.Branch
+	}
+
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	repositoryID := vcsConnector.Payload.ExternalId
+	branchCreated := false
+	if _, err := provider.GetBranch(ctx, repositoryID, branch); err != nil {
+		if common.ErrorCode(err) != common.NotFound {
+			return errors.Wrapf(err, "failed to get branch %q", branch)
+		}
+		base, err := provider.GetBranch(ctx, repositoryID, vcsConnector.Payload.Branch)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get branch %q", vcsConnector.Payload.Branch)
+		}
+		if err := provider.CreateBranch(ctx, repositoryID, branch, base.LastCommitID); err != nil {
+			return errors.Wrapf(err, "failed to create branch %q", branch)
+		}
+		branchCreated = true
+	} else {
+		content, err := provider.ReadFileContent(ctx, repositoryID, filePath, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: branch})
+		if err != nil && common.ErrorCode(err) != common.NotFound {
+			slog.Warn("failed to read the schema file to write back", slog.String("file", filePath), log.BBError(err))
+		}
+		if err == nil && content == schema {
+			return nil
+		}
+	}
+
+	databaseName := common.FormatDatabase(database.InstanceID, database.DatabaseName)
+	message := fmt.Sprintf("Update schema of %s", databaseName)
+	if err := provider.CommitFile(ctx, repositoryID, branch, filePath, schema, message); err != nil {
+		return errors.Wrapf(err, "failed to commit file %q to branch %q", filePath, branch)
+	}
+
+	// The pull request is opened along with the branch, and the following schemas are committed to the open pull request.
+	if !writeBack.PullRequest || !branchCreated {
+		return nil
+	}
+	if _, err := provider.CreatePullRequest(ctx, repositoryID, &vcs.PullRequestCreate{
+		Title: fmt.Sprintf("[Bytebase] %s", message),
+		Body:  fmt.Sprintf("The schema of %s is updated by Bytebase after the migration.", databaseName),
+		Head:  branch,
+		Base:  vcsConnector.Payload.Branch,
+	}); err != nil && common.ErrorCode(err) != common.Conflict {
+		return errors.Wrapf(err, "failed to create pull request from branch %q", branch)
+	}
+	return nil
+}
@@ -0,0 +1,380 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// transactionGroupTimeout is the maximum duration for a task to wait for the other tasks in its transaction group.
+const transactionGroupTimeout = 10 * time.Minute
+
+type transactionalTaskPayload struct {
+	Skipped       bool   `json:"skipped,omitempty"`
+	SpecID        string `json:"specId,omitempty"`
+	Transactional bool   `json:"transactional,omitempty"`
+}
+
+// getTransactionGroupKey returns the key of the transaction group of the task, or empty if the task is not transactional.
+// The tasks created from the same spec in the same stage belong to the same transaction group.
+func getTransactionGroupKey(task *store.TaskMessage) (string, error) {
+	switch task.Type {
+	case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseDataUpdate:
+	default:
+		return "", nil
+	}
+	payload := &transactionalTaskPayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal task payload")
+	}
+	if !payload.Transactional {
+		return "", nil
+	}
+	return fmt.Sprintf("%d/%s", task.StageID, payload.SpecID), nil
+}
+
+// getTransactionGroupTasks returns the tasks in the transaction group which are not skipped.
+// The members of the group are the tasks created from the spec of the plan in the stage, so that the group size doesn't
+// depend on which task joins the group first.
+func getTransactionGroupTasks(ctx context.Context, stores *store.Store, task *store.TaskMessage, key string) ([]*store.TaskMessage, error) {
+	tasks, err := stores.ListTasks(ctx, &api.TaskFind{PipelineID: &task.PipelineID, StageID: &task.StageID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list tasks")
+	}
+	var members []*store.TaskMessage
+	for _, t := range tasks {
+		k, err := getTransactionGroupKey(t)
+		if err != nil {
+			return nil, err
+		}
+		if k != key {
+			continue
+		}
+		payload := &transactionalTaskPayload{}
+		if err := json.Unmarshal([]byte(t.Payload), payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal task payload")
+		}
+		if payload.Skipped {
+			continue
+		}
+		members = append(members, t)
+	}
+	return members, nil
+}
+
+// isTransactionGroupCommitted returns true if any task run in the transaction group has recorded the decision to commit.
+// The group decides to commit only after every member has prepared, so every member is committed or about to commit.
+// Only the task runs of the attempt of the group ID are checked if it's not empty.
+func isTransactionGroupCommitted(ctx context.Context, stores *store.Store, members []*store.TaskMessage, groupID string) (bool, error) {
+	for _, member := range members {
+		taskRuns, err := stores.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{TaskUID: &member.ID})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to list task runs of task %d", member.ID)
+		}
+		for _, taskRun := range taskRuns {
+			if groupID != "" && taskRun.Payload.GetTransactionGroupId() != groupID {
+				continue
+			}
+			if taskRun.Payload.GetTransactionCommitted() {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// recordTransactionGroup records the attempt of the transaction group in the task run before its transaction is prepared.
+func recordTransactionGroup(ctx context.Context, stores *store.Store, taskRunUID int, groupID string) error {
+	return updateTransactionPayload(ctx, stores, taskRunUID, func(payload *storepb.TaskRunPayload) {
+		payload.TransactionGroupId = groupID
+	})
+}
+
+// recordTransactionCommitted records the decision to commit in the task run before its prepared transaction is committed.
+func recordTransactionCommitted(ctx context.Context, stores *store.Store, taskRunUID int) error {
+	return updateTransactionPayload(ctx, stores, taskRunUID, func(payload *storepb.TaskRunPayload) {
+		payload.TransactionCommitted = true
+	})
+}
+
+func updateTransactionPayload(ctx context.Context, stores *store.Store, taskRunUID int, update func(*storepb.TaskRunPayload)) error {
+	taskRuns, err := stores.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{UID: &taskRunUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list task runs")
+	}
+	if len(taskRuns) == 0 {
+		return errors.Errorf("task run %d not found", taskRunUID)
+	}
+	payload := taskRuns[0].Payload
+	if payload == nil {
+		payload = &storepb.TaskRunPayload{}
+	}
+	update(payload)
+	return stores.UpdateTaskRunPayload(ctx, taskRunUID, payload)
+}
+
+func getTransactionXID(taskUID, taskRunUID int) string {
+	return fmt.Sprintf("bytebase_%d_%d", taskUID, taskRunUID)
+}
+
+// executeInTransactionGroup prepares the statement in an open transaction, and commits or rolls back the transaction
+// together with the other tasks in the transaction group.
+// PostgreSQL uses PREPARE TRANSACTION if max_prepared_transactions is positive, and MySQL uses XA transactions.
+func executeInTransactionGroup(ctx context.Context, driverCtx context.Context, stores *store.Store, group *state.TransactionGroup, engine storepb.Engine, driver db.Driver, taskRunUID int, xid string, statement string) error {
+	if err := recordTransactionGroup(ctx, stores, taskRunUID, group.ID); err != nil {
+		group.Fail()
+		return errors.Wrapf(err, "failed to record the transaction group")
+	}
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		group.Fail()
+		return errors.Wrapf(err, "failed to get connection")
+	}
+	defer conn.Close()
+
+	var owner string
+	if pgDriver, ok := driver.(*pg.Driver); ok {
+		if owner, err = pgDriver.GetCurrentDatabaseOwner(ctx); err != nil {
+			group.Fail()
+			return errors.Wrapf(err, "failed to get database owner")
+		}
+	}
+	prepared, err := prepareTransaction(driverCtx, conn, engine, owner, xid, statement)
+	if err != nil {
+		group.Fail()
+		return err
+	}
+
+	commit, waitErr := group.Prepare(driverCtx, transactionGroupTimeout)
+	if commit {
+		// The other members may have committed already, so the transaction is committed even if the decision cannot be recorded.
+		if err := recordTransactionCommitted(ctx, stores, taskRunUID); err != nil {
+			slog.Error("failed to record the commit decision of the transaction group", slog.String("xid", xid), log.BBError(err))
+		}
+	}
+	// Finish the transaction with the context which is not canceled when the task run is canceled.
+	if err := finishTransaction(ctx, conn, engine, xid, prepared, commit); err != nil {
+		return err
+	}
+	if !commit {
+		if waitErr != nil {
+			return errors.Wrapf(waitErr, "rolled back the transaction")
+		}
+		return errors.Errorf("rolled back the transaction because other tasks in the transaction group failed")
+	}
+	return nil
+}
+
+// prepareTransaction executes the statement in a transaction and prepares the transaction for two-phase commit if supported.
+// The transaction is rolled back if the statement fails.
+// For PostgreSQL, the statement is executed as the database owner so that the owner of created objects is the same as the database owner.
+func prepareTransaction(ctx context.Context, conn *sql.Conn, engine storepb.Engine, owner string, xid string, statement string) (bool, error) {
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		var value string
+		if err := conn.QueryRowContext(ctx, "SHOW max_prepared_transactions").Scan(&value); err != nil {
+			return false, errors.Wrapf(err, "failed to get max_prepared_transactions")
+		}
+		maxPreparedTransactions, err := strconv.Atoi(value)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to parse max_prepared_transactions %q", value)
+		}
+		if _, err := conn.ExecContext(ctx, "BEGIN"); err != nil {
+			return false, err
+		}
+		if owner != "" {
+			if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET LOCAL ROLE '%s'", owner)); err != nil {
+				rollbackTransaction(conn, "ROLLBACK")
+				return false, errors.Wrapf(err, "failed to set role to database owner %q", owner)
+			}
+		}
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			rollbackTransaction(conn, "ROLLBACK")
+			return false, err
+		}
+		if maxPreparedTransactions <= 0 {
+			// Keep the transaction open until the decision of the group.
+			return false, nil
+		}
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("PREPARE TRANSACTION '%s'", xid)); err != nil {
+			rollbackTransaction(conn, "ROLLBACK")
+			return false, err
+		}
+		return true, nil
+	case storepb.Engine_MYSQL:
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("XA START '%s'", xid)); err != nil {
+			return false, err
+		}
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			rollbackTransaction(conn, fmt.Sprintf("XA END '%s'", xid), fmt.Sprintf("XA ROLLBACK '%s'", xid))
+			return false, err
+		}
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("XA END '%s'", xid)); err != nil {
+			rollbackTransaction(conn, fmt.Sprintf("XA ROLLBACK '%s'", xid))
+			return false, err
+		}
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("XA PREPARE '%s'", xid)); err != nil {
+			rollbackTransaction(conn, fmt.Sprintf("XA ROLLBACK '%s'", xid))
+			return false, err
+		}
+		return true, nil
+	default:
+		return false, errors.Errorf("transactional change is not supported for engine %s", engine)
+	}
+}
+
+func finishTransaction(ctx context.Context, conn *sql.Conn, engine storepb.Engine, xid string, prepared bool, commit bool) error {
+	var statement string
+	switch {
+	case engine == storepb.Engine_MYSQL && commit:
+		statement = fmt.Sprintf("XA COMMIT '%s'", xid)
+	case engine == storepb.Engine_MYSQL:
+		statement = fmt.Sprintf("XA ROLLBACK '%s'", xid)
+	case prepared && commit:
+		statement = fmt.Sprintf("COMMIT PREPARED '%s'", xid)
+	case prepared:
+		statement = fmt.Sprintf("ROLLBACK PREPARED '%s'", xid)
+	case commit:
+		statement = "COMMIT"
+	default:
+		statement = "ROLLBACK"
+	}
+	if _, err := conn.ExecContext(ctx, statement); err != nil {
+		return errors.Wrapf(err, "failed to finish the transaction %q with %q", xid, statement)
+	}
+	return nil
+}
+
+func rollbackTransaction(conn *sql.Conn, statements ...string) {
+	// Use a new context because the context may have been canceled.
+	ctx := context.Background()
+	for _, statement := range statements {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			slog.Warn("failed to roll back the transaction", slog.String("statement", statement), log.BBError(err))
+		}
+	}
+}
+
+// recoverTransactionGroups resolves the transactions left prepared by the task runs interrupted by a restart.
+// The prepared transactions hold their locks until they are resolved, so they are committed if the attempt of the
+// transaction group has decided to commit, and rolled back otherwise. The interrupted task runs are re-run afterwards.
+func (s *SchedulerV2) recoverTransactionGroups(ctx context.Context) error {
+	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+		Status: &[]api.TaskRunStatus{api.TaskRunRunning},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list running task runs")
+	}
+	for _, taskRun := range taskRuns {
+		task, err := s.store.GetTaskV2ByID(ctx, taskRun.TaskUID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get task %d", taskRun.TaskUID)
+		}
+		key, err := getTransactionGroupKey(task)
+		if err != nil {
+			return err
+		}
+		if key == "" {
+			continue
+		}
+		members, err := getTransactionGroupTasks(ctx, s.store, task, key)
+		if err != nil {
+			return err
+		}
+		// The task run which has not recorded its attempt has not prepared its transaction.
+		commit := false
+		if groupID := taskRun.Payload.GetTransactionGroupId(); groupID != "" {
+			if commit, err = isTransactionGroupCommitted(ctx, s.store, members, groupID); err != nil {
+				return err
+			}
+		}
+		xid := getTransactionXID(task.ID, taskRun.ID)
+		if err := s.recoverTransaction(ctx, task, xid, commit); err != nil {
+			slog.Error("failed to recover the prepared transaction", slog.String("xid", xid), log.BBError(err))
+		}
+	}
+	return nil
+}
+
+func (s *SchedulerV2) recoverTransaction(ctx context.Context, task *store.TaskMessage, xid string, commit bool) error {
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return err
+	}
+	if instance == nil || instance.Deleted {
+		return nil
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return err
+	}
+	if database == nil {
+		return nil
+	}
+	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get driver connection for instance %q", instance.ResourceID)
+	}
+	defer driver.Close(ctx)
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get connection")
+	}
+	defer conn.Close()
+
+	prepared, err := isTransactionPrepared(ctx, conn, instance.Engine, xid)
+	if err != nil {
+		return err
+	}
+	if !prepared {
+		return nil
+	}
+	slog.Info("recover the prepared transaction", slog.String("xid", xid), slog.Bool("commit", commit))
+	return finishTransaction(ctx, conn, instance.Engine, xid, true /* prepared */, commit)
+}
+
+// isTransactionPrepared returns true if the transaction of the xid is prepared and waits to be committed or rolled back.
+func isTransactionPrepared(ctx context.Context, conn *sql.Conn, engine storepb.Engine, xid string) (bool, error) {
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		var count int
+		if err := conn.QueryRowContext(ctx, "SELECT COUNT(1) FROM pg_prepared_xacts WHERE gid = $1", xid).Scan(&count); err != nil {
+			return false, errors.Wrapf(err, "failed to list prepared transactions")
+		}
+		return count > 0, nil
+	case storepb.Engine_MYSQL:
+		rows, err := conn.QueryContext(ctx, "XA RECOVER")
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to list prepared XA transactions")
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var formatID, gtridLength, bqualLength int
+			var data string
+			if err := rows.Scan(&formatID, &gtridLength, &bqualLength, &data); err != nil {
+				return false, err
+			}
+			if data == xid {
+				return true, nil
+			}
+		}
+		return false, rows.Err()
+	default:
+		return false, nil
+	}
+}
@@ -47,6 +47,19 @@ func (s *Server) getInitSetting(ctx context.Context, datastore *store.Store) (st
 	// Set secret to the stored secret.
 	secret = authSetting.Value
 
+	// initial masking key
+	maskingKey, err := common.RandomString(secretLength)
+	if err != nil {
+		return "", 0, errors.Wrap(err, "failed to generate random masking key")
+	}
+	if _, _, err := datastore.CreateSettingIfNotExistV2(ctx, &store.SettingMessage{
+		Name:        api.SettingMaskingKey,
+		Value:       maskingKey,
+		Description: "Random string used as the key of the format-preserving and tokenization masking algorithms.",
+	}, api.SystemBotID); err != nil {
+		return "", 0, err
+	}
+
 	// initial workspace
 	if _, _, err := datastore.CreateSettingIfNotExistV2(ctx, &store.SettingMessage{
 		Name:        api.SettingWorkspaceID,
@@ -16,7 +16,11 @@ import (
 type AuditLogMethod string
 
 // The methods other than v1 api.
-const AuditLogMethodProjectRepositoryPush AuditLogMethod = "bb.project.repository.push"
+const (
+	AuditLogMethodProjectRepositoryPush AuditLogMethod = "bb.project.repository.push"
+	// AuditLogMethodEditorSessionClose is the method of closing the editor session on idle timeout or disconnection.
+	AuditLogMethodEditorSessionClose AuditLogMethod = "bb.sql.editor-session.close"
+)
 
 func (m AuditLogMethod) String() string {
 	return string(m)
@@ -88,6 +88,7 @@ func (s *Store) ListExportArchives(ctx context.Context, find *FindExportArchiveM
 		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, exportArchivePayload); err != nil {
 			return nil, err
 		}
+		exportArchive.CreatedTime = time.Unix(createdTs, 0)
 		exportArchive.Payload = exportArchivePayload
 		exportArchive.Bytes = bytes
 		exportArchives = append(exportArchives, &exportArchive)
@@ -141,7 +142,75 @@ func (s *Store) CreateExportArchive(ctx context.Context, create *ExportArchiveMe
 	return create, nil
 }
 
-// DeleteExportArchive deletes a export archive.
+// UpdateExportArchivePayload updates the payload of the export archive.
+func (s *Store) UpdateExportArchivePayload(ctx context.Context, uid int, payload *storepb.ExportArchivePayload) error {
+	payloadBytes, err := protojson.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `UPDATE export_archive SET payload = $1 WHERE id = $2;`, payloadBytes, uid); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// CreateExportArchiveChunk creates a chunk of the export archive.
+func (s *Store) CreateExportArchiveChunk(ctx context.Context, archiveUID int, chunk int, bytes []byte) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO export_archive_chunk (
+			archive_id,
+			chunk,
+			bytes
+		)
+		VALUES ($1, $2, $3);
+	`, archiveUID, chunk, bytes); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetExportArchiveChunk gets a chunk of the export archive.
+func (s *Store) GetExportArchiveChunk(ctx context.Context, archiveUID int, chunk int) ([]byte, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var bytes []byte
+	if err := tx.QueryRowContext(ctx, `
+		SELECT bytes
+		FROM export_archive_chunk
+		WHERE archive_id = $1 AND chunk = $2;
+	`, archiveUID, chunk).Scan(&bytes); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("chunk %d of export archive %d not found", chunk, archiveUID)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return bytes, nil
+}
+
+// DeleteExportArchive deletes a export archive and its chunks.
 func (s *Store) DeleteExportArchive(ctx context.Context, uid int) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -155,3 +224,27 @@ func (s *Store) DeleteExportArchive(ctx context.Context, uid int) error {
 
 	return tx.Commit()
 }
+
+// DeleteExpiredExportArchives deletes the export archives created before the time and their chunks,
+// including the archives whose chunks are left by the interrupted exports, and returns the number of the archives deleted.
+func (s *Store) DeleteExpiredExportArchives(ctx context.Context, before time.Time) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM export_archive WHERE created_ts < $1;`, before.Unix())
+	if err != nil {
+		return 0, err
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -87,6 +87,7 @@ type UpdateInstanceChangeHistoryMessage struct {
 	Schema              *string
 	SchemaPrev          *string
 	Sheet               *int
+	RollbackStatement   *string
 }
 
 // CreateInstanceChangeHistoryForMigrator creates an instance change history for migrator.
@@ -701,6 +702,9 @@ func (s *Store) UpdateInstanceChangeHistory(ctx context.Context, update *UpdateI
 	if v := update.Sheet; v != nil {
 		set, args = append(set, fmt.Sprintf("sheet_id = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := update.RollbackStatement; v != nil {
+		set, args = append(set, fmt.Sprintf("payload = payload || jsonb_build_object('rollbackStatement', $%d::TEXT)", len(args)+1)), append(args, *v)
+	}
 	if len(set) == 0 {
 		return nil
 	}
@@ -41,6 +41,8 @@ type FindPlanMessage struct {
 	PipelineID      *int
 	CreatedTsBefore *int64
 	CreatedTsAfter  *int64
+	// PullRequestURL is the URL of the pull request that the plan is created from.
+	PullRequestURL *string
 
 	Limit  *int
 	Offset *int
@@ -155,6 +157,9 @@ func (s *Store) ListPlans(ctx context.Context, find *FindPlanMessage) ([]*PlanMe
 	if v := find.CreatedTsAfter; v != nil {
 		where, args = append(where, fmt.Sprintf("plan.created_ts > $%d", len(args)+1)), append(args, *v)
 	}
+	if v := find.PullRequestURL; v != nil {
+		where, args = append(where, fmt.Sprintf("plan.config->'vcsSource'->>'pullRequestUrl' = $%d", len(args)+1)), append(args, *v)
+	}
 	if v := find.NoIssue; v {
 		where = append(where, "issue.id IS NULL")
 	}
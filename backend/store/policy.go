@@ -182,6 +182,28 @@ func (s *Store) GetMaskingRulePolicy(ctx context.Context) (*storepb.MaskingRuleP
 	return p, nil
 }
 
+// GetQueryCostGuardPolicy will get the query cost guard policy.
+func (s *Store) GetQueryCostGuardPolicy(ctx context.Context) (*storepb.QueryCostGuardPolicy, error) {
+	pType := api.PolicyTypeQueryCostGuard
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		Type: &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil {
+		return &storepb.QueryCostGuardPolicy{}, nil
+	}
+
+	p := new(storepb.QueryCostGuardPolicy)
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
 // GetMaskingPolicyByDatabaseUID gets the masking policy for a database.
 func (s *Store) GetMaskingPolicyByDatabaseUID(ctx context.Context, databaseUID int) (*storepb.MaskingPolicy, error) {
 	resourceType := api.PolicyResourceTypeDatabase
@@ -232,6 +254,31 @@ func (s *Store) GetMaskingExceptionPolicyByProjectUID(ctx context.Context, proje
 	return p, nil
 }
 
+// GetRowFilterPolicyByProjectUID gets the row filter policy for a project.
+func (s *Store) GetRowFilterPolicyByProjectUID(ctx context.Context, projectUID int) (*storepb.RowFilterPolicy, error) {
+	resourceType := api.PolicyResourceTypeProject
+	pType := api.PolicyTypeRowFilter
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &projectUID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil {
+		return &storepb.RowFilterPolicy{}, nil
+	}
+
+	p := new(storepb.RowFilterPolicy)
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
 // PolicyMessage is the mssage for policy.
 type PolicyMessage struct {
 	ResourceUID       int
@@ -2,7 +2,9 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,6 +16,10 @@ import (
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
+// queryHistoryStatementSearchLength is the number of the leading characters of the statements searched by the full-text index.
+// It must be the same as the one of the index idx_query_history_statement_tsvector, so that the index is used.
+const queryHistoryStatementSearchLength = 100000
+
 type QueryHistoryType string
 
 const (
@@ -40,6 +46,8 @@ type QueryHistoryMessage struct {
 	Payload   *storepb.QueryHistoryPayload
 	// Database is the database resource name, like instances/{instance}/databases/{database}
 	Database string
+	// Fingerprint is the normalized template of the statement.
+	Fingerprint string
 
 	createdTs int64
 }
@@ -53,11 +61,39 @@ type FindQueryHistoryMessage struct {
 	// Database is database resource name like instances/{instance}/databases/{database}.
 	Database *string
 	Type     *QueryHistoryType
+	// Statement is the full-text search query on the statement in the web search syntax.
+	Statement       *string
+	Fingerprint     *string
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
 
 	Limit  *int
 	Offset *int
 }
 
+// QueryHistoryAggregateMessage is the statistics of the query histories with the same fingerprint.
+type QueryHistoryAggregateMessage struct {
+	Fingerprint     string
+	SampleStatement string
+	Count           int64
+	ErrorCount      int64
+	P50Duration     time.Duration
+	P95Duration     time.Duration
+	CreatorUIDs     []int
+	// Tables are the distinct tables read by the queries in the ascending order.
+	Tables        []string
+	LastQueryTime time.Time
+}
+
+// PurgeQueryHistoryMessage is the message to delete the query histories created before the time.
+type PurgeQueryHistoryMessage struct {
+	CreatedTsBefore int64
+	// ProjectID is the project of the query histories to delete, all projects if nil.
+	ProjectID *string
+	// ExcludedProjectIDs are the projects of the query histories to keep.
+	ExcludedProjectIDs []string
+}
+
 // CreateQueryHistory creates the query history.
 func (s *Store) CreateQueryHistory(ctx context.Context, create *QueryHistoryMessage) (*QueryHistoryMessage, error) {
 	payload, err := protojson.Marshal(create.Payload)
@@ -78,9 +114,10 @@ func (s *Store) CreateQueryHistory(ctx context.Context, create *QueryHistoryMess
 			database,
 			statement,
 			type,
-			payload
+			payload,
+			fingerprint
 		)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING
 			id,
 			created_ts
@@ -91,6 +128,7 @@ func (s *Store) CreateQueryHistory(ctx context.Context, create *QueryHistoryMess
 		create.Statement,
 		create.Type,
 		payload,
+		create.Fingerprint,
 	).Scan(
 		&create.UID,
 		&create.createdTs,
@@ -108,22 +146,7 @@ func (s *Store) CreateQueryHistory(ctx context.Context, create *QueryHistoryMess
 
 // ListQueryHistories lists the query history.
 func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMessage) ([]*QueryHistoryMessage, error) {
-	where, args := []string{"TRUE"}, []any{}
-
-	if v := find.CreatorUID; v != nil {
-		where, args = append(where, fmt.Sprintf("query_history.creator_id = $%d", len(args)+1)), append(args, *v)
-	}
-	if v := find.ProjectID; v != nil {
-		where, args = append(where, fmt.Sprintf("query_history.project_id = $%d", len(args)+1)), append(args, *v)
-	}
-	if v := find.Instance; v != nil {
-		where, args = append(where, fmt.Sprintf("query_history.database LIKE $%d", len(args)+1)), append(args, *v)
-	} else if v := find.Database; v != nil {
-		where, args = append(where, fmt.Sprintf("query_history.database = $%d", len(args)+1)), append(args, *v)
-	}
-	if v := find.Type; v != nil {
-		where, args = append(where, fmt.Sprintf("query_history.type = $%d", len(args)+1)), append(args, *v)
-	}
+	where, args := getQueryHistoryWhere(find)
 
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -140,7 +163,8 @@ func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMe
 			query_history.database,
 			query_history.statement,
 			query_history.type,
-			query_history.payload
+			query_history.payload,
+			query_history.fingerprint
 		FROM query_history
 		WHERE %s
 		ORDER BY created_ts DESC
@@ -171,6 +195,7 @@ func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMe
 			&queryHistory.Statement,
 			&queryHistory.Type,
 			&payloadStr,
+			&queryHistory.Fingerprint,
 		); err != nil {
 			return nil, err
 		}
@@ -194,3 +219,146 @@ func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMe
 
 	return queryHistories, nil
 }
+
+// AggregateQueryHistories aggregates the query histories by the fingerprints, which are ordered by the count descending.
+// The query histories without fingerprints are skipped.
+func (s *Store) AggregateQueryHistories(ctx context.Context, find *FindQueryHistoryMessage) ([]*QueryHistoryAggregateMessage, error) {
+	where, args := getQueryHistoryWhere(find)
+	where = append(where, "query_history.fingerprint != ''")
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// The duration is formatted as seconds with the suffix "s" in the protojson payload, e.g. "1.5s".
+	query := fmt.Sprintf(`
+		WITH h AS (
+			SELECT
+				query_history.creator_id,
+				query_history.created_ts,
+				query_history.statement,
+				query_history.fingerprint,
+				query_history.payload->>'error' IS NOT NULL AS failed,
+				COALESCE(NULLIF(rtrim(query_history.payload->>'duration', 's'), '')::DOUBLE PRECISION, 0) AS duration,
+				COALESCE(query_history.payload->'tables', '[]'::JSONB) AS tables
+			FROM query_history
+			WHERE %s
+		)
+		SELECT
+			fingerprint,
+			(array_agg(statement ORDER BY created_ts DESC))[1],
+			COUNT(*),
+			COUNT(*) FILTER (WHERE failed),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY duration),
+			percentile_cont(0.95) WITHIN GROUP (ORDER BY duration),
+			jsonb_agg(DISTINCT creator_id),
+			jsonb_agg(tables),
+			MAX(created_ts)
+		FROM h
+		GROUP BY fingerprint
+		ORDER BY COUNT(*) DESC, fingerprint
+	`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+
+	var aggregates []*QueryHistoryAggregateMessage
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		aggregate := &QueryHistoryAggregateMessage{}
+		var p50, p95 float64
+		var creatorUIDs, tables []byte
+		var lastQueryTs int64
+		if err := rows.Scan(
+			&aggregate.Fingerprint,
+			&aggregate.SampleStatement,
+			&aggregate.Count,
+			&aggregate.ErrorCount,
+			&p50,
+			&p95,
+			&creatorUIDs,
+			&tables,
+			&lastQueryTs,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(creatorUIDs, &aggregate.CreatorUIDs); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal creator ids")
+		}
+		var tableLists [][]string
+		if err := json.Unmarshal(tables, &tableLists); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal tables")
+		}
+		for _, list := range tableLists {
+			aggregate.Tables = append(aggregate.Tables, list...)
+		}
+		slices.Sort(aggregate.Tables)
+		aggregate.Tables = slices.Compact(aggregate.Tables)
+		aggregate.P50Duration = time.Duration(p50 * float64(time.Second))
+		aggregate.P95Duration = time.Duration(p95 * float64(time.Second))
+		aggregate.LastQueryTime = time.Unix(lastQueryTs, 0)
+		aggregates = append(aggregates, aggregate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return aggregates, nil
+}
+
+// PurgeQueryHistories deletes the query histories created before the time.
+func (s *Store) PurgeQueryHistories(ctx context.Context, purge *PurgeQueryHistoryMessage) (int64, error) {
+	where, args := []string{"created_ts < $1"}, []any{purge.CreatedTsBefore}
+	if v := purge.ProjectID; v != nil {
+		where, args = append(where, fmt.Sprintf("project_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := purge.ExcludedProjectIDs; len(v) > 0 {
+		where, args = append(where, fmt.Sprintf("project_id != ALL($%d)", len(args)+1)), append(args, v)
+	}
+	result, err := s.db.db.ExecContext(ctx, fmt.Sprintf(`DELETE FROM query_history WHERE %s`, strings.Join(where, " AND ")), args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func getQueryHistoryWhere(find *FindQueryHistoryMessage) ([]string, []any) {
+	where, args := []string{"TRUE"}, []any{}
+
+	if v := find.CreatorUID; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.creator_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.ProjectID; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.project_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Instance; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.database LIKE $%d", len(args)+1)), append(args, *v)
+	} else if v := find.Database; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.database = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Type; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.type = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Statement; v != nil {
+		where, args = append(where, fmt.Sprintf("to_tsvector('simple', left(query_history.statement, %d)) @@ websearch_to_tsquery('simple', $%d)", queryHistoryStatementSearchLength, len(args)+1)), append(args, *v)
+	}
+	if v := find.Fingerprint; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.fingerprint = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.created_ts >= $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.created_ts <= $%d", len(args)+1)), append(args, *v)
+	}
+	return where, args
+}
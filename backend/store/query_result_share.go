@@ -0,0 +1,134 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// QueryResultShareMessage is the message for the snapshot of a shared query result.
+type QueryResultShareMessage struct {
+	// ResourceID is the random id in the link of the share.
+	ResourceID string
+	CreatorUID int
+	// Database is the database resource name, like instances/{instance}/databases/{database}.
+	Database   string
+	Statement  string
+	ExpireTime time.Time
+	// Results is the serialized masked query results.
+	Results []byte
+	// RowFilterStatement is the statement rewritten with the row filters of the creator, empty if no row filter is applied.
+	RowFilterStatement string
+
+	// Output only fields
+	CreatedTime time.Time
+}
+
+// CreateQueryResultShare creates the snapshot of a shared query result.
+func (s *Store) CreateQueryResultShare(ctx context.Context, create *QueryResultShareMessage) (*QueryResultShareMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var createdTs int64
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO query_result_share (
+			id,
+			creator_id,
+			expire_ts,
+			database,
+			statement,
+			results,
+			row_filter_statement
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_ts
+	`,
+		create.ResourceID,
+		create.CreatorUID,
+		create.ExpireTime.Unix(),
+		create.Database,
+		create.Statement,
+		create.Results,
+		create.RowFilterStatement,
+	).Scan(&createdTs); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	create.CreatedTime = time.Unix(createdTs, 0)
+	return create, nil
+}
+
+// GetQueryResultShare gets the snapshot of a shared query result, nil if it's not found or expired.
+func (s *Store) GetQueryResultShare(ctx context.Context, resourceID string) (*QueryResultShareMessage, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	share := QueryResultShareMessage{ResourceID: resourceID}
+	var createdTs, expireTs int64
+	if err := tx.QueryRowContext(ctx, `
+		SELECT
+			creator_id,
+			created_ts,
+			expire_ts,
+			database,
+			statement,
+			results,
+			row_filter_statement
+		FROM query_result_share
+		WHERE id = $1 AND expire_ts > extract(epoch from now())
+	`, resourceID).Scan(
+		&share.CreatorUID,
+		&createdTs,
+		&expireTs,
+		&share.Database,
+		&share.Statement,
+		&share.Results,
+		&share.RowFilterStatement,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get query result share %q", resourceID)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	share.CreatedTime = time.Unix(createdTs, 0)
+	share.ExpireTime = time.Unix(expireTs, 0)
+	return &share, nil
+}
+
+// DeleteExpiredQueryResultShares deletes the snapshots of the shared query results which are expired.
+func (s *Store) DeleteExpiredQueryResultShares(ctx context.Context) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM query_result_share WHERE expire_ts <= extract(epoch from now())`)
+	if err != nil {
+		return 0, err
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
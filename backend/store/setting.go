@@ -90,6 +90,21 @@ func (s *Store) GetWorkspaceID(ctx context.Context) (string, error) {
 	return setting.Value, nil
 }
 
+// GetMaskingKey gets the workspace masking key.
+func (s *Store) GetMaskingKey(ctx context.Context) (string, error) {
+	settingName := api.SettingMaskingKey
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return "", errors.Errorf("cannot find setting %v", settingName)
+	}
+	return setting.Value, nil
+}
+
 // GetWorkspaceApprovalSetting gets the workspace approval setting.
 func (s *Store) GetWorkspaceApprovalSetting(ctx context.Context) (*storepb.WorkspaceApprovalSetting, error) {
 	settingName := api.SettingWorkspaceApproval
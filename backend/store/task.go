@@ -311,6 +311,17 @@ func (s *Store) UpdateTaskV2(ctx context.Context, patch *api.TaskPatch) (*TaskMe
 		}
 		payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('preUpdateBackupDetail', $%d::JSONB)`, len(args)+1)), append(args, jsonb)
 	}
+	if v := patch.BatchDetail; v != nil {
+		var batchDetail *api.BatchDetail
+		if v.BatchSize > 0 {
+			batchDetail = v
+		}
+		jsonb, err := json.Marshal(batchDetail)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal batchDetail")
+		}
+		payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('batchDetail', $%d::JSONB)`, len(args)+1)), append(args, jsonb)
+	}
 	if v := patch.Flags; v != nil {
 		jsonb, err := json.Marshal(v)
 		if err != nil {
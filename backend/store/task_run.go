@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -26,6 +27,7 @@ type TaskRunMessage struct {
 	Result      string
 	ResultProto *storepb.TaskRunResult
 	SheetUID    *int
+	Payload     *storepb.TaskRunPayload
 
 	// Output only.
 	ID        int
@@ -113,6 +115,8 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 			task_run.started_ts,
 			task_run.code,
 			task_run.result,
+			task_run.payload,
+			task_run.sheet_id,
 			task.pipeline_id,
 			task.stage_id,
 			project.resource_id
@@ -132,6 +136,7 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 	var taskRuns []*TaskRunMessage
 	for rows.Next() {
 		var taskRun TaskRunMessage
+		var payload []byte
 		if err := rows.Scan(
 			&taskRun.ID,
 			&taskRun.CreatorID,
@@ -144,6 +149,8 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 			&taskRun.StartedTs,
 			&taskRun.Code,
 			&taskRun.Result,
+			&payload,
+			&taskRun.SheetUID,
 			&taskRun.PipelineUID,
 			&taskRun.StageUID,
 			&taskRun.ProjectID,
@@ -157,6 +164,12 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 		}
 		taskRun.ResultProto = &resultProto
 
+		taskRunPayload := &storepb.TaskRunPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, taskRunPayload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal task run payload")
+		}
+		taskRun.Payload = taskRunPayload
+
 		taskRuns = append(taskRuns, &taskRun)
 	}
 	if err := rows.Err(); err != nil {
@@ -179,6 +192,22 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 	return taskRuns, nil
 }
 
+// UpdateTaskRunPayload updates the payload of a task run.
+func (s *Store) UpdateTaskRunPayload(ctx context.Context, taskRunUID int, payload *storepb.TaskRunPayload) error {
+	payloadBytes, err := protojson.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal task run payload")
+	}
+	query := `
+		UPDATE task_run
+		SET payload = $1
+		WHERE id = $2`
+	if _, err := s.db.db.ExecContext(ctx, query, payloadBytes, taskRunUID); err != nil {
+		return errors.Wrapf(err, "failed to update task run payload")
+	}
+	return nil
+}
+
 // UpdateTaskRunStatus updates task run status.
 func (s *Store) UpdateTaskRunStatus(ctx context.Context, patch *TaskRunStatusPatch) (*TaskRunMessage, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
@@ -315,9 +344,18 @@ func (*Store) createTaskRunImpl(ctx context.Context, tx *Tx, create *TaskRunMess
 			sheet_id,
 			attempt,
 			name,
-			status
-		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+			status,
+			payload
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
+	payload := create.Payload
+	if payload == nil {
+		payload = &storepb.TaskRunPayload{}
+	}
+	payloadBytes, err := protojson.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal task run payload")
+	}
 	if _, err := tx.ExecContext(ctx, query,
 		creatorID,
 		creatorID,
@@ -326,6 +364,7 @@ func (*Store) createTaskRunImpl(ctx context.Context, tx *Tx, create *TaskRunMess
 		attempt,
 		create.Name,
 		status,
+		payloadBytes,
 	); err != nil {
 		return err
 	}
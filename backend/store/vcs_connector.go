@@ -46,9 +46,17 @@ type UpdateVCSConnectorMessage struct {
 	UID       int
 
 	// Domain specific fields
-	Branch        *string
-	BaseDirectory *string
-	DatabaseGroup *string
+	Branch              *string
+	BaseDirectory       *string
+	DatabaseGroup       *string
+	FilePathTemplate    *string
+	Declarative         *bool
+	PushTrigger         *bool
+	TagPattern          *string
+	LastReleaseCommitID *string
+	SchemaWriteBack     *storepb.VCSConnector_SchemaWriteBack
+	LastPolledCommitID  *string
+	MigrationFormat     *storepb.VCSConnector_MigrationFormat
 }
 
 // GetVCSConnector gets a VCS connector.
@@ -206,6 +214,34 @@ func (s *Store) UpdateVCSConnector(ctx context.Context, update *UpdateVCSConnect
 	if v := update.DatabaseGroup; v != nil {
 		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('databaseGroup', $%d::TEXT)", len(args)+1)), append(args, *v)
 	}
+	if v := update.FilePathTemplate; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('filePathTemplate', $%d::TEXT)", len(args)+1)), append(args, *v)
+	}
+	if v := update.Declarative; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('declarative', $%d::BOOLEAN)", len(args)+1)), append(args, *v)
+	}
+	if v := update.PushTrigger; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('pushTrigger', $%d::BOOLEAN)", len(args)+1)), append(args, *v)
+	}
+	if v := update.TagPattern; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('tagPattern', $%d::TEXT)", len(args)+1)), append(args, *v)
+	}
+	if v := update.LastReleaseCommitID; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('lastReleaseCommitId', $%d::TEXT)", len(args)+1)), append(args, *v)
+	}
+	if v := update.SchemaWriteBack; v != nil {
+		schemaWriteBack, err := protojson.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal schema write-back")
+		}
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('schemaWriteBack', $%d::JSONB)", len(args)+1)), append(args, schemaWriteBack)
+	}
+	if v := update.LastPolledCommitID; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('lastPolledCommitId', $%d::TEXT)", len(args)+1)), append(args, *v)
+	}
+	if v := update.MigrationFormat; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('migrationFormat', $%d::TEXT)", len(args)+1)), append(args, v.String())
+	}
 	if len(payloadSet) != 0 {
 		set = append(set, fmt.Sprintf(`payload = payload || %s`, strings.Join(payloadSet, "||")))
 	}
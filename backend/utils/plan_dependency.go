@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// PlanDependency is the dependency of a stage on the stage in the same environment of the rollout of another plan.
+type PlanDependency struct {
+	Plan *store.PlanMessage
+	// Stage is nil if the plan has no rollout or the rollout has no stage in the environment.
+	Stage *store.StageMessage
+	// Done is true if the stage is done, or the plan has a rollout without stage in the environment.
+	Done bool
+}
+
+// GetPlanDependencies gets the dependencies of the stage in the environment on the plans.
+// The plans are in the format of projects/{project}/plans/{plan}.
+func GetPlanDependencies(ctx context.Context, s *store.Store, dependsOnPlans []string, environmentID int) ([]*PlanDependency, error) {
+	var dependencies []*PlanDependency
+	for _, name := range dependsOnPlans {
+		_, planUID, err := common.GetProjectIDPlanID(name)
+		if err != nil {
+			return nil, err
+		}
+		plan, err := s.GetPlan(ctx, &store.FindPlanMessage{UID: &planUID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get plan %q", name)
+		}
+		if plan == nil {
+			return nil, errors.Errorf("plan %q not found", name)
+		}
+		dependency := &PlanDependency{Plan: plan}
+		dependencies = append(dependencies, dependency)
+		if plan.PipelineUID == nil {
+			continue
+		}
+
+		stages, err := s.ListStageV2(ctx, *plan.PipelineUID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list stages of plan %q", name)
+		}
+		for _, stage := range stages {
+			if stage.EnvironmentID == environmentID {
+				dependency.Stage = stage
+				break
+			}
+		}
+		if dependency.Stage == nil {
+			dependency.Done = true
+			continue
+		}
+		tasks, err := s.ListTasks(ctx, &api.TaskFind{PipelineID: plan.PipelineUID, StageID: &dependency.Stage.ID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list tasks of plan %q", name)
+		}
+		done := true
+		for _, task := range tasks {
+			skipped, err := GetTaskSkipped(task)
+			if err != nil {
+				return nil, err
+			}
+			if !skipped && task.LatestTaskRunStatus != api.TaskRunDone {
+				done = false
+				break
+			}
+		}
+		dependency.Done = done
+	}
+	return dependencies, nil
+}
@@ -33,6 +33,7 @@ import (
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/app/relay"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -266,7 +267,11 @@ func ExecuteMigrationWithFunc(ctx context.Context, driverCtx context.Context, s
 	startedNs := time.Now().UnixNano()
 
 	defer func() {
-		if err := EndMigration(ctx, s, startedNs, insertedID, updatedSchema, prevSchemaBuf.String(), sheetID, resErr == nil /* isDone */); err != nil {
+		var rollbackStatement string
+		if resErr == nil {
+			rollbackStatement = GenerateRollbackStatement(driver.GetType(), m.Type, prevSchemaBuf.String(), updatedSchema)
+		}
+		if err := EndMigration(ctx, s, startedNs, insertedID, updatedSchema, prevSchemaBuf.String(), rollbackStatement, sheetID, resErr == nil /* isDone */); err != nil {
 			slog.Error("Failed to update migration history record",
 				log.BBError(err),
 				slog.String("migration_id", migrationHistoryID),
@@ -376,7 +381,7 @@ func BeginMigration(ctx context.Context, stores *store.Store, m *db.MigrationInf
 }
 
 // EndMigration updates the migration history record to DONE or FAILED depending on migration is done or not.
-func EndMigration(ctx context.Context, storeInstance *store.Store, startedNs int64, insertedID string, updatedSchema, schemaPrev string, sheetID *int, isDone bool) error {
+func EndMigration(ctx context.Context, storeInstance *store.Store, startedNs int64, insertedID string, updatedSchema, schemaPrev, rollbackStatement string, sheetID *int, isDone bool) error {
 	migrationDurationNs := time.Now().UnixNano() - startedNs
 	update := &store.UpdateInstanceChangeHistoryMessage{
 		ID:                  insertedID,
@@ -391,6 +396,7 @@ func EndMigration(ctx context.Context, storeInstance *store.Store, startedNs int
 		status := db.Done
 		update.Status = &status
 		update.Schema = &updatedSchema
+		update.RollbackStatement = &rollbackStatement
 	} else {
 		// Otherwise, update the migration history as 'FAILED', execution_duration.
 		status := db.Failed
@@ -399,6 +405,81 @@ func EndMigration(ctx context.Context, storeInstance *store.Store, startedNs int
 	return storeInstance.UpdateInstanceChangeHistory(ctx, update)
 }
 
+// GenerateRollbackStatement generates the statement to roll back the schema change by diffing the schema after the change
+// against the schema before the change. It returns an empty string if the change is not a schema change or the engine
+// doesn't support schema diff.
+func GenerateRollbackStatement(engine storepb.Engine, migrationType db.MigrationType, prevSchema, updatedSchema string) string {
+	switch migrationType {
+	case db.Migrate, db.MigrateSDL:
+	default:
+		return ""
+	}
+	if prevSchema == updatedSchema {
+		return ""
+	}
+	switch engine {
+	case storepb.Engine_POSTGRES:
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
+		engine = storepb.Engine_MYSQL
+	case storepb.Engine_TIDB:
+	case storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE:
+		engine = storepb.Engine_ORACLE
+	case storepb.Engine_MSSQL:
+	default:
+		return ""
+	}
+	rollbackStatement, err := base.SchemaDiff(engine, base.DiffContext{
+		IgnoreCaseSensitive: false,
+		StrictMode:          true,
+	}, updatedSchema, prevSchema)
+	if err != nil {
+		slog.Warn("failed to generate rollback statement", slog.String("engine", engine.String()), log.BBError(err))
+		return ""
+	}
+	return rollbackStatement
+}
+
+// GenerateRollbackStatementPreview generates the statement to roll back the schema change before the change is applied.
+// The schema after the change is the declarative schema for SDL changes, or the current schema with the statement appended
+// if the statement only creates new objects. Otherwise, it returns an empty string and the rollback statement is generated
+// after the change is applied.
+func GenerateRollbackStatementPreview(engine storepb.Engine, migrationType db.MigrationType, currentSchema, statement string) string {
+	var updatedSchema string
+	switch migrationType {
+	case db.MigrateSDL:
+		updatedSchema = statement
+	case db.Migrate:
+		if !isCreateOnlyStatement(engine, statement) {
+			return ""
+		}
+		updatedSchema = currentSchema + "\n" + statement
+	default:
+		return ""
+	}
+	return GenerateRollbackStatement(engine, migrationType, currentSchema, updatedSchema)
+}
+
+// isCreateOnlyStatement returns true if the statement only creates new objects, so that the schema after the change can be
+// derived by appending the statement to the current schema.
+func isCreateOnlyStatement(engine storepb.Engine, statement string) bool {
+	singleSQLs, err := base.SplitMultiSQL(engine, statement)
+	if err != nil {
+		return false
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return false
+	}
+	for _, singleSQL := range singleSQLs {
+		fields := strings.Fields(strings.ToUpper(singleSQL.Text))
+		// CREATE OR REPLACE changes the existing objects.
+		if len(fields) < 2 || fields[0] != "CREATE" || fields[1] == "OR" || fields[1] == "DATABASE" || fields[1] == "SCHEMA" {
+			return false
+		}
+	}
+	return true
+}
+
 // FindNextPendingStep finds the next pending step in the approval flow.
 func FindNextPendingStep(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) *storepb.ApprovalStep {
 	// We can do the finding like this for now because we are presuming that
@@ -542,6 +623,10 @@ func handleApprovalNodeExternalNode(ctx context.Context, s *store.Store, relayCl
 
 // UpdateProjectPolicyFromGrantIssue updates the project policy from grant issue.
 func UpdateProjectPolicyFromGrantIssue(ctx context.Context, stores *store.Store, issue *store.IssueMessage, grantRequest *storepb.GrantRequest) error {
+	// The approval of a query is recorded in the issue, and no role is granted.
+	if grantRequest.QueryDigest != "" {
+		return nil
+	}
 	policy, err := stores.GetProjectIamPolicy(ctx, issue.Project.UID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get project policy for project %q", issue.Project.UID)
@@ -6,6 +6,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	// Register the MySQL schema differ.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
@@ -466,3 +470,35 @@ func TestConvertBytesToUTF8String(t *testing.T) {
 		assert.Equal(t, test.expected, actual)
 	}
 }
+
+func TestGenerateRollbackStatement(t *testing.T) {
+	a := require.New(t)
+	prevSchema := "CREATE TABLE `t1` (\n  `id` int NOT NULL\n);\n"
+	updatedSchema := prevSchema + "CREATE TABLE `t2` (\n  `id` int NOT NULL\n);\n"
+
+	rollbackStatement := GenerateRollbackStatement(storepb.Engine_MYSQL, db.Migrate, prevSchema, updatedSchema)
+	a.Contains(rollbackStatement, "DROP TABLE")
+	a.Contains(rollbackStatement, "`t2`")
+	a.NotContains(rollbackStatement, "`t1`")
+
+	a.Empty(GenerateRollbackStatement(storepb.Engine_MYSQL, db.Data, prevSchema, updatedSchema))
+	a.Empty(GenerateRollbackStatement(storepb.Engine_MYSQL, db.Migrate, prevSchema, prevSchema))
+	a.Empty(GenerateRollbackStatement(storepb.Engine_MONGODB, db.Migrate, prevSchema, updatedSchema))
+}
+
+func TestGenerateRollbackStatementPreview(t *testing.T) {
+	a := require.New(t)
+	currentSchema := "CREATE TABLE `t1` (\n  `id` int NOT NULL\n);\n"
+
+	rollbackStatement := GenerateRollbackStatementPreview(storepb.Engine_MYSQL, db.Migrate, currentSchema, "CREATE TABLE `t2` (\n  `id` int NOT NULL\n);\n")
+	a.Contains(rollbackStatement, "DROP TABLE")
+	a.Contains(rollbackStatement, "`t2`")
+
+	rollbackStatement = GenerateRollbackStatementPreview(storepb.Engine_MYSQL, db.MigrateSDL, currentSchema, "")
+	a.Contains(rollbackStatement, "CREATE TABLE")
+	a.Contains(rollbackStatement, "`t1`")
+
+	// The schema after the change cannot be derived from the statements altering the existing objects.
+	a.Empty(GenerateRollbackStatementPreview(storepb.Engine_MYSQL, db.Migrate, currentSchema, "ALTER TABLE `t1` ADD COLUMN `name` varchar(255);"))
+	a.Empty(GenerateRollbackStatementPreview(storepb.Engine_MYSQL, db.Data, currentSchema, "INSERT INTO `t1` VALUES (1);"))
+}
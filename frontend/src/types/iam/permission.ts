@@ -74,6 +74,7 @@ export type ProjectPermission =
   | "bb.databases.list"
   | "bb.databases.query"
   | "bb.databases.sync"
+  | "bb.databases.unmask"
   | "bb.databases.update"
   | "bb.issueComments.create"
   | "bb.issueComments.update"
@@ -28,6 +28,11 @@ type QueryHistoryPayload struct {
 
 	Error    *string              `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The result of the query cost guard, unset if the query is not guarded.
+	CostGuard *QueryCostGuardResult `protobuf:"bytes,3,opt,name=cost_guard,json=costGuard,proto3" json:"cost_guard,omitempty"`
+	// The tables read by the query.
+	// Format: {database}.{schema}.{table}, the schema is omitted if the engine has no schema.
+	Tables []string `protobuf:"bytes,4,rep,name=tables,proto3" json:"tables,omitempty"`
 }
 
 func (x *QueryHistoryPayload) Reset() {
@@ -76,6 +81,114 @@ func (x *QueryHistoryPayload) GetDuration() *durationpb.Duration {
 	return nil
 }
 
+func (x *QueryHistoryPayload) GetCostGuard() *QueryCostGuardResult {
+	if x != nil {
+		return x.CostGuard
+	}
+	return nil
+}
+
+func (x *QueryHistoryPayload) GetTables() []string {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type QueryCostGuardResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of rows estimated to be examined by a step of the plan.
+	EstimatedRows int64 `protobuf:"varint,1,opt,name=estimated_rows,json=estimatedRows,proto3" json:"estimated_rows,omitempty"`
+	// The total cost estimated by the optimizer.
+	EstimatedCost float64 `protobuf:"fixed64,2,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
+	// The plan contains a full table scan.
+	FullScan bool `protobuf:"varint,3,opt,name=full_scan,json=fullScan,proto3" json:"full_scan,omitempty"`
+	// The strictest action of the rules matched.
+	Action QueryCostGuardPolicy_Rule_Action `protobuf:"varint,4,opt,name=action,proto3,enum=bytebase.store.QueryCostGuardPolicy_Rule_Action" json:"action,omitempty"`
+	// The approval issue of the query if the action is REQUIRE_APPROVAL.
+	// Format: projects/{project}/issues/{issue}
+	Issue string `protobuf:"bytes,5,opt,name=issue,proto3" json:"issue,omitempty"`
+	// The error of estimating the cost, in which case the rules are evaluated with the worst estimation.
+	EstimateError string `protobuf:"bytes,6,opt,name=estimate_error,json=estimateError,proto3" json:"estimate_error,omitempty"`
+}
+
+func (x *QueryCostGuardResult) Reset() {
+	*x = QueryCostGuardResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_query_history_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCostGuardResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCostGuardResult) ProtoMessage() {}
+
+func (x *QueryCostGuardResult) ProtoReflect() protoreflect.Message {
+	mi := &file_store_query_history_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCostGuardResult.ProtoReflect.Descriptor instead.
+func (*QueryCostGuardResult) Descriptor() ([]byte, []int) {
+	return file_store_query_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryCostGuardResult) GetEstimatedRows() int64 {
+	if x != nil {
+		return x.EstimatedRows
+	}
+	return 0
+}
+
+func (x *QueryCostGuardResult) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
+
+func (x *QueryCostGuardResult) GetFullScan() bool {
+	if x != nil {
+		return x.FullScan
+	}
+	return false
+}
+
+func (x *QueryCostGuardResult) GetAction() QueryCostGuardPolicy_Rule_Action {
+	if x != nil {
+		return x.Action
+	}
+	return QueryCostGuardPolicy_Rule_ACTION_UNSPECIFIED
+}
+
+func (x *QueryCostGuardResult) GetIssue() string {
+	if x != nil {
+		return x.Issue
+	}
+	return ""
+}
+
+func (x *QueryCostGuardResult) GetEstimateError() string {
+	if x != nil {
+		return x.EstimateError
+	}
+	return ""
+}
+
 var File_store_query_history_proto protoreflect.FileDescriptor
 
 var file_store_query_history_proto_rawDesc = []byte{
@@ -83,16 +196,40 @@ var file_store_query_history_proto_rawDesc = []byte{
 	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74,
 	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x13, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a,
-	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x14,
-	0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xce, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x6f, 0x73,
+	0x74, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x73, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x88, 0x02, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x47, 0x75,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f,
+	0x73, 0x63, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c,
+	0x53, 0x63, 0x61, 0x6e, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x47,
+	0x75, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x14, 0x5a, 0x12, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -107,18 +244,22 @@ func file_store_query_history_proto_rawDescGZIP() []byte {
 	return file_store_query_history_proto_rawDescData
 }
 
-var file_store_query_history_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_query_history_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_store_query_history_proto_goTypes = []any{
-	(*QueryHistoryPayload)(nil), // 0: bytebase.store.QueryHistoryPayload
-	(*durationpb.Duration)(nil), // 1: google.protobuf.Duration
+	(*QueryHistoryPayload)(nil),           // 0: bytebase.store.QueryHistoryPayload
+	(*QueryCostGuardResult)(nil),          // 1: bytebase.store.QueryCostGuardResult
+	(*durationpb.Duration)(nil),           // 2: google.protobuf.Duration
+	(QueryCostGuardPolicy_Rule_Action)(0), // 3: bytebase.store.QueryCostGuardPolicy.Rule.Action
 }
 var file_store_query_history_proto_depIdxs = []int32{
-	1, // 0: bytebase.store.QueryHistoryPayload.duration:type_name -> google.protobuf.Duration
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: bytebase.store.QueryHistoryPayload.duration:type_name -> google.protobuf.Duration
+	1, // 1: bytebase.store.QueryHistoryPayload.cost_guard:type_name -> bytebase.store.QueryCostGuardResult
+	3, // 2: bytebase.store.QueryCostGuardResult.action:type_name -> bytebase.store.QueryCostGuardPolicy.Rule.Action
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_store_query_history_proto_init() }
@@ -126,6 +267,7 @@ func file_store_query_history_proto_init() {
 	if File_store_query_history_proto != nil {
 		return
 	}
+	file_store_policy_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_store_query_history_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*QueryHistoryPayload); i {
@@ -139,6 +281,18 @@ func file_store_query_history_proto_init() {
 				return nil
 			}
 		}
+		file_store_query_history_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryCostGuardResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_store_query_history_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
@@ -147,7 +301,7 @@ func file_store_query_history_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_query_history_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
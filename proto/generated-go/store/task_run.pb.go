@@ -108,6 +108,153 @@ func (x *TaskRunResult) GetExportArchiveUid() int32 {
 	return 0
 }
 
+type TaskRunPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The indexes of the commands that have been committed.
+	// A task run resumed from a failed task run inherits the committed command indexes
+	// of the failed task run and skips these commands.
+	CommittedCommandIndexes []int32 `protobuf:"varint,1,rep,packed,name=committed_command_indexes,json=committedCommandIndexes,proto3" json:"committed_command_indexes,omitempty"`
+	// The primary key values of the last row changed by the committed batches.
+	// A batched task run resumed from a failed or canceled task run continues after the cursor.
+	BatchCursor []string `protobuf:"bytes,2,rep,name=batch_cursor,json=batchCursor,proto3" json:"batch_cursor,omitempty"`
+	// If true, the transaction group of the task run has decided to commit, and the task run is about to commit its prepared transaction.
+	// The transactions left prepared by a restart are committed if any task run in the same attempt of the group has recorded the decision, and rolled back otherwise.
+	TransactionCommitted bool `protobuf:"varint,3,opt,name=transaction_committed,json=transactionCommitted,proto3" json:"transaction_committed,omitempty"`
+	// The batch whose transaction is being committed, which is recorded before the commit.
+	// A batched task run resumed from the task run checks if the transaction is committed to decide whether to re-run the batch.
+	PendingBatch *PendingBatch `protobuf:"bytes,4,opt,name=pending_batch,json=pendingBatch,proto3" json:"pending_batch,omitempty"`
+	// The id of the attempt of the transaction group which the task run joins.
+	// The transaction left prepared by a restart is committed only if a task run of the same attempt has recorded the decision to commit.
+	TransactionGroupId string `protobuf:"bytes,5,opt,name=transaction_group_id,json=transactionGroupId,proto3" json:"transaction_group_id,omitempty"`
+}
+
+func (x *TaskRunPayload) Reset() {
+	*x = TaskRunPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_task_run_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskRunPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRunPayload) ProtoMessage() {}
+
+func (x *TaskRunPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_task_run_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRunPayload.ProtoReflect.Descriptor instead.
+func (*TaskRunPayload) Descriptor() ([]byte, []int) {
+	return file_store_task_run_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TaskRunPayload) GetCommittedCommandIndexes() []int32 {
+	if x != nil {
+		return x.CommittedCommandIndexes
+	}
+	return nil
+}
+
+func (x *TaskRunPayload) GetBatchCursor() []string {
+	if x != nil {
+		return x.BatchCursor
+	}
+	return nil
+}
+
+func (x *TaskRunPayload) GetTransactionCommitted() bool {
+	if x != nil {
+		return x.TransactionCommitted
+	}
+	return false
+}
+
+func (x *TaskRunPayload) GetPendingBatch() *PendingBatch {
+	if x != nil {
+		return x.PendingBatch
+	}
+	return nil
+}
+
+func (x *TaskRunPayload) GetTransactionGroupId() string {
+	if x != nil {
+		return x.TransactionGroupId
+	}
+	return ""
+}
+
+type PendingBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The primary key values of the last row changed by the batch, empty if it's the last batch.
+	Cursor []string `protobuf:"bytes,1,rep,name=cursor,proto3" json:"cursor,omitempty"`
+	// The transaction id of the batch, which is the txid of PostgreSQL or the XA transaction id of MySQL.
+	Xid string `protobuf:"bytes,2,opt,name=xid,proto3" json:"xid,omitempty"`
+}
+
+func (x *PendingBatch) Reset() {
+	*x = PendingBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_task_run_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingBatch) ProtoMessage() {}
+
+func (x *PendingBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_store_task_run_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingBatch.ProtoReflect.Descriptor instead.
+func (*PendingBatch) Descriptor() ([]byte, []int) {
+	return file_store_task_run_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PendingBatch) GetCursor() []string {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *PendingBatch) GetXid() string {
+	if x != nil {
+		return x.Xid
+	}
+	return ""
+}
+
 // The following fields are used for error reporting.
 type TaskRunResult_Position struct {
 	state         protoimpl.MessageState
@@ -121,7 +268,7 @@ type TaskRunResult_Position struct {
 func (x *TaskRunResult_Position) Reset() {
 	*x = TaskRunResult_Position{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_task_run_proto_msgTypes[1]
+		mi := &file_store_task_run_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -134,7 +281,7 @@ func (x *TaskRunResult_Position) String() string {
 func (*TaskRunResult_Position) ProtoMessage() {}
 
 func (x *TaskRunResult_Position) ProtoReflect() protoreflect.Message {
-	mi := &file_store_task_run_proto_msgTypes[1]
+	mi := &file_store_task_run_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -192,8 +339,30 @@ var file_store_task_run_proto_rawDesc = []byte{
 	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c,
 	0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67,
-	0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x22, 0x99, 0x02, 0x0a, 0x0e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x17, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x38, 0x0a,
+	0x0c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x78, 0x69, 0x64, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -208,19 +377,22 @@ func file_store_task_run_proto_rawDescGZIP() []byte {
 	return file_store_task_run_proto_rawDescData
 }
 
-var file_store_task_run_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_task_run_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_store_task_run_proto_goTypes = []any{
 	(*TaskRunResult)(nil),          // 0: bytebase.store.TaskRunResult
-	(*TaskRunResult_Position)(nil), // 1: bytebase.store.TaskRunResult.Position
+	(*TaskRunPayload)(nil),         // 1: bytebase.store.TaskRunPayload
+	(*PendingBatch)(nil),           // 2: bytebase.store.PendingBatch
+	(*TaskRunResult_Position)(nil), // 3: bytebase.store.TaskRunResult.Position
 }
 var file_store_task_run_proto_depIdxs = []int32{
-	1, // 0: bytebase.store.TaskRunResult.start_position:type_name -> bytebase.store.TaskRunResult.Position
-	1, // 1: bytebase.store.TaskRunResult.end_position:type_name -> bytebase.store.TaskRunResult.Position
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: bytebase.store.TaskRunResult.start_position:type_name -> bytebase.store.TaskRunResult.Position
+	3, // 1: bytebase.store.TaskRunResult.end_position:type_name -> bytebase.store.TaskRunResult.Position
+	2, // 2: bytebase.store.TaskRunPayload.pending_batch:type_name -> bytebase.store.PendingBatch
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_store_task_run_proto_init() }
@@ -242,6 +414,30 @@ func file_store_task_run_proto_init() {
 			}
 		}
 		file_store_task_run_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskRunPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_task_run_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*PendingBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_task_run_proto_msgTypes[3].Exporter = func(v any, i int) any {
 			switch v := v.(*TaskRunResult_Position); i {
 			case 0:
 				return &v.state
@@ -260,7 +456,7 @@ func file_store_task_run_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_task_run_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
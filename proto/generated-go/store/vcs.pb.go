@@ -20,6 +20,66 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type VCSConnector_MigrationFormat int32
+
+const (
+	// The Bytebase versioned SQL files, e.g. 0001_create_table.sql.
+	VCSConnector_MIGRATION_FORMAT_UNSPECIFIED VCSConnector_MigrationFormat = 0
+	// The Flyway SQL migrations, e.g. V1.1__create_table.sql and R__views.sql.
+	VCSConnector_FLYWAY VCSConnector_MigrationFormat = 1
+	// The Liquibase XML or YAML changelogs.
+	VCSConnector_LIQUIBASE VCSConnector_MigrationFormat = 2
+	// The Django migrations, only the RunSQL operations are supported.
+	VCSConnector_DJANGO VCSConnector_MigrationFormat = 3
+	// The Prisma migrations, e.g. 20240101000000_init/migration.sql.
+	VCSConnector_PRISMA VCSConnector_MigrationFormat = 4
+)
+
+// Enum value maps for VCSConnector_MigrationFormat.
+var (
+	VCSConnector_MigrationFormat_name = map[int32]string{
+		0: "MIGRATION_FORMAT_UNSPECIFIED",
+		1: "FLYWAY",
+		2: "LIQUIBASE",
+		3: "DJANGO",
+		4: "PRISMA",
+	}
+	VCSConnector_MigrationFormat_value = map[string]int32{
+		"MIGRATION_FORMAT_UNSPECIFIED": 0,
+		"FLYWAY":                       1,
+		"LIQUIBASE":                    2,
+		"DJANGO":                       3,
+		"PRISMA":                       4,
+	}
+)
+
+func (x VCSConnector_MigrationFormat) Enum() *VCSConnector_MigrationFormat {
+	p := new(VCSConnector_MigrationFormat)
+	*p = x
+	return p
+}
+
+func (x VCSConnector_MigrationFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VCSConnector_MigrationFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_vcs_proto_enumTypes[0].Descriptor()
+}
+
+func (VCSConnector_MigrationFormat) Type() protoreflect.EnumType {
+	return &file_store_vcs_proto_enumTypes[0]
+}
+
+func (x VCSConnector_MigrationFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VCSConnector_MigrationFormat.Descriptor instead.
+func (VCSConnector_MigrationFormat) EnumDescriptor() ([]byte, []int) {
+	return file_store_vcs_proto_rawDescGZIP(), []int{0, 0}
+}
+
 type VCSConnector struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -48,6 +108,29 @@ type VCSConnector struct {
 	// Apply changes to the database group. Optional, if not set, will apply changes to all databases in the project.
 	// Format: projects/{project}/databaseGroups/{databaseGroup}
 	DatabaseGroup string `protobuf:"bytes,9,opt,name=database_group,json=databaseGroup,proto3" json:"database_group,omitempty"`
+	// The template of the file paths to route changed files to their target databases or database groups.
+	// Optional, if not set, only the files directly under the base directory are observed.
+	// Example: {{ROOT}}/{{ENV_ID}}/{{DB_NAME}}/{{VERSION}}_{{TYPE}}_{{DESC}}.sql
+	FilePathTemplate string `protobuf:"bytes,10,opt,name=file_path_template,json=filePathTemplate,proto3" json:"file_path_template,omitempty"`
+	// If true, the files are the desired full schema (SDL) of the databases instead of versioned migrations.
+	// The changes are computed by diffing the desired schema against the database schema.
+	Declarative bool `protobuf:"varint,11,opt,name=declarative,proto3" json:"declarative,omitempty"`
+	// If true, pushes to the branch create rollouts for the changed files without pull requests,
+	// and the merged pull requests are handled by their pushes.
+	PushTrigger bool `protobuf:"varint,12,opt,name=push_trigger,json=pushTrigger,proto3" json:"push_trigger,omitempty"`
+	// The glob pattern of the tags, e.g. v*. If set, the tags matching the pattern create rollouts for the files changed
+	// since the last released tag.
+	TagPattern string `protobuf:"bytes,13,opt,name=tag_pattern,json=tagPattern,proto3" json:"tag_pattern,omitempty"`
+	// The commit of the last tag that created a rollout.
+	LastReleaseCommitId string `protobuf:"bytes,14,opt,name=last_release_commit_id,json=lastReleaseCommitId,proto3" json:"last_release_commit_id,omitempty"`
+	// The push webhook id for the VCS providers which require a webhook per event type, i.e. Azure DevOps.
+	ExternalPushWebhookId string `protobuf:"bytes,15,opt,name=external_push_webhook_id,json=externalPushWebhookId,proto3" json:"external_push_webhook_id,omitempty"`
+	// If set, the schema of the database is committed back to the repository after each successful migration.
+	SchemaWriteBack *VCSConnector_SchemaWriteBack `protobuf:"bytes,16,opt,name=schema_write_back,json=schemaWriteBack,proto3" json:"schema_write_back,omitempty"`
+	// The last commit of the branch processed by polling, only used by the generic Git VCS.
+	LastPolledCommitId string `protobuf:"bytes,17,opt,name=last_polled_commit_id,json=lastPolledCommitId,proto3" json:"last_polled_commit_id,omitempty"`
+	// The format of the migration files under the base directory.
+	MigrationFormat VCSConnector_MigrationFormat `protobuf:"varint,18,opt,name=migration_format,json=migrationFormat,proto3,enum=bytebase.store.VCSConnector_MigrationFormat" json:"migration_format,omitempty"`
 }
 
 func (x *VCSConnector) Reset() {
@@ -145,12 +228,141 @@ func (x *VCSConnector) GetDatabaseGroup() string {
 	return ""
 }
 
+func (x *VCSConnector) GetFilePathTemplate() string {
+	if x != nil {
+		return x.FilePathTemplate
+	}
+	return ""
+}
+
+func (x *VCSConnector) GetDeclarative() bool {
+	if x != nil {
+		return x.Declarative
+	}
+	return false
+}
+
+func (x *VCSConnector) GetPushTrigger() bool {
+	if x != nil {
+		return x.PushTrigger
+	}
+	return false
+}
+
+func (x *VCSConnector) GetTagPattern() string {
+	if x != nil {
+		return x.TagPattern
+	}
+	return ""
+}
+
+func (x *VCSConnector) GetLastReleaseCommitId() string {
+	if x != nil {
+		return x.LastReleaseCommitId
+	}
+	return ""
+}
+
+func (x *VCSConnector) GetExternalPushWebhookId() string {
+	if x != nil {
+		return x.ExternalPushWebhookId
+	}
+	return ""
+}
+
+func (x *VCSConnector) GetSchemaWriteBack() *VCSConnector_SchemaWriteBack {
+	if x != nil {
+		return x.SchemaWriteBack
+	}
+	return nil
+}
+
+func (x *VCSConnector) GetLastPolledCommitId() string {
+	if x != nil {
+		return x.LastPolledCommitId
+	}
+	return ""
+}
+
+func (x *VCSConnector) GetMigrationFormat() VCSConnector_MigrationFormat {
+	if x != nil {
+		return x.MigrationFormat
+	}
+	return VCSConnector_MIGRATION_FORMAT_UNSPECIFIED
+}
+
+type VCSConnector_SchemaWriteBack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The template of the schema file path, e.g. {{ROOT}}/{{ENV_ID}}/{{DB_NAME}}.sql.
+	FilePathTemplate string `protobuf:"bytes,1,opt,name=file_path_template,json=filePathTemplate,proto3" json:"file_path_template,omitempty"`
+	// The branch to commit the schema files to. If empty, the branch of the VCS connector is used.
+	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// If true, a pull request is opened from the branch to the branch of the VCS connector.
+	PullRequest bool `protobuf:"varint,3,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+}
+
+func (x *VCSConnector_SchemaWriteBack) Reset() {
+	*x = VCSConnector_SchemaWriteBack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_vcs_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VCSConnector_SchemaWriteBack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VCSConnector_SchemaWriteBack) ProtoMessage() {}
+
+func (x *VCSConnector_SchemaWriteBack) ProtoReflect() protoreflect.Message {
+	mi := &file_store_vcs_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VCSConnector_SchemaWriteBack.ProtoReflect.Descriptor instead.
+func (*VCSConnector_SchemaWriteBack) Descriptor() ([]byte, []int) {
+	return file_store_vcs_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *VCSConnector_SchemaWriteBack) GetFilePathTemplate() string {
+	if x != nil {
+		return x.FilePathTemplate
+	}
+	return ""
+}
+
+func (x *VCSConnector_SchemaWriteBack) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *VCSConnector_SchemaWriteBack) GetPullRequest() bool {
+	if x != nil {
+		return x.PullRequest
+	}
+	return false
+}
+
 var File_store_vcs_proto protoreflect.FileDescriptor
 
 var file_store_vcs_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x22, 0xc3, 0x02, 0x0a, 0x0c, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x22, 0x8f, 0x08, 0x0a, 0x0c, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
 	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c,
 	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c,
@@ -170,9 +382,54 @@ var file_store_vcs_proto_rawDesc = []byte{
 	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
 	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x72, 0x6f,
 	0x75, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x63, 0x6c,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70,
+	0x75, 0x73, 0x68, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61,
+	0x67, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x61, 0x67, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x61, 0x73,
+	0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64,
+	0x12, 0x37, 0x0a, 0x18, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x75, 0x73,
+	0x68, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x75, 0x73, 0x68,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x58, 0x0a, 0x11, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x61,
+	0x63, 0x6b, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42,
+	0x61, 0x63, 0x6b, 0x12, 0x31, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x6c,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x10, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0f,
+	0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x1a,
+	0x7a, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x61,
+	0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x6c, 0x6c,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x0f, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x20,
+	0x0a, 0x1c, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x46, 0x4c, 0x59, 0x57, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x4c, 0x49, 0x51, 0x55, 0x49, 0x42, 0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44,
+	0x4a, 0x41, 0x4e, 0x47, 0x4f, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x52, 0x49, 0x53, 0x4d,
+	0x41, 0x10, 0x04, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -187,16 +444,21 @@ func file_store_vcs_proto_rawDescGZIP() []byte {
 	return file_store_vcs_proto_rawDescData
 }
 
-var file_store_vcs_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_vcs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_vcs_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_store_vcs_proto_goTypes = []any{
-	(*VCSConnector)(nil), // 0: bytebase.store.VCSConnector
+	(VCSConnector_MigrationFormat)(0),    // 0: bytebase.store.VCSConnector.MigrationFormat
+	(*VCSConnector)(nil),                 // 1: bytebase.store.VCSConnector
+	(*VCSConnector_SchemaWriteBack)(nil), // 2: bytebase.store.VCSConnector.SchemaWriteBack
 }
 var file_store_vcs_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: bytebase.store.VCSConnector.schema_write_back:type_name -> bytebase.store.VCSConnector.SchemaWriteBack
+	0, // 1: bytebase.store.VCSConnector.migration_format:type_name -> bytebase.store.VCSConnector.MigrationFormat
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_store_vcs_proto_init() }
@@ -217,19 +479,32 @@ func file_store_vcs_proto_init() {
 				return nil
 			}
 		}
+		file_store_vcs_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*VCSConnector_SchemaWriteBack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_vcs_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_vcs_proto_goTypes,
 		DependencyIndexes: file_store_vcs_proto_depIdxs,
+		EnumInfos:         file_store_vcs_proto_enumTypes,
 		MessageInfos:      file_store_vcs_proto_msgTypes,
 	}.Build()
 	File_store_vcs_proto = out.File
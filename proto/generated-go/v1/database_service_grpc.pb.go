@@ -27,6 +27,7 @@ const (
 	DatabaseService_BatchUpdateDatabases_FullMethodName   = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
 	DatabaseService_SyncDatabase_FullMethodName           = "/bytebase.v1.DatabaseService/SyncDatabase"
 	DatabaseService_GetDatabaseMetadata_FullMethodName    = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
+	DatabaseService_GetSensitiveDataReport_FullMethodName = "/bytebase.v1.DatabaseService/GetSensitiveDataReport"
 	DatabaseService_UpdateDatabaseMetadata_FullMethodName = "/bytebase.v1.DatabaseService/UpdateDatabaseMetadata"
 	DatabaseService_GetDatabaseSchema_FullMethodName      = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
 	DatabaseService_DiffSchema_FullMethodName             = "/bytebase.v1.DatabaseService/DiffSchema"
@@ -37,6 +38,7 @@ const (
 	DatabaseService_AdviseIndex_FullMethodName            = "/bytebase.v1.DatabaseService/AdviseIndex"
 	DatabaseService_ListChangeHistories_FullMethodName    = "/bytebase.v1.DatabaseService/ListChangeHistories"
 	DatabaseService_GetChangeHistory_FullMethodName       = "/bytebase.v1.DatabaseService/GetChangeHistory"
+	DatabaseService_RollbackChangeHistory_FullMethodName  = "/bytebase.v1.DatabaseService/RollbackChangeHistory"
 )
 
 // DatabaseServiceClient is the client API for DatabaseService service.
@@ -51,6 +53,8 @@ type DatabaseServiceClient interface {
 	BatchUpdateDatabases(ctx context.Context, in *BatchUpdateDatabasesRequest, opts ...grpc.CallOption) (*BatchUpdateDatabasesResponse, error)
 	SyncDatabase(ctx context.Context, in *SyncDatabaseRequest, opts ...grpc.CallOption) (*SyncDatabaseResponse, error)
 	GetDatabaseMetadata(ctx context.Context, in *GetDatabaseMetadataRequest, opts ...grpc.CallOption) (*DatabaseMetadata, error)
+	// GetSensitiveDataReport gets the sensitive data discovered by sampling the database, the findings are proposals for review.
+	GetSensitiveDataReport(ctx context.Context, in *GetSensitiveDataReportRequest, opts ...grpc.CallOption) (*SensitiveDataReport, error)
 	UpdateDatabaseMetadata(ctx context.Context, in *UpdateDatabaseMetadataRequest, opts ...grpc.CallOption) (*DatabaseMetadata, error)
 	GetDatabaseSchema(ctx context.Context, in *GetDatabaseSchemaRequest, opts ...grpc.CallOption) (*DatabaseSchema, error)
 	DiffSchema(ctx context.Context, in *DiffSchemaRequest, opts ...grpc.CallOption) (*DiffSchemaResponse, error)
@@ -61,6 +65,8 @@ type DatabaseServiceClient interface {
 	AdviseIndex(ctx context.Context, in *AdviseIndexRequest, opts ...grpc.CallOption) (*AdviseIndexResponse, error)
 	ListChangeHistories(ctx context.Context, in *ListChangeHistoriesRequest, opts ...grpc.CallOption) (*ListChangeHistoriesResponse, error)
 	GetChangeHistory(ctx context.Context, in *GetChangeHistoryRequest, opts ...grpc.CallOption) (*ChangeHistory, error)
+	// RollbackChangeHistory creates an issue to roll back the change history with its rollback statement.
+	RollbackChangeHistory(ctx context.Context, in *RollbackChangeHistoryRequest, opts ...grpc.CallOption) (*Issue, error)
 }
 
 type databaseServiceClient struct {
@@ -141,6 +147,16 @@ func (c *databaseServiceClient) GetDatabaseMetadata(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *databaseServiceClient) GetSensitiveDataReport(ctx context.Context, in *GetSensitiveDataReportRequest, opts ...grpc.CallOption) (*SensitiveDataReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SensitiveDataReport)
+	err := c.cc.Invoke(ctx, DatabaseService_GetSensitiveDataReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) UpdateDatabaseMetadata(ctx context.Context, in *UpdateDatabaseMetadataRequest, opts ...grpc.CallOption) (*DatabaseMetadata, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DatabaseMetadata)
@@ -241,6 +257,16 @@ func (c *databaseServiceClient) GetChangeHistory(ctx context.Context, in *GetCha
 	return out, nil
 }
 
+func (c *databaseServiceClient) RollbackChangeHistory(ctx context.Context, in *RollbackChangeHistoryRequest, opts ...grpc.CallOption) (*Issue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Issue)
+	err := c.cc.Invoke(ctx, DatabaseService_RollbackChangeHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatabaseServiceServer is the server API for DatabaseService service.
 // All implementations must embed UnimplementedDatabaseServiceServer
 // for forward compatibility
@@ -253,6 +279,8 @@ type DatabaseServiceServer interface {
 	BatchUpdateDatabases(context.Context, *BatchUpdateDatabasesRequest) (*BatchUpdateDatabasesResponse, error)
 	SyncDatabase(context.Context, *SyncDatabaseRequest) (*SyncDatabaseResponse, error)
 	GetDatabaseMetadata(context.Context, *GetDatabaseMetadataRequest) (*DatabaseMetadata, error)
+	// GetSensitiveDataReport gets the sensitive data discovered by sampling the database, the findings are proposals for review.
+	GetSensitiveDataReport(context.Context, *GetSensitiveDataReportRequest) (*SensitiveDataReport, error)
 	UpdateDatabaseMetadata(context.Context, *UpdateDatabaseMetadataRequest) (*DatabaseMetadata, error)
 	GetDatabaseSchema(context.Context, *GetDatabaseSchemaRequest) (*DatabaseSchema, error)
 	DiffSchema(context.Context, *DiffSchemaRequest) (*DiffSchemaResponse, error)
@@ -263,6 +291,8 @@ type DatabaseServiceServer interface {
 	AdviseIndex(context.Context, *AdviseIndexRequest) (*AdviseIndexResponse, error)
 	ListChangeHistories(context.Context, *ListChangeHistoriesRequest) (*ListChangeHistoriesResponse, error)
 	GetChangeHistory(context.Context, *GetChangeHistoryRequest) (*ChangeHistory, error)
+	// RollbackChangeHistory creates an issue to roll back the change history with its rollback statement.
+	RollbackChangeHistory(context.Context, *RollbackChangeHistoryRequest) (*Issue, error)
 	mustEmbedUnimplementedDatabaseServiceServer()
 }
 
@@ -291,6 +321,9 @@ func (UnimplementedDatabaseServiceServer) SyncDatabase(context.Context, *SyncDat
 func (UnimplementedDatabaseServiceServer) GetDatabaseMetadata(context.Context, *GetDatabaseMetadataRequest) (*DatabaseMetadata, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDatabaseMetadata not implemented")
 }
+func (UnimplementedDatabaseServiceServer) GetSensitiveDataReport(context.Context, *GetSensitiveDataReportRequest) (*SensitiveDataReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSensitiveDataReport not implemented")
+}
 func (UnimplementedDatabaseServiceServer) UpdateDatabaseMetadata(context.Context, *UpdateDatabaseMetadataRequest) (*DatabaseMetadata, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDatabaseMetadata not implemented")
 }
@@ -321,6 +354,9 @@ func (UnimplementedDatabaseServiceServer) ListChangeHistories(context.Context, *
 func (UnimplementedDatabaseServiceServer) GetChangeHistory(context.Context, *GetChangeHistoryRequest) (*ChangeHistory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChangeHistory not implemented")
 }
+func (UnimplementedDatabaseServiceServer) RollbackChangeHistory(context.Context, *RollbackChangeHistoryRequest) (*Issue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackChangeHistory not implemented")
+}
 func (UnimplementedDatabaseServiceServer) mustEmbedUnimplementedDatabaseServiceServer() {}
 
 // UnsafeDatabaseServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -460,6 +496,24 @@ func _DatabaseService_GetDatabaseMetadata_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_GetSensitiveDataReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSensitiveDataReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetSensitiveDataReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_GetSensitiveDataReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetSensitiveDataReport(ctx, req.(*GetSensitiveDataReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_UpdateDatabaseMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateDatabaseMetadataRequest)
 	if err := dec(in); err != nil {
@@ -640,6 +694,24 @@ func _DatabaseService_GetChangeHistory_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_RollbackChangeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackChangeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).RollbackChangeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_RollbackChangeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).RollbackChangeHistory(ctx, req.(*RollbackChangeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DatabaseService_ServiceDesc is the grpc.ServiceDesc for DatabaseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -675,6 +747,10 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDatabaseMetadata",
 			Handler:    _DatabaseService_GetDatabaseMetadata_Handler,
 		},
+		{
+			MethodName: "GetSensitiveDataReport",
+			Handler:    _DatabaseService_GetSensitiveDataReport_Handler,
+		},
 		{
 			MethodName: "UpdateDatabaseMetadata",
 			Handler:    _DatabaseService_UpdateDatabaseMetadata_Handler,
@@ -715,6 +791,10 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChangeHistory",
 			Handler:    _DatabaseService_GetChangeHistory_Handler,
 		},
+		{
+			MethodName: "RollbackChangeHistory",
+			Handler:    _DatabaseService_RollbackChangeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/database_service.proto",
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,17 +20,28 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	SQLService_Query_FullMethodName                = "/bytebase.v1.SQLService/Query"
-	SQLService_Execute_FullMethodName              = "/bytebase.v1.SQLService/Execute"
-	SQLService_AdminExecute_FullMethodName         = "/bytebase.v1.SQLService/AdminExecute"
-	SQLService_SearchQueryHistories_FullMethodName = "/bytebase.v1.SQLService/SearchQueryHistories"
-	SQLService_Export_FullMethodName               = "/bytebase.v1.SQLService/Export"
-	SQLService_DifferPreview_FullMethodName        = "/bytebase.v1.SQLService/DifferPreview"
-	SQLService_Check_FullMethodName                = "/bytebase.v1.SQLService/Check"
-	SQLService_ParseMyBatisMapper_FullMethodName   = "/bytebase.v1.SQLService/ParseMyBatisMapper"
-	SQLService_Pretty_FullMethodName               = "/bytebase.v1.SQLService/Pretty"
-	SQLService_StringifyMetadata_FullMethodName    = "/bytebase.v1.SQLService/StringifyMetadata"
-	SQLService_GenerateRestoreSQL_FullMethodName   = "/bytebase.v1.SQLService/GenerateRestoreSQL"
+	SQLService_Query_FullMethodName                   = "/bytebase.v1.SQLService/Query"
+	SQLService_FetchQueryResult_FullMethodName        = "/bytebase.v1.SQLService/FetchQueryResult"
+	SQLService_CloseQueryResult_FullMethodName        = "/bytebase.v1.SQLService/CloseQueryResult"
+	SQLService_CreateQueryResultShare_FullMethodName  = "/bytebase.v1.SQLService/CreateQueryResultShare"
+	SQLService_GetQueryResultShare_FullMethodName     = "/bytebase.v1.SQLService/GetQueryResultShare"
+	SQLService_CreateEditorSession_FullMethodName     = "/bytebase.v1.SQLService/CreateEditorSession"
+	SQLService_GetEditorSession_FullMethodName        = "/bytebase.v1.SQLService/GetEditorSession"
+	SQLService_ExecuteEditorSession_FullMethodName    = "/bytebase.v1.SQLService/ExecuteEditorSession"
+	SQLService_HeartbeatEditorSession_FullMethodName  = "/bytebase.v1.SQLService/HeartbeatEditorSession"
+	SQLService_DeleteEditorSession_FullMethodName     = "/bytebase.v1.SQLService/DeleteEditorSession"
+	SQLService_Execute_FullMethodName                 = "/bytebase.v1.SQLService/Execute"
+	SQLService_AdminExecute_FullMethodName            = "/bytebase.v1.SQLService/AdminExecute"
+	SQLService_SearchQueryHistories_FullMethodName    = "/bytebase.v1.SQLService/SearchQueryHistories"
+	SQLService_AggregateQueryHistories_FullMethodName = "/bytebase.v1.SQLService/AggregateQueryHistories"
+	SQLService_Export_FullMethodName                  = "/bytebase.v1.SQLService/Export"
+	SQLService_DifferPreview_FullMethodName           = "/bytebase.v1.SQLService/DifferPreview"
+	SQLService_Check_FullMethodName                   = "/bytebase.v1.SQLService/Check"
+	SQLService_ParseMyBatisMapper_FullMethodName      = "/bytebase.v1.SQLService/ParseMyBatisMapper"
+	SQLService_Pretty_FullMethodName                  = "/bytebase.v1.SQLService/Pretty"
+	SQLService_StringifyMetadata_FullMethodName       = "/bytebase.v1.SQLService/StringifyMetadata"
+	SQLService_GenerateRestoreSQL_FullMethodName      = "/bytebase.v1.SQLService/GenerateRestoreSQL"
+	SQLService_Unmask_FullMethodName                  = "/bytebase.v1.SQLService/Unmask"
 )
 
 // SQLServiceClient is the client API for SQLService service.
@@ -37,9 +49,31 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SQLServiceClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// FetchQueryResult fetches the next page of the query result returned by Query with the page size.
+	FetchQueryResult(ctx context.Context, in *FetchQueryResultRequest, opts ...grpc.CallOption) (*QueryResult, error)
+	// CloseQueryResult releases the connection held for the query result before all the pages are fetched.
+	CloseQueryResult(ctx context.Context, in *CloseQueryResultRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreateQueryResultShare runs the query and saves the masked result as a snapshot, which can be viewed by the link until it expires.
+	CreateQueryResultShare(ctx context.Context, in *CreateQueryResultShareRequest, opts ...grpc.CallOption) (*QueryResultShare, error)
+	// GetQueryResultShare gets the snapshot of the shared query result.
+	GetQueryResultShare(ctx context.Context, in *GetQueryResultShareRequest, opts ...grpc.CallOption) (*QueryResultShare, error)
+	// CreateEditorSession pins a connection to the editor tab, so that a transaction can be run across the requests.
+	// The session is rolled back and closed if it is idle for 10 minutes,
+	// or if the client sends no heartbeat or execution for 1 minute.
+	CreateEditorSession(ctx context.Context, in *CreateEditorSessionRequest, opts ...grpc.CallOption) (*EditorSession, error)
+	GetEditorSession(ctx context.Context, in *GetEditorSessionRequest, opts ...grpc.CallOption) (*EditorSession, error)
+	// ExecuteEditorSession executes the SQL statement on the connection of the session.
+	ExecuteEditorSession(ctx context.Context, in *ExecuteEditorSessionRequest, opts ...grpc.CallOption) (*ExecuteEditorSessionResponse, error)
+	// HeartbeatEditorSession tells that the client of the session is still connected.
+	HeartbeatEditorSession(ctx context.Context, in *HeartbeatEditorSessionRequest, opts ...grpc.CallOption) (*EditorSession, error)
+	// DeleteEditorSession rolls back the open transaction of the session and closes its connection.
+	DeleteEditorSession(ctx context.Context, in *DeleteEditorSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error)
 	AdminExecute(ctx context.Context, opts ...grpc.CallOption) (SQLService_AdminExecuteClient, error)
 	SearchQueryHistories(ctx context.Context, in *SearchQueryHistoriesRequest, opts ...grpc.CallOption) (*SearchQueryHistoriesResponse, error)
+	// AggregateQueryHistories aggregates the query histories of all the users in the project by the fingerprints of the statements.
+	// It requires the bb.auditLogs.get permission.
+	AggregateQueryHistories(ctx context.Context, in *AggregateQueryHistoriesRequest, opts ...grpc.CallOption) (*AggregateQueryHistoriesResponse, error)
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
 	DifferPreview(ctx context.Context, in *DifferPreviewRequest, opts ...grpc.CallOption) (*DifferPreviewResponse, error)
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
@@ -47,6 +81,9 @@ type SQLServiceClient interface {
 	Pretty(ctx context.Context, in *PrettyRequest, opts ...grpc.CallOption) (*PrettyResponse, error)
 	StringifyMetadata(ctx context.Context, in *StringifyMetadataRequest, opts ...grpc.CallOption) (*StringifyMetadataResponse, error)
 	GenerateRestoreSQL(ctx context.Context, in *GenerateRestoreSQLRequest, opts ...grpc.CallOption) (*GenerateRestoreSQLResponse, error)
+	// Unmask reverses the values masked by the format-preserving masking algorithm of the column.
+	// It requires the bb.databases.unmask permission.
+	Unmask(ctx context.Context, in *UnmaskRequest, opts ...grpc.CallOption) (*UnmaskResponse, error)
 }
 
 type sQLServiceClient struct {
@@ -67,6 +104,96 @@ func (c *sQLServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...
 	return out, nil
 }
 
+func (c *sQLServiceClient) FetchQueryResult(ctx context.Context, in *FetchQueryResultRequest, opts ...grpc.CallOption) (*QueryResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryResult)
+	err := c.cc.Invoke(ctx, SQLService_FetchQueryResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) CloseQueryResult(ctx context.Context, in *CloseQueryResultRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SQLService_CloseQueryResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) CreateQueryResultShare(ctx context.Context, in *CreateQueryResultShareRequest, opts ...grpc.CallOption) (*QueryResultShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryResultShare)
+	err := c.cc.Invoke(ctx, SQLService_CreateQueryResultShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) GetQueryResultShare(ctx context.Context, in *GetQueryResultShareRequest, opts ...grpc.CallOption) (*QueryResultShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryResultShare)
+	err := c.cc.Invoke(ctx, SQLService_GetQueryResultShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) CreateEditorSession(ctx context.Context, in *CreateEditorSessionRequest, opts ...grpc.CallOption) (*EditorSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditorSession)
+	err := c.cc.Invoke(ctx, SQLService_CreateEditorSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) GetEditorSession(ctx context.Context, in *GetEditorSessionRequest, opts ...grpc.CallOption) (*EditorSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditorSession)
+	err := c.cc.Invoke(ctx, SQLService_GetEditorSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) ExecuteEditorSession(ctx context.Context, in *ExecuteEditorSessionRequest, opts ...grpc.CallOption) (*ExecuteEditorSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecuteEditorSessionResponse)
+	err := c.cc.Invoke(ctx, SQLService_ExecuteEditorSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) HeartbeatEditorSession(ctx context.Context, in *HeartbeatEditorSessionRequest, opts ...grpc.CallOption) (*EditorSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditorSession)
+	err := c.cc.Invoke(ctx, SQLService_HeartbeatEditorSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) DeleteEditorSession(ctx context.Context, in *DeleteEditorSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SQLService_DeleteEditorSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecuteResponse)
@@ -119,6 +246,16 @@ func (c *sQLServiceClient) SearchQueryHistories(ctx context.Context, in *SearchQ
 	return out, nil
 }
 
+func (c *sQLServiceClient) AggregateQueryHistories(ctx context.Context, in *AggregateQueryHistoriesRequest, opts ...grpc.CallOption) (*AggregateQueryHistoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AggregateQueryHistoriesResponse)
+	err := c.cc.Invoke(ctx, SQLService_AggregateQueryHistories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExportResponse)
@@ -189,14 +326,46 @@ func (c *sQLServiceClient) GenerateRestoreSQL(ctx context.Context, in *GenerateR
 	return out, nil
 }
 
+func (c *sQLServiceClient) Unmask(ctx context.Context, in *UnmaskRequest, opts ...grpc.CallOption) (*UnmaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmaskResponse)
+	err := c.cc.Invoke(ctx, SQLService_Unmask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SQLServiceServer is the server API for SQLService service.
 // All implementations must embed UnimplementedSQLServiceServer
 // for forward compatibility
 type SQLServiceServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// FetchQueryResult fetches the next page of the query result returned by Query with the page size.
+	FetchQueryResult(context.Context, *FetchQueryResultRequest) (*QueryResult, error)
+	// CloseQueryResult releases the connection held for the query result before all the pages are fetched.
+	CloseQueryResult(context.Context, *CloseQueryResultRequest) (*emptypb.Empty, error)
+	// CreateQueryResultShare runs the query and saves the masked result as a snapshot, which can be viewed by the link until it expires.
+	CreateQueryResultShare(context.Context, *CreateQueryResultShareRequest) (*QueryResultShare, error)
+	// GetQueryResultShare gets the snapshot of the shared query result.
+	GetQueryResultShare(context.Context, *GetQueryResultShareRequest) (*QueryResultShare, error)
+	// CreateEditorSession pins a connection to the editor tab, so that a transaction can be run across the requests.
+	// The session is rolled back and closed if it is idle for 10 minutes,
+	// or if the client sends no heartbeat or execution for 1 minute.
+	CreateEditorSession(context.Context, *CreateEditorSessionRequest) (*EditorSession, error)
+	GetEditorSession(context.Context, *GetEditorSessionRequest) (*EditorSession, error)
+	// ExecuteEditorSession executes the SQL statement on the connection of the session.
+	ExecuteEditorSession(context.Context, *ExecuteEditorSessionRequest) (*ExecuteEditorSessionResponse, error)
+	// HeartbeatEditorSession tells that the client of the session is still connected.
+	HeartbeatEditorSession(context.Context, *HeartbeatEditorSessionRequest) (*EditorSession, error)
+	// DeleteEditorSession rolls back the open transaction of the session and closes its connection.
+	DeleteEditorSession(context.Context, *DeleteEditorSessionRequest) (*emptypb.Empty, error)
 	Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error)
 	AdminExecute(SQLService_AdminExecuteServer) error
 	SearchQueryHistories(context.Context, *SearchQueryHistoriesRequest) (*SearchQueryHistoriesResponse, error)
+	// AggregateQueryHistories aggregates the query histories of all the users in the project by the fingerprints of the statements.
+	// It requires the bb.auditLogs.get permission.
+	AggregateQueryHistories(context.Context, *AggregateQueryHistoriesRequest) (*AggregateQueryHistoriesResponse, error)
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
 	DifferPreview(context.Context, *DifferPreviewRequest) (*DifferPreviewResponse, error)
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
@@ -204,6 +373,9 @@ type SQLServiceServer interface {
 	Pretty(context.Context, *PrettyRequest) (*PrettyResponse, error)
 	StringifyMetadata(context.Context, *StringifyMetadataRequest) (*StringifyMetadataResponse, error)
 	GenerateRestoreSQL(context.Context, *GenerateRestoreSQLRequest) (*GenerateRestoreSQLResponse, error)
+	// Unmask reverses the values masked by the format-preserving masking algorithm of the column.
+	// It requires the bb.databases.unmask permission.
+	Unmask(context.Context, *UnmaskRequest) (*UnmaskResponse, error)
 	mustEmbedUnimplementedSQLServiceServer()
 }
 
@@ -214,6 +386,33 @@ type UnimplementedSQLServiceServer struct {
 func (UnimplementedSQLServiceServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (UnimplementedSQLServiceServer) FetchQueryResult(context.Context, *FetchQueryResultRequest) (*QueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchQueryResult not implemented")
+}
+func (UnimplementedSQLServiceServer) CloseQueryResult(context.Context, *CloseQueryResultRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseQueryResult not implemented")
+}
+func (UnimplementedSQLServiceServer) CreateQueryResultShare(context.Context, *CreateQueryResultShareRequest) (*QueryResultShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQueryResultShare not implemented")
+}
+func (UnimplementedSQLServiceServer) GetQueryResultShare(context.Context, *GetQueryResultShareRequest) (*QueryResultShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryResultShare not implemented")
+}
+func (UnimplementedSQLServiceServer) CreateEditorSession(context.Context, *CreateEditorSessionRequest) (*EditorSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEditorSession not implemented")
+}
+func (UnimplementedSQLServiceServer) GetEditorSession(context.Context, *GetEditorSessionRequest) (*EditorSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEditorSession not implemented")
+}
+func (UnimplementedSQLServiceServer) ExecuteEditorSession(context.Context, *ExecuteEditorSessionRequest) (*ExecuteEditorSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteEditorSession not implemented")
+}
+func (UnimplementedSQLServiceServer) HeartbeatEditorSession(context.Context, *HeartbeatEditorSessionRequest) (*EditorSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeartbeatEditorSession not implemented")
+}
+func (UnimplementedSQLServiceServer) DeleteEditorSession(context.Context, *DeleteEditorSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEditorSession not implemented")
+}
 func (UnimplementedSQLServiceServer) Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
@@ -223,6 +422,9 @@ func (UnimplementedSQLServiceServer) AdminExecute(SQLService_AdminExecuteServer)
 func (UnimplementedSQLServiceServer) SearchQueryHistories(context.Context, *SearchQueryHistoriesRequest) (*SearchQueryHistoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchQueryHistories not implemented")
 }
+func (UnimplementedSQLServiceServer) AggregateQueryHistories(context.Context, *AggregateQueryHistoriesRequest) (*AggregateQueryHistoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateQueryHistories not implemented")
+}
 func (UnimplementedSQLServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
@@ -244,6 +446,9 @@ func (UnimplementedSQLServiceServer) StringifyMetadata(context.Context, *Stringi
 func (UnimplementedSQLServiceServer) GenerateRestoreSQL(context.Context, *GenerateRestoreSQLRequest) (*GenerateRestoreSQLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateRestoreSQL not implemented")
 }
+func (UnimplementedSQLServiceServer) Unmask(context.Context, *UnmaskRequest) (*UnmaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unmask not implemented")
+}
 func (UnimplementedSQLServiceServer) mustEmbedUnimplementedSQLServiceServer() {}
 
 // UnsafeSQLServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -275,6 +480,168 @@ func _SQLService_Query_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_FetchQueryResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchQueryResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).FetchQueryResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_FetchQueryResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).FetchQueryResult(ctx, req.(*FetchQueryResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_CloseQueryResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseQueryResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CloseQueryResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CloseQueryResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CloseQueryResult(ctx, req.(*CloseQueryResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_CreateQueryResultShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQueryResultShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CreateQueryResultShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CreateQueryResultShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CreateQueryResultShare(ctx, req.(*CreateQueryResultShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_GetQueryResultShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueryResultShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).GetQueryResultShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_GetQueryResultShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).GetQueryResultShare(ctx, req.(*GetQueryResultShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_CreateEditorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEditorSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CreateEditorSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CreateEditorSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CreateEditorSession(ctx, req.(*CreateEditorSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_GetEditorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEditorSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).GetEditorSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_GetEditorSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).GetEditorSession(ctx, req.(*GetEditorSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_ExecuteEditorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteEditorSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).ExecuteEditorSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_ExecuteEditorSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).ExecuteEditorSession(ctx, req.(*ExecuteEditorSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_HeartbeatEditorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatEditorSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).HeartbeatEditorSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_HeartbeatEditorSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).HeartbeatEditorSession(ctx, req.(*HeartbeatEditorSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_DeleteEditorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEditorSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).DeleteEditorSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_DeleteEditorSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).DeleteEditorSession(ctx, req.(*DeleteEditorSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecuteRequest)
 	if err := dec(in); err != nil {
@@ -337,6 +704,24 @@ func _SQLService_SearchQueryHistories_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_AggregateQueryHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateQueryHistoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).AggregateQueryHistories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_AggregateQueryHistories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).AggregateQueryHistories(ctx, req.(*AggregateQueryHistoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportRequest)
 	if err := dec(in); err != nil {
@@ -463,6 +848,24 @@ func _SQLService_GenerateRestoreSQL_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_Unmask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).Unmask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_Unmask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).Unmask(ctx, req.(*UnmaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SQLService_ServiceDesc is the grpc.ServiceDesc for SQLService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -474,6 +877,42 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _SQLService_Query_Handler,
 		},
+		{
+			MethodName: "FetchQueryResult",
+			Handler:    _SQLService_FetchQueryResult_Handler,
+		},
+		{
+			MethodName: "CloseQueryResult",
+			Handler:    _SQLService_CloseQueryResult_Handler,
+		},
+		{
+			MethodName: "CreateQueryResultShare",
+			Handler:    _SQLService_CreateQueryResultShare_Handler,
+		},
+		{
+			MethodName: "GetQueryResultShare",
+			Handler:    _SQLService_GetQueryResultShare_Handler,
+		},
+		{
+			MethodName: "CreateEditorSession",
+			Handler:    _SQLService_CreateEditorSession_Handler,
+		},
+		{
+			MethodName: "GetEditorSession",
+			Handler:    _SQLService_GetEditorSession_Handler,
+		},
+		{
+			MethodName: "ExecuteEditorSession",
+			Handler:    _SQLService_ExecuteEditorSession_Handler,
+		},
+		{
+			MethodName: "HeartbeatEditorSession",
+			Handler:    _SQLService_HeartbeatEditorSession_Handler,
+		},
+		{
+			MethodName: "DeleteEditorSession",
+			Handler:    _SQLService_DeleteEditorSession_Handler,
+		},
 		{
 			MethodName: "Execute",
 			Handler:    _SQLService_Execute_Handler,
@@ -482,6 +921,10 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchQueryHistories",
 			Handler:    _SQLService_SearchQueryHistories_Handler,
 		},
+		{
+			MethodName: "AggregateQueryHistories",
+			Handler:    _SQLService_AggregateQueryHistories_Handler,
+		},
 		{
 			MethodName: "Export",
 			Handler:    _SQLService_Export_Handler,
@@ -510,6 +953,10 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateRestoreSQL",
 			Handler:    _SQLService_GenerateRestoreSQL_Handler,
 		},
+		{
+			MethodName: "Unmask",
+			Handler:    _SQLService_Unmask_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{